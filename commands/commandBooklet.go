@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/urfave/cli/v2"
+)
+
+// maximum number of puzzles on a single page of the booklet
+const maximumBookletPerPage = 16
+
+// BookletCommand provides booklet command configuration
+func (commandConfig *CommandContext) BookletCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "booklet",
+		Aliases: []string{"b"},
+		Usage: "Lays out puzzles as a printable PDF booklet. Puzzles are read from the file\n" +
+			"provided with -i flag, or from all files of the directory provided with -i flag\n" +
+			"(every file in any supported format, all puzzles of collection files are used).\n" +
+			"Every puzzle is titled with the name of its file and labelled with difficulty -\n" +
+			"easy, medium or hard, depending on techniques needed to solve it. Solutions can\n" +
+			"be added in an answer section at the end of the booklet. The document is\n" +
+			"generated locally, with standard PDF fonts.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input-file",
+				Aliases:     []string{"i"},
+				DefaultText: "",
+				Usage:       "Specify path to sudoku input file or directory with sudoku files",
+			},
+			&cli.StringFlag{
+				Name:        "output-file",
+				Aliases:     []string{"o"},
+				DefaultText: "",
+				Usage:       "Specify path to PDF file where you want to save the booklet",
+			},
+			&cli.IntFlag{
+				Name:  "per-page",
+				Value: 4,
+				Usage: fmt.Sprintf("Number of puzzles on a single page (up to %d)", maximumBookletPerPage),
+			},
+			&cli.BoolFlag{
+				Name:  "solutions-at-end",
+				Usage: "Add solutions of the puzzles in an answer section at the end of the booklet",
+			},
+			&cli.StringFlag{
+				Name:  "title",
+				Usage: "Title printed at the top of puzzle pages",
+			},
+			&alphabetFlag,
+			&overwriteFileFlag,
+		},
+		Action: func(context *cli.Context) error {
+			request := commandConfig.buildBookletCommandRequest(context)
+			return commandConfig.bookletCommandHandler(context.Context, request)
+		},
+	}
+}
+
+// bookletCommandHandler is an entry point function for booklet command
+func (commandConfig *CommandContext) bookletCommandHandler(ctx context.Context,
+	request *models.BookletCommandRequest) error {
+
+	commandConfig.startCommandOutput("booklet")
+	defer commandConfig.finishCommandOutput()
+
+	if len(request.InputPath) < 1 || len(request.OutputFile) < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Please provide input and output paths with -i and -o flags.")
+	}
+
+	if request.PerPage < 1 || request.PerPage > maximumBookletPerPage {
+		return commandConfig.failCommand(models.ErrInvalidInput, fmt.Sprintf(
+			"Number of puzzles per page has to be between 1 and %d.", maximumBookletPerPage))
+	}
+
+	outputPath := request.OutputFile
+	if len(filepath.Ext(outputPath)) < 1 {
+		outputPath += ".pdf"
+	}
+
+	files, err := commandConfig.ServiceCollection.DataReader.
+		ReadSudokuFiles(request.InputPath, request.Alphabet)
+	if err != nil {
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	puzzles, solutions, err := commandConfig.prepareBookletEntries(ctx, files, request)
+	if err != nil {
+		return err
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Booklet puzzles:")
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	for _, puzzle := range puzzles {
+		commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(
+			fmt.Sprintf("- %s (%s)", puzzle.Title, puzzle.Label))
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Saving results:")
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	written, err := commandConfig.ServiceCollection.DataWriter.SaveDataToFile(outputPath, request.Overwrite,
+		func(writer io.Writer) error {
+			return commandConfig.ServiceCollection.Renderer.RenderPdfBooklet(writer, puzzles, solutions,
+				sudokuRenderer.BookletOptions{Title: request.Title, PerPage: request.PerPage})
+		})
+	return commandConfig.reportSudokuFileSave(outputPath, written, err)
+}
+
+// prepareBookletEntries validates and grades all puzzles of the files. Solutions are
+// returned only if they are requested. Puzzles are titled with names of the files,
+// numbered if the file contains multiple puzzles.
+func (commandConfig *CommandContext) prepareBookletEntries(ctx context.Context, files []*models.SudokuFile,
+	request *models.BookletCommandRequest) ([]*sudokuRenderer.BookletEntry, []*sudokuRenderer.BookletEntry, error) {
+
+	puzzles := []*sudokuRenderer.BookletEntry{}
+	solutions := []*sudokuRenderer.BookletEntry{}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file.Path), filepath.Ext(file.Path))
+		for index, sudokuDto := range file.Sudokus {
+			title := name
+			if len(file.Sudokus) > 1 {
+				title = fmt.Sprintf("%s #%d", name, index+1)
+			}
+
+			if len(request.Alphabet) > 0 {
+				sudokuDto.Alphabet = request.Alphabet
+			}
+
+			sudoku, err := commandConfig.executeSudokuInitialization(sudokuDto, false)
+			if err != nil {
+				commandConfig.reportError(fmt.Sprintf("Puzzle '%s' is not valid.", title))
+				return nil, nil, err
+			}
+
+			difficulty := commandConfig.ServiceCollection.Grader.Grade(sudoku)
+			label := fmt.Sprintf("Difficulty: %s", difficulty)
+
+			if !request.SolutionsAtEnd {
+				puzzles = append(puzzles, &sudokuRenderer.BookletEntry{Title: title, Label: label, Sudoku: sudoku})
+				continue
+			}
+
+			// initialized sudoku is solved in place, so the puzzle is rendered from its copy
+			puzzles = append(puzzles, &sudokuRenderer.BookletEntry{
+				Title: title, Label: label, Sudoku: sudokuDto.ToSudoku()})
+
+			err = commandConfig.getLibrary().SolveInitialized(ctx, sudoku)
+			if err != nil {
+				commandConfig.reportError(fmt.Sprintf("Puzzle '%s' can not be solved.", title))
+				return nil, nil, commandConfig.failSolution(err)
+			}
+
+			solutions = append(solutions, &sudokuRenderer.BookletEntry{Title: title, Label: label, Sudoku: sudoku})
+		}
+	}
+
+	return puzzles, solutions, nil
+}
+
+// buildBookletCommandRequest retrieves options settings from the command
+// and constructs request object.
+func (commandConfig *CommandContext) buildBookletCommandRequest(context *cli.Context) *models.BookletCommandRequest {
+	return &models.BookletCommandRequest{
+		InputPath:      context.String("input-file"),
+		OutputFile:     context.String("output-file"),
+		Title:          context.String("title"),
+		PerPage:        context.Int("per-page"),
+		SolutionsAtEnd: context.Bool("solutions-at-end"),
+		Alphabet:       context.String(alphabetFlag.Name),
+		Overwrite:      context.Bool(overwriteFileFlag.Name),
+	}
+}
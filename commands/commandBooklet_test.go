@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/sudokuGrader"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestBookletCommand(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		arguments            []string
+		dataReaderResult     *models.SudokuDTO
+		dataReaderError      error
+		dataWriterError      error
+		sudokuInitResult     bool
+		sudokuInitErrors     []error
+		sudokuSolutionResult bool
+		printContent         []string
+		expectedExitCode     int
+	}{
+		{
+			name:             "Missing output file",
+			arguments:        []string{"", "booklet", "-i", "puzzles"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			printContent:     []string{"Please provide input and output paths"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid number of puzzles per page",
+			arguments:        []string{"", "booklet", "-i", "puzzles", "-o", "book.pdf", "--per-page", "0"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			printContent:     []string{"Number of puzzles per page has to be between 1 and 16"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid input file",
+			arguments:        []string{"", "booklet", "-i", "puzzles", "-o", "book.pdf"},
+			dataReaderError:  errors.New("sudoku data file read error"),
+			printContent:     []string{"Invalid sudoku input"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Input directory read failure",
+			arguments:        []string{"", "booklet", "-i", "puzzles", "-o", "book.pdf"},
+			dataReaderError:  models.WithKind(models.ErrIO, errors.New("sudoku directory read error")),
+			printContent:     []string{"Invalid sudoku input"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Invalid puzzle",
+			arguments:        []string{"", "booklet", "-i", "puzzles.json", "-o", "book.pdf"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			sudokuInitResult: false,
+			sudokuInitErrors: []error{errors.New("sudoku init error")},
+			printContent:     []string{"Puzzle 'puzzles' is not valid."},
+			expectedExitCode: ExitCodeInvalidConfiguration,
+		},
+		{
+			name:                 "Puzzle without solution",
+			arguments:            []string{"", "booklet", "-i", "puzzles.json", "-o", "book.pdf", "--solutions-at-end"},
+			dataReaderResult:     testHelpers.GetTestSudokuDto(),
+			sudokuInitResult:     true,
+			sudokuSolutionResult: false,
+			printContent:         []string{"Puzzle 'puzzles' can not be solved."},
+			expectedExitCode:     ExitCodeSolverFailure,
+		},
+		{
+			name:             "Output file write failure",
+			arguments:        []string{"", "booklet", "-i", "puzzles.json", "-o", "book.pdf"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			sudokuInitResult: true,
+			dataWriterError:  errors.New("file write unexpected error"),
+			printContent:     []string{"file write unexpected error"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:                 "All good",
+			arguments:            []string{"", "booklet", "-i", "puzzles.json", "-o", "book", "--solutions-at-end"},
+			dataReaderResult:     testHelpers.GetTestSudokuDto(),
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"- puzzles (Difficulty: ", "'book.pdf' written successfully"},
+			expectedExitCode:     ExitCodeSuccess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		testPrinter := testHelpers.NewTestPrinter()
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+				TerminalPrinter: testPrinter,
+				DataReader: testHelpers.NewTestDataReader(
+					testCase.dataReaderResult, testCase.dataReaderError),
+				DataWriter: testHelpers.NewTestDataWriter(testCase.dataWriterError == nil, testCase.dataWriterError),
+				SudokuInit: testHelpers.NewTestSudokuInit(
+					testCase.sudokuInitResult, testCase.sudokuInitErrors),
+				Solver:        testHelpers.GetNewTestSolver(testCase.sudokuSolutionResult, []error{}),
+				SudokuFormats: newTestSudokuFormats(settings),
+				Renderer:      sudokuRenderer.GetNewSudokuRenderer(settings),
+				Grader:        sudokuGrader.GetNewSudokuGrader(settings),
+			},
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.BookletCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedPrintout := range testCase.printContent {
+			if !strings.Contains(testPrinter.PrintedData, expectedPrintout) {
+				t.Errorf("%s: Console printout is missing the following: '%s'",
+					testCase.name, expectedPrintout)
+			}
+		}
+	}
+}
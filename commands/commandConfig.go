@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/Michu8258/kangaroo/config"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigCommand provides configuration command with its subcommands
+func (commandConfig *CommandContext) ConfigCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "config",
+		Usage: "Manages settings of the cli",
+		Subcommands: []*cli.Command{
+			{
+				Name: "show",
+				Usage: "Prints effective settings - built-in defaults changed with configuration file\n" +
+					"(--config or $XDG_CONFIG_HOME/kangaroo/config.yaml), KANGAROO_* environment\n" +
+					"variables and global options, in that order of precedence.",
+				Action: func(context *cli.Context) error {
+					return commandConfig.configShowCommandHandler()
+				},
+			},
+		},
+	}
+}
+
+// LoadSettings changes settings of the command context with configuration file and
+// environment variables. Default configuration file is used if the path is empty.
+func (commandConfig *CommandContext) LoadSettings(configFilePath string) error {
+	loadedFilePath, err := config.Load(commandConfig.Settings, configFilePath, os.LookupEnv)
+	if err != nil {
+		commandConfig.reportErrors("Failed to load settings:", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	commandConfig.configFilePath = loadedFilePath
+	return nil
+}
+
+// configShowCommandHandler is an entry point function for config show command
+func (commandConfig *CommandContext) configShowCommandHandler() error {
+	values := config.FromSettings(commandConfig.Settings)
+
+	if commandConfig.Settings.OutputFormat == models.JSONOutputFormat {
+		encoder := json.NewEncoder(commandConfig.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(values)
+	}
+
+	if commandConfig.Settings.IsConsoleSilent() {
+		return nil
+	}
+
+	// values contain only numbers, booleans and strings
+	data, _ := yaml.Marshal(values)
+
+	if len(commandConfig.configFilePath) > 0 {
+		fmt.Fprintf(commandConfig.Stdout, "# configuration file: %s\n", commandConfig.configFilePath)
+	}
+
+	_, err := commandConfig.Stdout.Write(data)
+	return err
+}
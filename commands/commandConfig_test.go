@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestConfigShowCommand(t *testing.T) {
+	directory := t.TempDir()
+	configFilePath := filepath.Join(directory, "config.yaml")
+	err := os.WriteFile(configFilePath, []byte("maxBoxSize: 4\nvaluePadding: 2\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("XDG_CONFIG_HOME", directory)
+	t.Setenv("KANGAROO_VALUE_PADDING", "3")
+
+	testCases := []struct {
+		name             string
+		arguments        []string
+		expectedOutput   []string
+		expectedExitCode int
+	}{
+		{
+			name:      "Default configuration file",
+			arguments: []string{"", "config", "show"},
+			expectedOutput: []string{
+				"maxBoxSize: 5\n", "valuePadding: 3\n", "output: text\n",
+			},
+		},
+		{
+			name:      "Provided configuration file",
+			arguments: []string{"", "--config", configFilePath, "config", "show"},
+			expectedOutput: []string{
+				"# configuration file: " + configFilePath, "maxBoxSize: 4\n", "valuePadding: 3\n",
+			},
+		},
+		{
+			name:      "Global option takes precedence",
+			arguments: []string{"", "--config", configFilePath, "--max-box-size", "3", "config", "show"},
+			expectedOutput: []string{
+				"maxBoxSize: 3\n",
+			},
+		},
+		{
+			name:      "JSON output",
+			arguments: []string{"", "--output", "json", "--config", configFilePath, "config", "show"},
+			expectedOutput: []string{
+				`"maxBoxSize": 4`, `"output": "json"`, `"silent": false`,
+			},
+		},
+		{
+			name:             "Missing configuration file",
+			arguments:        []string{"", "--config", filepath.Join(directory, "missing.yaml"), "config", "show"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		stdout := &bytes.Buffer{}
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter: dataPrinters.GetNewDataPrinter(settings, testHelpers.NewTestPrinter()),
+			},
+			Stdout: stdout,
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Before: func(context *cli.Context) error {
+				return config.LoadSettings(context.String("config"))
+			},
+			Flags: []cli.Flag{
+				&cli.StringFlag{Name: "config"},
+				&cli.StringFlag{
+					Name: "output",
+					Action: func(context *cli.Context, format string) error {
+						return settings.SetOutputFormat(format)
+					},
+				},
+				&cli.IntFlag{
+					Name: "max-box-size",
+					Action: func(context *cli.Context, size int) error {
+						return settings.SetMaximumBoxSize(size)
+					},
+				},
+			},
+			Commands: []*cli.Command{
+				config.ConfigCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedOutput := range testCase.expectedOutput {
+			if !strings.Contains(stdout.String(), expectedOutput) {
+				t.Errorf("%s: Output '%s' is missing the following: '%s'",
+					testCase.name, stdout.String(), expectedOutput)
+			}
+		}
+
+		if testCase.expectedExitCode == ExitCodeSuccess && settings.OutputFormat == models.TextOutputFormat &&
+			settings.SudokuPrintoutValuePaddingLength != 3 {
+			t.Errorf("%s: Environment variable was not applied to the settings.", testCase.name)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package commands
 
 import (
+	"io"
+	"sync"
+
+	"github.com/Michu8258/kangaroo/internal/library"
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services"
 )
@@ -8,4 +12,40 @@ import (
 type CommandContext struct {
 	Settings          *models.Settings
 	ServiceCollection *services.ServiceCollection
+	Stdin             io.Reader
+	Stdout            io.Writer
+	output            *commandOutput
+	configFilePath    string
+	library           *library.Library
+	libraryOnce       sync.Once
+}
+
+// getLibrary returns sudoku library using settings and services of the command
+// context, so all commands share the same solver and encoder
+func (commandConfig *CommandContext) getLibrary() *library.Library {
+	commandConfig.libraryOnce.Do(func() {
+		commandConfig.library = commandConfig.newLibrary()
+	})
+
+	return commandConfig.library
+}
+
+// newLibrary creates sudoku library using settings and services of the command context
+// and additional options, which can not be invalid
+func (commandConfig *CommandContext) newLibrary(options ...library.Option) *library.Library {
+	libraryOptions := []library.Option{library.WithSettings(commandConfig.Settings)}
+	if commandConfig.ServiceCollection.SudokuInit != nil {
+		libraryOptions = append(libraryOptions, library.WithSudokuInit(commandConfig.ServiceCollection.SudokuInit))
+	}
+
+	if commandConfig.ServiceCollection.Solver != nil {
+		libraryOptions = append(libraryOptions, library.WithSolver(commandConfig.ServiceCollection.Solver))
+	}
+
+	if commandConfig.ServiceCollection.SudokuEncoder != nil {
+		libraryOptions = append(libraryOptions, library.WithEncoder(commandConfig.ServiceCollection.SudokuEncoder))
+	}
+
+	sudokuLibrary, _ := library.New(append(libraryOptions, options...)...)
+	return sudokuLibrary
 }
@@ -0,0 +1,153 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/urfave/cli/v2"
+)
+
+func (commandConfig *CommandContext) ConvertCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "convert",
+		Aliases: []string{"v"},
+		Usage: "Converts sudoku file to a different format, e.g. JSON file to base64 data\n" +
+			"accepted by exec command and back. Format of the input file is detected from\n" +
+			"the content and format of the output file is chosen by the extension, formats\n" +
+			"can be overriden with --from and --to flags. Information which can not be\n" +
+			"represented in the output format (e.g. variant constraints) is reported.\n" +
+			"Supported formats: " + strings.Join(commandConfig.getFormatNames(), ", ") + ".",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input-file",
+				Aliases:     []string{"i"},
+				DefaultText: "",
+				Usage:       "Specify path to sudoku input file",
+			},
+			&cli.StringFlag{
+				Name:        "output-file",
+				Aliases:     []string{"o"},
+				DefaultText: "",
+				Usage:       "Specify path to file where you want to save converted sudoku",
+			},
+			&cli.StringFlag{
+				Name:  "from",
+				Usage: "Format of the input file, detected by content or extension if not provided",
+			},
+			&cli.StringFlag{
+				Name:  "to",
+				Usage: "Format of the output file, chosen by extension if not provided (JSON is default)",
+			},
+			&alphabetFlag,
+			&overwriteFileFlag,
+		},
+		Action: func(context *cli.Context) error {
+			request := commandConfig.buildConvertCommandRequest(context)
+			return commandConfig.convertCommandHandler(request)
+		},
+	}
+}
+
+// convertCommandHandler is an entry point function for convert sudoku command
+func (commandConfig *CommandContext) convertCommandHandler(request *models.ConvertCommandRequest) error {
+	commandConfig.startCommandOutput("convert")
+	defer commandConfig.finishCommandOutput()
+
+	if len(request.InputFile) < 1 || len(request.OutputFile) < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Please provide input and output file paths with -i and -o flags.")
+	}
+
+	outputPath := request.OutputFile
+	format := commandConfig.ServiceCollection.SudokuFormats.GetFormatByName(request.ToFormat)
+	if len(request.ToFormat) < 1 {
+		validPaths, err := commandConfig.validateDestinationFilePaths(request.OutputFile)
+		if err != nil {
+			return err
+		}
+
+		outputPath = validPaths[0]
+		format = commandConfig.ServiceCollection.SudokuFormats.GetFormatByPath(outputPath)
+	} else if format == nil || !format.CanWrite() {
+		return commandConfig.failCommand(models.ErrInvalidInput, fmt.Sprintf(
+			"Output format '%s' is not supported, use one of: %s.", request.ToFormat,
+			strings.Join(commandConfig.getFormatNames(), ", ")))
+	}
+
+	sudokus, err := commandConfig.ServiceCollection.DataReader.
+		ReadSudokusFromFile(request.InputFile, request.FromFormat, request.Alphabet)
+	if err != nil {
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	sudokuDto := sudokus[0]
+	if len(request.Alphabet) > 0 {
+		sudokuDto.Alphabet = request.Alphabet
+	}
+
+	lostInformation := format.GetLostInformation(sudokuDto)
+	if len(sudokus) > 1 {
+		lostInformation = append(lostInformation, fmt.Sprintf(
+			"%d sudoku(s) of the input file after the first one", len(sudokus)-1))
+	}
+
+	commandConfig.reportLostInformation(format, lostInformation)
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Saving results:")
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	written, err := commandConfig.ServiceCollection.DataWriter.
+		SaveSudokuDtoToFile(sudokuDto, outputPath, format.Name, request.Overwrite)
+	return commandConfig.reportSudokuFileSave(outputPath, written, err)
+}
+
+// reportLostInformation prints information which is not written in the output
+// format and adds it to the command output
+func (commandConfig *CommandContext) reportLostInformation(format *sudokuFormats.SudokuFormat,
+	lostInformation []string) {
+
+	if len(lostInformation) < 1 {
+		return
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary(
+		fmt.Sprintf("Information not supported by %s format (lost in the conversion):", format.Name))
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	for _, information := range lostInformation {
+		commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(fmt.Sprintf("- %s", information))
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	if commandConfig.output != nil {
+		commandConfig.output.dto.LostInformation = lostInformation
+	}
+}
+
+// getFormatNames returns names of all supported sudoku file formats
+func (commandConfig *CommandContext) getFormatNames() []string {
+	names := []string{}
+	for _, format := range commandConfig.ServiceCollection.SudokuFormats.GetFormats() {
+		names = append(names, format.Name)
+	}
+
+	return names
+}
+
+// buildConvertCommandRequest retrieves options settings from the command
+// and constructs request object.
+func (commandConfig *CommandContext) buildConvertCommandRequest(context *cli.Context) *models.ConvertCommandRequest {
+	return &models.ConvertCommandRequest{
+		InputFile:  context.String("input-file"),
+		OutputFile: context.String("output-file"),
+		FromFormat: context.String("from"),
+		ToFormat:   context.String("to"),
+		Alphabet:   context.String(alphabetFlag.Name),
+		Overwrite:  context.Bool(overwriteFileFlag.Name),
+	}
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestConvertCommand(t *testing.T) {
+	thermometerSudoku := testHelpers.GetTestSudokuDto()
+	thermometerSudoku.Thermometers = models.GenericSlice[*models.SudokuThermometerDTO]{{}}
+
+	testCases := []struct {
+		name             string
+		arguments        []string
+		dataReaderResult *models.SudokuDTO
+		dataReaderError  error
+		dataWriterError  error
+		printContent     []string
+		expectedExitCode int
+	}{
+		{
+			name:             "Missing output file",
+			arguments:        []string{"", "convert", "-i", "sudoku.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			printContent:     []string{"Please provide input and output file paths"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Unsupported output format",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.out", "--to", "bad"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			printContent:     []string{"Output format 'bad' is not supported", "base64"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Unsupported output extension",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.bad"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			printContent:     []string{"Unsupported file extension"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid input file",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.b64"},
+			dataReaderError:  errors.New("sudoku data file read error"),
+			printContent:     []string{"Invalid sudoku input"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Input file read failure",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.b64"},
+			dataReaderError:  models.WithKind(models.ErrIO, errors.New("sudoku data file read error")),
+			printContent:     []string{"Invalid sudoku input"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Output file write failure",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.b64"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			dataWriterError:  errors.New("file write unexpected error"),
+			printContent:     []string{"file write unexpected error"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Information lost in the conversion",
+			arguments:        []string{"", "convert", "-i", "sudoku.json", "-o", "sudoku.sdk"},
+			dataReaderResult: thermometerSudoku,
+			printContent:     []string{"lost in the conversion", "1 thermometer(s)", "'sudoku.sdk' written successfully"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "All good - format override",
+			arguments:        []string{"", "convert", "-i", "sudoku.txt", "--from", "json", "-o", "sudoku.txt", "--to", "base64"},
+			dataReaderResult: thermometerSudoku,
+			printContent:     []string{"'sudoku.txt' written successfully"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		testPrinter := testHelpers.NewTestPrinter()
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+				TerminalPrinter: testPrinter,
+				DataReader: testHelpers.NewTestDataReader(
+					testCase.dataReaderResult, testCase.dataReaderError),
+				DataWriter:    testHelpers.NewTestDataWriter(testCase.dataWriterError == nil, testCase.dataWriterError),
+				SudokuFormats: newTestSudokuFormats(settings),
+			},
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.ConvertCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedPrintout := range testCase.printContent {
+			if !strings.Contains(testPrinter.PrintedData, expectedPrintout) {
+				t.Errorf("%s: Console printout is missing the following: '%s'",
+					testCase.name, expectedPrintout)
+			}
+		}
+	}
+}
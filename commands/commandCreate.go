@@ -1,8 +1,11 @@
 package commands
 
 import (
+	"context"
+
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
 	"github.com/urfave/cli/v2"
 )
 
@@ -12,55 +15,119 @@ func (commandConfig *CommandContext) CreateCommand() *cli.Command {
 		Name:    "create",
 		Aliases: []string{"c"},
 		Usage: "Creates sudoku puzzle data and saves to provided file paths\n" +
-			"(JSON and TXT files supported, default is JSON). At least one file\n" +
-			"path for output must be provided. You can ommit prompts for box size\n" +
-			"and sudoku layout by using flags -b, --lw, --lh.",
+			"(format is chosen by the extension - .json, .txt, .sdk, .sdm, .ss, .hodoku,\n" +
+			".fpuzzles, .svg and .png images, or .html page to play the puzzle in a web\n" +
+			"browser, default is JSON). File paths can be passed as arguments or with -o\n" +
+			"flags, at least one file path for output must be provided. You can ommit\n" +
+			"prompts for box size and sudoku layout by using flags -b, --lw, --lh. Values\n" +
+			"can be presented and entered as symbols of an alphabet provided with -a flag.\n" +
+			"With --embed-solution flag the sudoku is solved and the solution is embedded\n" +
+			"in .html pages, which enables checking of entered values.",
 		Flags: []cli.Flag{
 			&boxSizeFlag,
 			&layoutWidthFlag,
 			&layoutHeightFlag,
+			&alphabetFlag,
 			&overwriteFileFlag,
+			&candidatesFlag,
+			&cli.BoolFlag{
+				Name:  "embed-solution",
+				Usage: "Solve the sudoku and embed the solution in .html pages to enable checking of entered values",
+			},
+			&cli.StringSliceFlag{
+				Name:    "output-file",
+				Aliases: []string{"o"},
+				Usage:   "Specify path to file where you want to save the sudoku (can be used multiple times)",
+			},
 		},
 		Action: func(context *cli.Context) error {
 			request := commandConfig.buildCreateCommandRequest(context)
-			filePaths := context.Args().Slice()
-			return commandConfig.createCommandHandler(request, filePaths)
+			filePaths := append(context.Args().Slice(), context.StringSlice("output-file")...)
+			return commandConfig.createCommandHandler(context.Context, request, filePaths)
 		},
 	}
 }
 
 // createCommandHandler is an entry point function to create sudoku data file
-func (commandConfig *CommandContext) createCommandHandler(request *models.CreateCommandRequest,
-	destinationFilePaths []string) error {
+func (commandConfig *CommandContext) createCommandHandler(ctx context.Context,
+	request *models.CreateCommandRequest, destinationFilePaths []string) error {
+
+	commandConfig.startCommandOutput("create")
+	defer commandConfig.finishCommandOutput()
 
 	if len(destinationFilePaths) < 1 {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(
+		return commandConfig.failCommand(models.ErrInvalidInput,
 			"Please provide at least one argument for output file location.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
 	}
 
-	validPaths := commandConfig.validateDestinationFilePaths(destinationFilePaths...)
-	if len(validPaths) < 1 {
-		return nil
+	validPaths, err := commandConfig.validateDestinationFilePaths(destinationFilePaths...)
+	if err != nil {
+		return err
+	}
+
+	if request.Candidates {
+		commandConfig.Settings.RenderCandidates = true
 	}
 
 	sudokuDto, err := commandConfig.ServiceCollection.DataReader.
 		ReadSudokuFromConsole(request.AsConfigRequest())
 	if err != nil {
-		commandConfig.ServiceCollection.DataPrinter.
-			PrintErrors("Invalid sudoku input", err)
-		return nil
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
 	}
 
-	sudoku, ok := commandConfig.executeSudokuInitialization(sudokuDto, true)
-	if !ok {
-		return nil
+	sudoku, err := commandConfig.executeSudokuInitialization(sudokuDto, true)
+	if err != nil {
+		return err
 	}
 
-	commandConfig.executeSudokuFilesSave(sudoku, request.AsConfigRequest(), validPaths)
+	if commandConfig.output != nil {
+		commandConfig.output.dto.Sudoku = sudoku.ToSudokuDto()
+	}
 
-	return nil
+	if request.EmbedSolution {
+		return commandConfig.saveSudokuWithSolution(ctx, sudoku, request.AsConfigRequest(), validPaths)
+	}
+
+	return commandConfig.executeSudokuFilesSave(sudoku, request.AsConfigRequest(), validPaths)
+}
+
+// saveSudokuWithSolution solves a copy of the sudoku and saves files - HTML pages
+// get the solution embedded for checking of entered values, other files get the puzzle
+func (commandConfig *CommandContext) saveSudokuWithSolution(ctx context.Context, sudoku *models.Sudoku,
+	request *models.SudokuConfigRequest, paths []string) error {
+
+	solution, err := commandConfig.getLibrary().Initialize(sudoku.ToSudokuDto())
+	if err == nil {
+		err = commandConfig.solveInitializedSudoku(ctx, solution)
+	}
+
+	if err != nil {
+		return commandConfig.failSolution(err)
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Saving results:")
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	var saveErr error
+	for _, path := range paths {
+		commandConfig.Settings.RenderSolution = false
+		pathSudoku := sudoku
+
+		format := commandConfig.ServiceCollection.SudokuFormats.GetFormatByPath(path)
+		if format != nil && format.Name == sudokuFormats.HtmlFormatName {
+			commandConfig.Settings.RenderSolution = true
+			pathSudoku = solution
+		}
+
+		err := commandConfig.saveSudokuToFile(pathSudoku, request, path)
+		if saveErr == nil {
+			saveErr = err
+		}
+	}
+
+	commandConfig.Settings.RenderSolution = false
+	return saveErr
 }
 
 // buildCreateCommandRequest retrieves options settings from the command
@@ -71,9 +138,13 @@ func (commandConfig *CommandContext) buildCreateCommandRequest(
 	boxSize := context.Int(boxSizeFlag.Name)
 	layoutWidth := context.Int(layoutWidthFlag.Name)
 	layoutHeight := context.Int(layoutHeightFlag.Name)
+	alphabet := context.String(alphabetFlag.Name)
 	overwrite := context.Bool(overwriteFileFlag.Name)
 
-	request := &models.CreateCommandRequest{}
+	request := &models.CreateCommandRequest{
+		Candidates:    context.Bool(candidatesFlag.Name),
+		EmbedSolution: context.Bool("embed-solution"),
+	}
 
 	if boxSize > 0 {
 		request.BoxSize = helpers.IntToInt8Pointer(boxSize)
@@ -87,6 +158,10 @@ func (commandConfig *CommandContext) buildCreateCommandRequest(
 		request.LayoutHeight = helpers.IntToInt8Pointer(layoutHeight)
 	}
 
+	if len(alphabet) > 0 {
+		request.Alphabet = &alphabet
+	}
+
 	if overwrite {
 		request.Overwrite = true
 	}
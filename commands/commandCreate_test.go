@@ -20,10 +20,13 @@ func TestCreateCommand(t *testing.T) {
 		dataReaderError  error
 		sudokuInitResult bool
 		sudokuInitErrors []error
+		solverResult     bool
 		printContent     []string
+		expectedExitCode int
 	}{
 		{
 			name:             "Everything OK",
+			expectedExitCode: ExitCodeSuccess,
 			arguments:        []string{"", "create", "-s", "3", "--lw", "4", "--lh", "5", "-r", "./relative/path/to/file.json"},
 			dataReaderResult: testHelpers.GetTestSudokuDto(),
 			dataReaderError:  nil,
@@ -35,6 +38,7 @@ func TestCreateCommand(t *testing.T) {
 		},
 		{
 			name:             "Everything OK - no flags",
+			expectedExitCode: ExitCodeSuccess,
 			arguments:        []string{"", "create", "./relative/path/to/file.json"},
 			dataReaderResult: testHelpers.GetTestSudokuDto(),
 			dataReaderError:  nil,
@@ -44,8 +48,49 @@ func TestCreateCommand(t *testing.T) {
 				"Saving results:",
 			},
 		},
+		{
+			name:             "Everything OK - images with output flags",
+			expectedExitCode: ExitCodeSuccess,
+			arguments:        []string{"", "create", "--candidates", "-o", "./image.svg", "-o", "./image.png"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			dataReaderError:  nil,
+			sudokuInitResult: true,
+			sudokuInitErrors: []error{},
+			printContent: []string{
+				"'./image.svg' written successfully",
+				"'./image.png' written successfully",
+			},
+		},
+		{
+			name:             "Everything OK - page with embedded solution",
+			expectedExitCode: ExitCodeSuccess,
+			arguments:        []string{"", "create", "--embed-solution", "-o", "./page.html", "-o", "./file.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			dataReaderError:  nil,
+			sudokuInitResult: true,
+			sudokuInitErrors: []error{},
+			solverResult:     true,
+			printContent: []string{
+				"'./page.html' written successfully",
+				"'./file.json' written successfully",
+			},
+		},
+		{
+			name:             "Embedded solution failure",
+			expectedExitCode: ExitCodeSolverFailure,
+			arguments:        []string{"", "create", "--embed-solution", "-o", "./page.html"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			dataReaderError:  nil,
+			sudokuInitResult: true,
+			sudokuInitErrors: []error{},
+			solverResult:     false,
+			printContent: []string{
+				"Failed to solve",
+			},
+		},
 		{
 			name:             "No destination path",
+			expectedExitCode: ExitCodeInvalidInput,
 			arguments:        []string{"", "create", "-s", "3", "--lw", "4", "--lh", "5", "-r"},
 			dataReaderResult: testHelpers.GetTestSudokuDto(),
 			dataReaderError:  nil,
@@ -57,6 +102,7 @@ func TestCreateCommand(t *testing.T) {
 		},
 		{
 			name:             "Sudoku console read fail",
+			expectedExitCode: ExitCodeInvalidInput,
 			arguments:        []string{"", "create", "-s", "3", "--lw", "4", "--lh", "5", "-r", "./relative/path/to/file.json"},
 			dataReaderResult: nil,
 			dataReaderError:  errors.New("failed to read sudoku from console"),
@@ -68,6 +114,7 @@ func TestCreateCommand(t *testing.T) {
 		},
 		{
 			name:             "No valid file save path",
+			expectedExitCode: ExitCodeInvalidInput,
 			arguments:        []string{"", "create", "-s", "3", "--lw", "4", "--lh", "5", "-r", "./relative/path/to/file.badext"},
 			dataReaderResult: testHelpers.GetTestSudokuDto(),
 			dataReaderError:  nil,
@@ -80,6 +127,7 @@ func TestCreateCommand(t *testing.T) {
 		},
 		{
 			name:             "Sudoku initialization error",
+			expectedExitCode: ExitCodeInvalidConfiguration,
 			arguments:        []string{"", "create", "-s", "3", "--lw", "4", "--lh", "5", "-r", "./relative/path/to/file.json"},
 			dataReaderResult: testHelpers.GetTestSudokuDto(),
 			dataReaderError:  nil,
@@ -102,20 +150,24 @@ func TestCreateCommand(t *testing.T) {
 				TerminalPrinter: testPrinter,
 				DataReader:      testHelpers.NewTestDataReader(testCase.dataReaderResult, testCase.dataReaderError),
 				SudokuInit:      testHelpers.NewTestSudokuInit(testCase.sudokuInitResult, testCase.sudokuInitErrors),
+				Solver:          testHelpers.GetNewTestSolver(testCase.solverResult, []error{}),
 				DataWriter:      testHelpers.NewTestDataWriter(true, nil),
+				SudokuFormats:   newTestSudokuFormats(settings),
 			},
 		}
 
 		app := &cli.App{
-			Name: "Kangaroo",
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
 			Commands: []*cli.Command{
 				config.CreateCommand(),
 			},
 		}
 
 		err := app.Run(testCase.arguments)
-		if err != nil {
-			t.Error(err)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
 		}
 
 		printed := false
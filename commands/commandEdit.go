@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/urfave/cli/v2"
+)
+
+// EditCommand provides edit command configuration
+func (commandConfig *CommandContext) EditCommand() *cli.Command {
+	return &cli.Command{
+		Name: "edit",
+		Usage: "Opens sudoku read from the file provided with -i flag in the interactive\n" +
+			"editor, with values and disabled boxes of the file. Edited sudoku is saved to\n" +
+			"the file provided with -o flag (format is chosen by the extension), or to the\n" +
+			"input file if there is no -o flag. Fields of JSON file which are not a part of\n" +
+			"the sudoku (e.g. added by other tools) are kept in the edited file.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input-file",
+				Aliases:     []string{"i"},
+				DefaultText: "",
+				Usage:       "Specify path to sudoku input file (format detected by content or extension)",
+			},
+			&cli.StringFlag{
+				Name:        "output-file",
+				Aliases:     []string{"o"},
+				DefaultText: "",
+				Usage:       "Specify path to file where you want to save edited sudoku (input file by default)",
+			},
+			&alphabetFlag,
+			&overwriteFileFlag,
+		},
+		Action: func(context *cli.Context) error {
+			request := commandConfig.buildEditCommandRequest(context)
+			return commandConfig.editCommandHandler(request)
+		},
+	}
+}
+
+// editCommandHandler is an entry point function for edit command
+func (commandConfig *CommandContext) editCommandHandler(request *models.EditCommandRequest) error {
+	commandConfig.startCommandOutput("edit")
+	defer commandConfig.finishCommandOutput()
+
+	if len(request.InputFile) < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Please provide path to sudoku file with -i flag.")
+	}
+
+	outputPath := request.OutputFile
+	if len(outputPath) < 1 {
+		outputPath = request.InputFile
+	}
+
+	validPaths, err := commandConfig.validateDestinationFilePaths(outputPath)
+	if err != nil {
+		return err
+	}
+
+	// editing the file in place does not require overwrite flag
+	outputPath = validPaths[0]
+	overwrite := request.Overwrite || outputPath == request.InputFile
+
+	sudokuDto, err := commandConfig.ServiceCollection.DataReader.
+		ReadSudokuFromFile(request.InputFile, request.Alphabet)
+	if err != nil {
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	if len(request.Alphabet) > 0 {
+		sudokuDto.Alphabet = request.Alphabet
+	}
+
+	if err := commandConfig.ServiceCollection.Prompter.PromptSudokuValues(sudokuDto, true); err != nil {
+		commandConfig.reportErrors("Failed to edit the sudoku", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	if err := commandConfig.validateEditedSudoku(sudokuDto); err != nil {
+		return err
+	}
+
+	// symbols of edited values are no longer valid
+	sudokuDto.AssignSymbols()
+	if commandConfig.output != nil {
+		commandConfig.output.dto.Sudoku = sudokuDto
+	}
+
+	format := commandConfig.ServiceCollection.SudokuFormats.GetFormatByPath(outputPath)
+	commandConfig.reportLostInformation(format, format.GetLostInformation(sudokuDto))
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Saving results:")
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	if format.Name != sudokuFormats.JsonFormatName {
+		written, err := commandConfig.ServiceCollection.DataWriter.
+			SaveSudokuDtoToFile(sudokuDto, outputPath, format.Name, overwrite)
+		return commandConfig.reportSudokuFileSave(outputPath, written, err)
+	}
+
+	// fields of the input file are kept only if it is a JSON file too,
+	// the file has been read already, so missing data is not an error
+	originalData, _ := commandConfig.ServiceCollection.DataReader.ReadSudokuFileData(request.InputFile)
+
+	written, err := commandConfig.ServiceCollection.DataWriter.SaveDataToFile(outputPath, overwrite,
+		func(writer io.Writer) error {
+			data, err := sudokuFormats.MergeUnknownJsonFields(originalData, sudokuDto)
+			if err != nil {
+				return err
+			}
+
+			_, err = writer.Write(data)
+			return err
+		})
+	return commandConfig.reportSudokuFileSave(outputPath, written, err)
+}
+
+// validateEditedSudoku initializes edited sudoku and prints it. Conflicting values can be
+// confirmed in the editor on purpose, so validation errors are reported as a warning and
+// the sudoku is saved anyway. Returns an error only if the sudoku can not be initialized.
+func (commandConfig *CommandContext) validateEditedSudoku(sudokuDto *models.SudokuDTO) error {
+	sudoku, err := commandConfig.getLibrary().Initialize(sudokuDto)
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		commandConfig.reportValidationErrors(validationError.Errors...)
+		commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary(
+			"The sudoku is saved with the errors above, edit it again to fix them.")
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+		return nil
+	}
+
+	if err != nil {
+		return commandConfig.failCommand(getErrorKind(err, models.ErrInvalidInput),
+			"Failed to initialize the sudoku.")
+	}
+
+	commandConfig.printSudokuConfig(sudoku)
+	commandConfig.printSudoku("Provided sudoku input:", sudoku)
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	return nil
+}
+
+// buildEditCommandRequest retrieves options settings from the command
+// and constructs request object.
+func (commandConfig *CommandContext) buildEditCommandRequest(context *cli.Context) *models.EditCommandRequest {
+	return &models.EditCommandRequest{
+		InputFile:  context.String("input-file"),
+		OutputFile: context.String("output-file"),
+		Alphabet:   context.String(alphabetFlag.Name),
+		Overwrite:  context.Bool(overwriteFileFlag.Name),
+	}
+}
@@ -0,0 +1,166 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestEditCommand(t *testing.T) {
+	// confirmed anyway with two values 5 in the first row
+	conflictingValuesPrompt := func(sudokuDto *models.SudokuDTO) error {
+		value := 5
+		sudokuDto.Boxes[0].Cells[0].Value = &value
+		sudokuDto.Boxes[0].Cells[1].Value = &value
+		return nil
+	}
+
+	testCases := []struct {
+		name              string
+		arguments         []string
+		dataReaderResult  *models.SudokuDTO
+		dataReaderError   error
+		fileData          []byte
+		sudokuPromptError error
+		sudokuPromptFunc  *func(sudokuDto *models.SudokuDTO) error
+		sudokuInitErrors  []error
+		fileWritten       bool
+		printContent      []string
+		expectedValues    []int
+		expectedExitCode  int
+	}{
+		{
+			name:             "No input file",
+			arguments:        []string{"", "edit", "-o", "puzzle.json"},
+			printContent:     []string{"Please provide path to sudoku file with -i flag."},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Unsupported output file",
+			arguments:        []string{"", "edit", "-i", "puzzle.json", "-o", "puzzle.pdf"},
+			printContent:     []string{"No supported file path to save sudoku data to."},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid input file",
+			arguments:        []string{"", "edit", "-i", "puzzle.json"},
+			dataReaderError:  models.WithKind(models.ErrIO, errors.New("sudoku input data file does not exist")),
+			printContent:     []string{"Invalid sudoku input", "Sudoku input data file does not exist"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:              "Editor failure",
+			arguments:         []string{"", "edit", "-i", "puzzle.json"},
+			dataReaderResult:  testHelpers.GetTestSudokuDto(),
+			sudokuPromptError: errors.New("failed to get sudoku values from manual input"),
+			printContent:      []string{"Failed to edit the sudoku", "Failed to get sudoku values from manual input"},
+			expectedExitCode:  ExitCodeInvalidInput,
+		},
+		{
+			name:             "Conflicting edited sudoku",
+			arguments:        []string{"", "edit", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileData:         []byte(`{"boxes": []}`),
+			sudokuPromptFunc: &conflictingValuesPrompt,
+			sudokuInitErrors: []error{errors.New("duplicated value in the row")},
+			fileWritten:      true,
+			printContent: []string{"Duplicated value in the row", "The sudoku is saved with the errors above",
+				"'puzzle.json' written successfully"},
+			expectedValues:   []int{5, 5},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Saved to the input file",
+			arguments:        []string{"", "edit", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileData:         []byte(`{"author": "Jane", "boxes": []}`),
+			fileWritten:      true,
+			printContent:     []string{"Provided sudoku input:", "'puzzle.json' written successfully"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Saved to the output file in other format",
+			arguments:        []string{"", "edit", "-i", "puzzle.json", "-o", "puzzle.txt"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileWritten:      true,
+			printContent:     []string{"'puzzle.txt' written successfully"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Output file already exists",
+			arguments:        []string{"", "edit", "-i", "puzzle.txt", "-o", "edited"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileWritten:      false,
+			printContent:     []string{"'edited.json' already exists (ommited)"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		testPrinter := testHelpers.NewTestPrinter()
+		dataReader := testHelpers.NewTestDataReader(testCase.dataReaderResult, testCase.dataReaderError)
+		dataReader.FileData = testCase.fileData
+
+		dataWriter := testHelpers.NewTestDataWriter(testCase.fileWritten, nil)
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+				TerminalPrinter: testPrinter,
+				DataReader:      dataReader,
+				DataWriter:      dataWriter,
+				SudokuInit:      testHelpers.NewTestSudokuInit(true, testCase.sudokuInitErrors),
+				Prompter: testHelpers.GetNewTestPrompter(&testHelpers.TestPrompterConfig{
+					SudokuPromptError: testCase.sudokuPromptError,
+					SudokuPromptFunc:  testCase.sudokuPromptFunc,
+				}),
+				SudokuFormats: newTestSudokuFormats(settings),
+			},
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.EditCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedPrintout := range testCase.printContent {
+			if !strings.Contains(testPrinter.PrintedData, expectedPrintout) {
+				t.Errorf("%s: Console printout is missing the following: '%s'",
+					testCase.name, expectedPrintout)
+			}
+		}
+
+		if len(testCase.expectedValues) > 0 {
+			savedSudoku := &models.SudokuDTO{}
+			if err := json.Unmarshal(dataWriter.SavedData, savedSudoku); err != nil {
+				t.Errorf("%s: Edited sudoku was not saved (%s)", testCase.name, err)
+				continue
+			}
+
+			for index, expectedValue := range testCase.expectedValues {
+				value := savedSudoku.Boxes[0].Cells[index].Value
+				if value == nil || *value != expectedValue {
+					t.Errorf("%s: Expected saved value %d in cell %d, got %v",
+						testCase.name, expectedValue, index, value)
+				}
+			}
+		}
+	}
+}
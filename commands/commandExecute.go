@@ -1,6 +1,23 @@
 package commands
 
-import "github.com/urfave/cli/v2"
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"runtime"
+	"slices"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/urfave/cli/v2"
+)
+
+var supportedEncodings = []models.BinaryEncoding{
+	models.StdBase64Encoding,
+	models.URLBase64Encoding,
+	models.RawBinaryEncoding,
+}
 
 func (commandConfig *CommandContext) ExecuteCommand() *cli.Command {
 	return &cli.Command{
@@ -8,63 +25,146 @@ func (commandConfig *CommandContext) ExecuteCommand() *cli.Command {
 		Aliases: []string{"e"},
 		Usage: "Solves a sudoku puzzle provided through argument as base64 representation\n" +
 			"of sudoku binary data and outputs similarly encoded solution to the terminal.\n" +
+			"If there is no argument or the argument is -, data is read from standard input.\n" +
 			"You can find more about this format here:\nhttps://github.com/Michu8258/kangaroo/blob/main/documentation/binaryFormat.md",
-		Flags: []cli.Flag{},
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "encoding",
+				Aliases: []string{"e"},
+				Value:   string(models.StdBase64Encoding),
+				Usage:   "Encoding of input and output sudoku binary data: std (base64), url (URL-safe base64) or raw (binary bytes)",
+			},
+			&cli.BoolFlag{
+				Name:  "batch",
+				Usage: "Read one sudoku per line from standard input and write one result per line in the same order",
+			},
+			&cli.IntFlag{
+				Name:        "workers",
+				Value:       runtime.NumCPU(),
+				DefaultText: "number of CPUs",
+				Usage:       "Number of sudokus solved concurrently in batch mode",
+			},
+			&timeoutFlag,
+		},
 		Action: func(context *cli.Context) error {
-			return commandConfig.executeCommandHandler(context.Args())
+			request := commandConfig.buildExecuteCommandRequest(context)
+			return commandConfig.executeCommandHandler(context.Context, request)
 		},
 	}
 }
 
 // executeCommandHandler is an entry point function for exec sudoku command
-func (commandConfig *CommandContext) executeCommandHandler(arguments cli.Args) error {
-	if arguments.Len() < 1 {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(
-			"Please provide a base64 representation of a sudoku to this command.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
+func (commandConfig *CommandContext) executeCommandHandler(ctx context.Context,
+	request *models.ExecuteCommandRequest) error {
+	commandConfig.startCommandOutput("exec")
+	defer commandConfig.finishCommandOutput()
+
+	if !slices.Contains(supportedEncodings, request.Encoding) {
+		return commandConfig.failCommand(models.ErrInvalidInput, fmt.Sprintf(
+			"Encoding '%s' is not supported, use one of: std, url, raw.", request.Encoding))
+	}
+
+	if request.Batch {
+		return commandConfig.executeBatchHandler(ctx, request)
+	}
+
+	inputData, err := commandConfig.getExecuteInputData(request)
+	if err != nil {
+		return commandConfig.failCommand(models.ErrIO, "Failed to read sudoku data from standard input.")
+	}
+
+	if len(inputData) < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Please provide a sudoku data to this command as an argument or through standard input.")
 	}
 
-	sudokuDto, err := commandConfig.ServiceCollection.SudokuEncoder.ReadFromBase64(
-		arguments.First())
+	sudokuDto, err := commandConfig.getLibrary().Decode(inputData, request.Encoding)
+	if err != nil {
+		return commandConfig.failCommand(models.ErrInvalidInput, fmt.Sprintf(
+			"Failed to parse provided data to sudoku object: %s.", err))
+	}
 
+	solutionContext, cancel := withSolutionTimeout(ctx, request.Timeout)
+	defer cancel()
+
+	solution, err := commandConfig.solveSudoku(solutionContext, sudokuDto)
 	if err != nil {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(
-			"Failed to parse provided data to sudoku object.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
+		return commandConfig.failSolution(err)
 	}
 
-	sudoku, ok := commandConfig.executeSudokuInitialization(sudokuDto, false)
-	if !ok {
-		return nil
+	err = commandConfig.writeSudokuSolution(solution, request.Encoding)
+	if err != nil {
+		return commandConfig.failCommand(models.ErrIO, "Failed to encode output sudoku solution.")
 	}
 
-	solved, errs := commandConfig.ServiceCollection.Solver.Solve(sudoku)
-	if !solved {
-		commandConfig.ServiceCollection.TerminalPrinter.
-			PrintError("Failed to solve the sudoku.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
+	return nil
+}
+
+// getExecuteInputData returns sudoku data passed as an argument or read from standard input.
+// Surrounding whitespaces are removed from base64 encoded data.
+func (commandConfig *CommandContext) getExecuteInputData(request *models.ExecuteCommandRequest) ([]byte, error) {
+	inputData := []byte{}
+
+	if request.Input != nil {
+		inputData = []byte(*request.Input)
+	} else if commandConfig.Stdin != nil {
+		stdinData, err := io.ReadAll(commandConfig.Stdin)
+		if err != nil {
+			return nil, err
+		}
+		inputData = stdinData
 	}
 
-	if commandConfig.Settings.UseDebugPrints && len(errs) >= 1 {
-		commandConfig.ServiceCollection.DataPrinter.PrintErrors(
-			"Sudoku solution failure reasons:", err)
-		return nil
+	if request.Encoding != models.RawBinaryEncoding {
+		inputData = []byte(strings.TrimSpace(string(inputData)))
 	}
 
-	solutionBase64, err := commandConfig.ServiceCollection.SudokuEncoder.ToBase64(
-		sudoku.ToSudokuDto())
+	return inputData, nil
+}
+
+// writeSudokuSolution encodes the solution according to provided encoding and prints it.
+// Raw binary data is written directly to standard output, without any styling.
+func (commandConfig *CommandContext) writeSudokuSolution(sudokuDto *models.SudokuDTO,
+	encoding models.BinaryEncoding) error {
 
+	solution, err := commandConfig.getLibrary().Encode(sudokuDto, encoding)
 	if err != nil {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(
-			"Failed to encode output sudoku solution.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
+		return err
+	}
+
+	if commandConfig.output != nil {
+		commandConfig.output.dto.EncodedSolution = string(solution)
+		if encoding == models.RawBinaryEncoding {
+			commandConfig.output.dto.EncodedSolution = base64.StdEncoding.EncodeToString(solution)
+		}
 	}
 
-	commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(solutionBase64)
+	if encoding == models.RawBinaryEncoding {
+		if !commandConfig.Settings.IsConsoleSilent() && commandConfig.Stdout != nil {
+			_, err = commandConfig.Stdout.Write(solution)
+		}
+		return err
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(string(solution))
 	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
 	return nil
 }
+
+// buildExecuteCommandRequest retrieves options settings and argument from the
+// command and constructs request object.
+func (commandConfig *CommandContext) buildExecuteCommandRequest(context *cli.Context) *models.ExecuteCommandRequest {
+	request := &models.ExecuteCommandRequest{
+		Encoding: models.BinaryEncoding(context.String("encoding")),
+		Batch:    context.Bool("batch"),
+		Workers:  context.Int("workers"),
+		Timeout:  context.Duration(timeoutFlag.Name),
+	}
+
+	if context.Args().Len() >= 1 && context.Args().First() != "-" {
+		input := context.Args().First()
+		request.Input = &input
+	}
+
+	return request
+}
@@ -0,0 +1,229 @@
+package commands
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// maximum length of single line of batch input, big enough for samurai sudokus with big boxes
+const batchLineMaximumLength = 16 * 1024 * 1024
+
+// Types of errors reported for single sudoku of a batch
+const (
+	batchErrorInvalidInput         = "invalid_input"
+	batchErrorInvalidConfiguration = "invalid_configuration"
+	batchErrorUnsolvable           = "unsolvable"
+	batchErrorSolverFailure        = "solver_failure"
+	batchErrorEncodingFailure      = "encoding_failure"
+)
+
+// Kinds of errors matching types of errors of single sudoku of a batch
+var batchErrorKinds = map[string]error{
+	batchErrorInvalidInput:         models.ErrInvalidInput,
+	batchErrorInvalidConfiguration: models.ErrInvalidConfiguration,
+	batchErrorUnsolvable:           models.ErrUnsolvable,
+	batchErrorSolverFailure:        models.ErrSolverFailure,
+	batchErrorEncodingFailure:      models.ErrIO,
+}
+
+// batchJob is a sudoku of a batch, index is the position in the output
+// and line is the number of the line of the input
+type batchJob struct {
+	index int
+	line  int
+	data  string
+}
+
+type batchResult struct {
+	index  int
+	result *models.BatchResultDTO
+}
+
+// executeBatchHandler solves sudokus read from standard input, one per line, with a pool
+// of workers. Results are written to standard output, one per line, in order of input
+// lines, blank lines are skipped. Result of a line is encoded solution or JSON object describing the error, so
+// single invalid sudoku does not stop processing of the others. In JSON output format
+// results are a part of the command output document instead. If any of the sudokus
+// failed, returned error has kind matching the first failed line.
+func (commandConfig *CommandContext) executeBatchHandler(ctx context.Context,
+	request *models.ExecuteCommandRequest) error {
+	if request.Encoding == models.RawBinaryEncoding {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Raw encoding cannot be used in batch mode, use std or url encoding.")
+	}
+
+	if request.Workers < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput, fmt.Sprintf(
+			"Workers count has a value of %d, but it is expected to be at least 1.", request.Workers))
+	}
+
+	if commandConfig.Stdin == nil {
+		return nil
+	}
+
+	jobs := make(chan batchJob, request.Workers)
+	results := make(chan batchResult, request.Workers)
+	workersGroup := sync.WaitGroup{}
+
+	for i := 0; i < request.Workers; i++ {
+		workersGroup.Add(1)
+		go func() {
+			defer workersGroup.Done()
+			for job := range jobs {
+				results <- batchResult{
+					index:  job.index,
+					result: commandConfig.solveBatchSudoku(ctx, job, request.Encoding, request.Timeout),
+				}
+			}
+		}()
+	}
+
+	var scanErr error
+	go func() {
+		scanner := bufio.NewScanner(commandConfig.Stdin)
+		scanner.Buffer(make([]byte, 0, 64*1024), batchLineMaximumLength)
+
+		index := 0
+		line := 0
+		for scanner.Scan() {
+			line++
+			// blank lines (e.g. at the end of the input) have no results
+			if len(strings.TrimSpace(scanner.Text())) < 1 {
+				continue
+			}
+
+			jobs <- batchJob{index: index, line: line, data: scanner.Text()}
+			index++
+		}
+
+		scanErr = scanner.Err()
+		close(jobs)
+		workersGroup.Wait()
+		close(results)
+	}()
+
+	// results are buffered until all results of preceding lines are written
+	pendingResults := map[int]*models.BatchResultDTO{}
+	nextIndex := 0
+	var firstFailure *models.BatchResultDTO
+	for result := range results {
+		pendingResults[result.index] = result.result
+		for output, ok := pendingResults[nextIndex]; ok; output, ok = pendingResults[nextIndex] {
+			if firstFailure == nil && len(output.Error) > 0 {
+				firstFailure = output
+			}
+
+			commandConfig.writeBatchOutput(output)
+			delete(pendingResults, nextIndex)
+			nextIndex++
+		}
+	}
+
+	if scanErr != nil {
+		return commandConfig.failCommand(models.ErrIO, "Failed to read sudoku data from standard input.")
+	}
+
+	if firstFailure != nil {
+		return newExitError(models.WithKind(batchErrorKinds[firstFailure.Error], fmt.Errorf(
+			"line %d: %s", firstFailure.Line, firstFailure.Message)))
+	}
+
+	return nil
+}
+
+// solveBatchSudoku decodes, initializes and solves single sudoku of a batch. Returns
+// result with encoded solution or an error. Nothing is printed.
+func (commandConfig *CommandContext) solveBatchSudoku(ctx context.Context, job batchJob,
+	encoding models.BinaryEncoding, timeout time.Duration) *models.BatchResultDTO {
+
+	data := strings.TrimSpace(job.data)
+	sudokuDto, err := commandConfig.getLibrary().Decode([]byte(data), encoding)
+	if err != nil {
+		return formatBatchError(job, batchErrorInvalidInput, err)
+	}
+
+	solutionContext, cancel := withSolutionTimeout(ctx, timeout)
+	defer cancel()
+
+	solution, err := commandConfig.getLibrary().Solve(solutionContext, sudokuDto)
+	if err != nil {
+		errorType, err := getBatchSolutionError(err)
+		return formatBatchError(job, errorType, err)
+	}
+
+	encodedSolution, err := commandConfig.getLibrary().Encode(solution, encoding)
+	if err != nil {
+		return formatBatchError(job, batchErrorEncodingFailure, err)
+	}
+
+	return &models.BatchResultDTO{
+		Line:     job.line,
+		Solution: string(encodedSolution),
+	}
+}
+
+// getBatchSolutionError returns type of the batch error and the error describing
+// failed solution of single sudoku of a batch
+func getBatchSolutionError(err error) (string, error) {
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		return batchErrorInvalidConfiguration, errors.Join(validationError.Errors...)
+	}
+
+	solutionError := &library.SolutionError{}
+	if !errors.As(err, &solutionError) {
+		return batchErrorSolverFailure, err
+	}
+
+	errorType := batchErrorSolverFailure
+	if errors.Is(err, models.ErrUnsolvable) {
+		errorType = batchErrorUnsolvable
+	}
+
+	err = errors.Join(solutionError.Errors...)
+	if err == nil {
+		err = errors.New("failed to solve the sudoku")
+	}
+
+	return errorType, err
+}
+
+// formatBatchError creates result of single sudoku of a batch describing an error
+func formatBatchError(job batchJob, errorType string, err error) *models.BatchResultDTO {
+	return &models.BatchResultDTO{
+		Line:    job.line,
+		Error:   errorType,
+		Message: strings.ReplaceAll(err.Error(), "\n", "; "),
+	}
+}
+
+// writeBatchOutput writes single line of batch result to standard output, which is
+// encoded solution or JSON representation of an error. In JSON output format the
+// result is added to the command output.
+func (commandConfig *CommandContext) writeBatchOutput(result *models.BatchResultDTO) {
+	if commandConfig.output != nil && commandConfig.Settings.OutputFormat == models.JSONOutputFormat {
+		commandConfig.output.dto.BatchResults = append(commandConfig.output.dto.BatchResults, result)
+		return
+	}
+
+	if commandConfig.Settings.IsConsoleSilent() || commandConfig.Stdout == nil {
+		return
+	}
+
+	if len(result.Error) > 0 {
+		errorJson, _ := json.Marshal(result)
+		fmt.Fprintln(commandConfig.Stdout, string(errorJson))
+		return
+	}
+
+	fmt.Fprintln(commandConfig.Stdout, result.Solution)
+}
@@ -1,12 +1,23 @@
 package commands
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
 	"errors"
+	"io"
 	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
+	"github.com/Michu8258/kangaroo/internal/library"
 	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	crook "github.com/Michu8258/kangaroo/services/crookMethodSolver"
 	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
 	"github.com/Michu8258/kangaroo/testHelpers"
 	"github.com/urfave/cli/v2"
 )
@@ -22,10 +33,16 @@ func TestExecuteCommand(t *testing.T) {
 		sudokuInitErrors     []error
 		sudokuSolutionResult bool
 		sudokuSolutionErrors []error
+		sudokuUnsolvable     bool
+		sudokuSolverWaits    bool
 		printContent         []string
+		expectedExitCode     int
+		stdin                string
+		stdoutContent        []byte
 	}{
 		{
 			name:                 "No data argument",
+			expectedExitCode:     ExitCodeInvalidInput,
 			arguments:            []string{"", "exec"},
 			decodeHasError:       nil,
 			encodeToBytesError:   nil,
@@ -38,6 +55,7 @@ func TestExecuteCommand(t *testing.T) {
 		},
 		{
 			name:                 "Base64 parse error",
+			expectedExitCode:     ExitCodeInvalidInput,
 			arguments:            []string{"", "exec", "base64Config"},
 			decodeHasError:       errors.New("decode error"),
 			encodeToBytesError:   nil,
@@ -50,6 +68,7 @@ func TestExecuteCommand(t *testing.T) {
 		},
 		{
 			name:                 "Sudoku init error",
+			expectedExitCode:     ExitCodeInvalidConfiguration,
 			arguments:            []string{"", "exec", "base64Config"},
 			decodeHasError:       nil,
 			encodeToBytesError:   nil,
@@ -62,6 +81,7 @@ func TestExecuteCommand(t *testing.T) {
 		},
 		{
 			name:                 "Sudoku solution error",
+			expectedExitCode:     ExitCodeSolverFailure,
 			arguments:            []string{"", "exec", "base64Config"},
 			decodeHasError:       nil,
 			encodeToBytesError:   nil,
@@ -72,8 +92,26 @@ func TestExecuteCommand(t *testing.T) {
 			sudokuSolutionErrors: []error{errors.New("sudoku solve error")},
 			printContent:         []string{"Failed to solve"},
 		},
+		{
+			name:                 "Unsolvable sudoku",
+			expectedExitCode:     ExitCodeUnsolvable,
+			arguments:            []string{"", "exec", "base64Config"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: false,
+			sudokuUnsolvable:     true,
+			printContent:         []string{"The sudoku has no solution"},
+		},
+		{
+			name:              "Solution timeout",
+			expectedExitCode:  ExitCodeSolverFailure,
+			arguments:         []string{"", "exec", "--timeout", "10ms", "base64Config"},
+			sudokuInitResult:  true,
+			sudokuSolverWaits: true,
+			printContent:      []string{"exceeded the time limit"},
+		},
 		{
 			name:                 "Solution encode error",
+			expectedExitCode:     ExitCodeIOFailure,
 			arguments:            []string{"", "exec", "base64Config"},
 			decodeHasError:       nil,
 			encodeToBytesError:   nil,
@@ -86,6 +124,7 @@ func TestExecuteCommand(t *testing.T) {
 		},
 		{
 			name:                 "Success",
+			expectedExitCode:     ExitCodeSuccess,
 			arguments:            []string{"", "exec", "base64Config"},
 			decodeHasError:       nil,
 			encodeToBytesError:   nil,
@@ -96,12 +135,93 @@ func TestExecuteCommand(t *testing.T) {
 			sudokuSolutionErrors: []error{},
 			printContent:         []string{""},
 		},
+		{
+			name:                 "Standard input data",
+			expectedExitCode:     ExitCodeSuccess,
+			arguments:            []string{"", "exec", "-"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{""},
+			stdin:                "base64Config\n",
+		},
+		{
+			name:                 "Standard input data with no argument",
+			expectedExitCode:     ExitCodeSuccess,
+			arguments:            []string{"", "exec"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{""},
+			stdin:                "base64Config",
+		},
+		{
+			name:                 "Empty standard input",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "exec", "-"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"Please provide"},
+			stdin:                " \n",
+		},
+		{
+			name:                 "URL-safe base64 encoding",
+			expectedExitCode:     ExitCodeSuccess,
+			arguments:            []string{"", "exec", "--encoding", "url", "base64Config"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{""},
+		},
+		{
+			name:                 "Raw binary encoding",
+			expectedExitCode:     ExitCodeSuccess,
+			arguments:            []string{"", "exec", "--encoding", "raw"},
+			encodeToBytesError:   nil,
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{},
+			stdin:                "\x00\x02\x03",
+			stdoutContent:        []byte{0, 2},
+		},
+		{
+			name:                 "Raw binary encoding error",
+			expectedExitCode:     ExitCodeIOFailure,
+			arguments:            []string{"", "exec", "-e", "raw", "-"},
+			encodeToBytesError:   errors.New("solution encoding error"),
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"Failed to encode output"},
+			stdin:                "\x00\x02\x03",
+		},
+		{
+			name:                 "Batch with raw encoding",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "exec", "--batch", "--encoding", "raw"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"Raw encoding cannot be used in batch mode"},
+		},
+		{
+			name:                 "Batch with invalid workers count",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "exec", "--batch", "--workers", "0"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"Workers count has a value of 0"},
+		},
+		{
+			name:                 "Unsupported encoding",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "exec", "--encoding", "hex", "base64Config"},
+			sudokuInitResult:     true,
+			sudokuSolutionResult: true,
+			printContent:         []string{"Encoding 'hex' is not supported"},
+		},
 	}
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
 		settings.UseDebugPrints = true
 		testPrinter := testHelpers.NewTestPrinter()
+		stdout := &bytes.Buffer{}
 
 		config := &CommandContext{
 			Settings: settings,
@@ -112,21 +232,34 @@ func TestExecuteCommand(t *testing.T) {
 					testCase.sudokuInitResult, testCase.sudokuInitErrors),
 				SudokuEncoder: testHelpers.NewTestBinarySudokuManager(
 					testCase.decodeHasError, testCase.encodeToBase64Error, testCase.encodeToBytesError),
-				Solver: testHelpers.GetNewTestSolver(
-					testCase.sudokuSolutionResult, testCase.sudokuSolutionErrors),
+				Solver: &testHelpers.TestSolver{
+					Result:         testCase.sudokuSolutionResult,
+					Unsolvable:     testCase.sudokuUnsolvable,
+					Errors:         testCase.sudokuSolutionErrors,
+					WaitForContext: testCase.sudokuSolverWaits,
+				},
 			},
+			Stdin:  strings.NewReader(testCase.stdin),
+			Stdout: stdout,
 		}
 
 		app := &cli.App{
-			Name: "Kangaroo",
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
 			Commands: []*cli.Command{
 				config.ExecuteCommand(),
 			},
 		}
 
 		err := app.Run(testCase.arguments)
-		if err != nil {
-			t.Error(err)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		if testCase.stdoutContent != nil && !bytes.Equal(stdout.Bytes(), testCase.stdoutContent) {
+			t.Errorf("%s: Standard output content is %v, expected %v",
+				testCase.name, stdout.Bytes(), testCase.stdoutContent)
 		}
 
 		printed := false
@@ -142,3 +275,195 @@ func TestExecuteCommand(t *testing.T) {
 		}
 	}
 }
+
+func TestExecuteCommand_CancelledSolution(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+
+	config := &CommandContext{
+		Settings: settings,
+		ServiceCollection: &services.ServiceCollection{
+			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			TerminalPrinter: testPrinter,
+			SudokuInit:      testHelpers.NewTestSudokuInit(true, []error{}),
+			SudokuEncoder:   testHelpers.NewTestBinarySudokuManager(nil, nil, nil),
+			Solver:          &testHelpers.TestSolver{WaitForContext: true},
+		},
+	}
+
+	app := &cli.App{
+		Name:           "Kangaroo",
+		ExitErrHandler: func(context *cli.Context, err error) {},
+		Commands: []*cli.Command{
+			config.ExecuteCommand(),
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	err := app.RunContext(ctx, []string{"", "exec", "base64Config"})
+	if GetExitCode(err) != ExitCodeSolverFailure {
+		t.Errorf("Expected exit code %d, got %d (%v)", ExitCodeSolverFailure, GetExitCode(err), err)
+	}
+
+	if !strings.Contains(testPrinter.PrintedData, "Solution of the sudoku was cancelled") {
+		t.Errorf("Console printout is missing cancellation message: '%s'", testPrinter.PrintedData)
+	}
+}
+
+func TestExecuteCommand_Batch(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+	stdout := &bytes.Buffer{}
+
+	validSudoku := "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+	// two values 6 in the first box
+	invalidSudoku := "AAEDAwP/gAYGAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+	// blank lines are skipped, including the trailing one
+	input := strings.Join([]string{validSudoku, "not base64", invalidSudoku, "", validSudoku, "  ", ""}, "\n")
+
+	config := &CommandContext{
+		Settings: settings,
+		ServiceCollection: &services.ServiceCollection{
+			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			TerminalPrinter: testPrinter,
+			SudokuInit:      sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()),
+			SudokuEncoder:   binarySudokuManager.GetNewBinarySudokuManager(settings),
+			Solver:          crook.GetNewSudokuSolver(settings, logger.NewDiscardLogger()),
+		},
+		Stdin:  strings.NewReader(input),
+		Stdout: stdout,
+	}
+
+	app := &cli.App{
+		Name:           "Kangaroo",
+		ExitErrHandler: func(context *cli.Context, err error) {},
+		Commands: []*cli.Command{
+			config.ExecuteCommand(),
+		},
+	}
+
+	// the first failed line decides about exit code
+	err := app.Run([]string{"", "exec", "--batch", "--workers", "3"})
+	if GetExitCode(err) != ExitCodeInvalidInput {
+		t.Errorf("Expected exit code %d, got %d (%v)", ExitCodeInvalidInput, GetExitCode(err), err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n")
+	expectedLines := []string{"", `"line":2,"error":"invalid_input"`,
+		`"line":3,"error":"invalid_configuration"`, ""}
+
+	if len(lines) != len(expectedLines) {
+		t.Fatalf("Expected %d result lines, got %d: %v", len(expectedLines), len(lines), lines)
+	}
+
+	for index, expectedLine := range expectedLines {
+		if !strings.Contains(lines[index], expectedLine) {
+			t.Errorf("Result line %d is '%s', expected to contain '%s'", index+1, lines[index], expectedLine)
+		}
+	}
+
+	for _, index := range []int{0, 3} {
+		_, err := config.ServiceCollection.SudokuEncoder.ReadFromBase64(lines[index])
+		if err != nil || lines[index] == validSudoku {
+			t.Errorf("Result line %d is not a valid sudoku solution: '%s'", index+1, lines[index])
+		}
+	}
+}
+
+func TestExecuteCommand_BatchReadFailure(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+	stdout := &bytes.Buffer{}
+
+	validSudoku := "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+
+	config := &CommandContext{
+		Settings: settings,
+		ServiceCollection: &services.ServiceCollection{
+			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			TerminalPrinter: testPrinter,
+			SudokuInit:      sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()),
+			SudokuEncoder:   binarySudokuManager.GetNewBinarySudokuManager(settings),
+			Solver:          crook.GetNewSudokuSolver(settings, logger.NewDiscardLogger()),
+		},
+		Stdin:  io.MultiReader(strings.NewReader(validSudoku+"\n"), iotest.ErrReader(errors.New("read error"))),
+		Stdout: stdout,
+	}
+
+	app := &cli.App{
+		Name:           "Kangaroo",
+		ExitErrHandler: func(context *cli.Context, err error) {},
+		Commands: []*cli.Command{
+			config.ExecuteCommand(),
+		},
+	}
+
+	// lines read before the failure are still solved
+	err := app.Run([]string{"", "exec", "--batch"})
+	if GetExitCode(err) != ExitCodeIOFailure {
+		t.Errorf("Expected exit code %d, got %d (%v)", ExitCodeIOFailure, GetExitCode(err), err)
+	}
+
+	if !strings.Contains(testPrinter.PrintedData, "Failed to read sudoku data from standard input.") {
+		t.Errorf("Console printout is missing read failure: '%s'", testPrinter.PrintedData)
+	}
+
+	if lines := strings.Split(strings.TrimSuffix(stdout.String(), "\n"), "\n"); len(lines) != 1 {
+		t.Errorf("Expected 1 result line, got %d: %v", len(lines), lines)
+	}
+}
+
+func TestExecuteCommand_Version1RoundTrip(t *testing.T) {
+	settings := library.DefaultSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+
+	config := &CommandContext{
+		Settings: settings,
+		ServiceCollection: &services.ServiceCollection{
+			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			TerminalPrinter: testPrinter,
+			SudokuInit:      sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()),
+			SudokuEncoder:   binarySudokuManager.GetNewBinarySudokuManager(settings),
+			Solver:          crook.GetNewSudokuSolver(settings, logger.NewDiscardLogger()),
+		},
+	}
+
+	app := &cli.App{
+		Name:           "Kangaroo",
+		ExitErrHandler: func(context *cli.Context, err error) {},
+		Commands: []*cli.Command{
+			config.ExecuteCommand(),
+		},
+	}
+
+	input := "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+	err := app.Run([]string{"", "exec", input})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	output, err := base64.StdEncoding.DecodeString(strings.TrimSpace(testPrinter.PrintedData))
+	if err != nil {
+		t.Fatalf("Output is not base64 encoded: '%s'", testPrinter.PrintedData)
+	}
+
+	// version 1 data has no checksum, so length of solution is the same as of input
+	if len(output) != 88 || output[0] != 0 || output[1] != 1 {
+		t.Errorf("Expected version 1 solution of 88 bytes, got %v", output)
+	}
+
+	solution, err := config.ServiceCollection.SudokuEncoder.ReadFromBytes(output)
+	if err != nil {
+		t.Fatalf("Output is not a valid sudoku: %v", err)
+	}
+
+	for _, box := range solution.Boxes {
+		for _, cell := range box.Cells {
+			if cell.Value == nil {
+				t.Fatal("Expected all cells of the solution to have values")
+			}
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+type commandOutput struct {
+	dto       *models.CommandOutputDTO
+	startTime time.Time
+}
+
+// startCommandOutput starts collecting machine-readable result of the command
+func (commandConfig *CommandContext) startCommandOutput(command string) {
+	commandConfig.output = &commandOutput{
+		dto: &models.CommandOutputDTO{
+			Command:          command,
+			Result:           models.Unspecified,
+			ValidationErrors: []string{},
+			Errors:           []string{},
+			SavedFiles:       []string{},
+		},
+		startTime: time.Now(),
+	}
+}
+
+// finishCommandOutput writes collected result of the command to standard output
+// as JSON document, if JSON output format is selected
+func (commandConfig *CommandContext) finishCommandOutput() {
+	output := commandConfig.output
+	commandConfig.output = nil
+
+	if output == nil || commandConfig.Settings.OutputFormat != models.JSONOutputFormat ||
+		commandConfig.Stdout == nil {
+		return
+	}
+
+	output.dto.Timing.TotalMilliseconds = toMilliseconds(time.Since(output.startTime))
+
+	encoder := json.NewEncoder(commandConfig.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(output.dto)
+}
+
+// reportError prints the error message and adds it to the command output
+func (commandConfig *CommandContext) reportError(message string) {
+	commandConfig.ServiceCollection.TerminalPrinter.PrintError(message)
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	if commandConfig.output != nil {
+		commandConfig.output.dto.Errors = append(commandConfig.output.dto.Errors, message)
+	}
+}
+
+// reportErrors prints errors with the description and adds them to the command output
+func (commandConfig *CommandContext) reportErrors(description string, errs ...error) {
+	commandConfig.ServiceCollection.DataPrinter.PrintErrors(description, errs...)
+
+	if commandConfig.output != nil {
+		for _, err := range errs {
+			if err != nil {
+				commandConfig.output.dto.Errors = append(commandConfig.output.dto.Errors, err.Error())
+			}
+		}
+	}
+}
+
+// reportValidationErrors prints sudoku configuration validation errors
+// and adds them to the command output
+func (commandConfig *CommandContext) reportValidationErrors(errs ...error) {
+	commandConfig.ServiceCollection.DataPrinter.PrintErrors(
+		"Invalid sudoku configuration:", errs...)
+
+	if commandConfig.output != nil {
+		for _, err := range errs {
+			commandConfig.output.dto.ValidationErrors = append(
+				commandConfig.output.dto.ValidationErrors, err.Error())
+		}
+	}
+}
+
+// solveSudoku solves the sudoku with the library and stores result type, solution
+// and timing in the command output
+func (commandConfig *CommandContext) solveSudoku(ctx context.Context,
+	sudokuDto *models.SudokuDTO) (*models.SudokuDTO, error) {
+
+	startTime := time.Now()
+	solution, err := commandConfig.getLibrary().Solve(ctx, sudokuDto)
+	commandConfig.storeSolution(startTime, solution, err)
+
+	return solution, err
+}
+
+// solveInitializedSudoku solves initialized sudoku in place with the library and stores
+// result type, solution and timing in the command output
+func (commandConfig *CommandContext) solveInitializedSudoku(ctx context.Context,
+	sudoku *models.Sudoku) error {
+
+	startTime := time.Now()
+	err := commandConfig.getLibrary().SolveInitialized(ctx, sudoku)
+
+	var solution *models.SudokuDTO
+	if err == nil {
+		solution = sudoku.ToSudokuDto()
+	}
+
+	commandConfig.storeSolution(startTime, solution, err)
+	return err
+}
+
+// storeSolution stores result type, solution and timing of the solution started
+// at provided time in the command output
+func (commandConfig *CommandContext) storeSolution(startTime time.Time,
+	solution *models.SudokuDTO, err error) {
+
+	if commandConfig.output != nil {
+		commandConfig.output.dto.Timing.SolutionMilliseconds = toMilliseconds(time.Since(startTime))
+		commandConfig.output.dto.Result = getSolutionResult(err)
+		commandConfig.output.dto.Sudoku = solution
+	}
+}
+
+// getSolutionResult returns result type of the solution which ended with provided error
+func getSolutionResult(err error) models.SudokuResultType {
+	if err == nil {
+		return models.SuccessfullSolution
+	}
+
+	solutionError := &library.SolutionError{}
+	if errors.As(err, &solutionError) {
+		return solutionError.Result
+	}
+
+	return models.Unspecified
+}
+
+// toMilliseconds converts duration to fractional amount of milliseconds
+func toMilliseconds(duration time.Duration) float64 {
+	return float64(duration.Microseconds()) / 1000
+}
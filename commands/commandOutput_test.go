@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestCommandOutput_Json(t *testing.T) {
+	testCases := []struct {
+		name                     string
+		arguments                []string
+		outputFormat             string
+		decodeError              error
+		sudokuInitErrors         []error
+		sudokuSolutionResult     bool
+		expectedResult           models.SudokuResultType
+		expectedSudoku           bool
+		expectedValidationErrors int
+		expectedErrors           int
+		expectedSavedFiles       []string
+		expectedExitCode         int
+	}{
+		{
+			name:                 "Solve success",
+			arguments:            []string{"", "solve", "-i", "input.json", "-o", "solution.txt"},
+			outputFormat:         models.JSONOutputFormat,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			expectedResult:       models.SuccessfullSolution,
+			expectedSudoku:       true,
+			expectedSavedFiles:   []string{"solution.txt"},
+		},
+		{
+			name:                     "Solve validation errors",
+			expectedExitCode:         ExitCodeInvalidConfiguration,
+			arguments:                []string{"", "solve", "-i", "input.json"},
+			outputFormat:             models.JSONOutputFormat,
+			sudokuInitErrors:         []error{errors.New("first"), errors.New("second")},
+			sudokuSolutionResult:     true,
+			expectedResult:           models.Unspecified,
+			expectedValidationErrors: 2,
+		},
+		{
+			name:                 "Solve failure",
+			expectedExitCode:     ExitCodeSolverFailure,
+			arguments:            []string{"", "solve", "-i", "input.json"},
+			outputFormat:         models.JSONOutputFormat,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: false,
+			expectedResult:       models.Failure,
+			expectedErrors:       1,
+		},
+		{
+			name:                 "Exec success",
+			arguments:            []string{"", "exec", "base64Config"},
+			outputFormat:         models.JSONOutputFormat,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			expectedResult:       models.SuccessfullSolution,
+			expectedSudoku:       true,
+		},
+		{
+			name:                 "Exec invalid input",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "exec", "base64Config"},
+			outputFormat:         models.JSONOutputFormat,
+			decodeError:          errors.New("decode error"),
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			expectedResult:       models.Unspecified,
+			expectedErrors:       1,
+		},
+		{
+			name:                 "Create success",
+			arguments:            []string{"", "create", "first.json", "second"},
+			outputFormat:         models.JSONOutputFormat,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			expectedResult:       models.Unspecified,
+			expectedSudoku:       true,
+			expectedSavedFiles:   []string{"first.json", "second.json"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		settings.SetOutputFormat(testCase.outputFormat)
+		testPrinter := testHelpers.NewTestPrinter()
+		stdout := &bytes.Buffer{}
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+				TerminalPrinter: testPrinter,
+				DataReader:      testHelpers.NewTestDataReader(testHelpers.GetTestSudokuDto(), nil),
+				DataWriter:      testHelpers.NewTestDataWriter(true, nil),
+				SudokuFormats:   newTestSudokuFormats(settings),
+				SudokuInit: testHelpers.NewTestSudokuInit(
+					len(testCase.sudokuInitErrors) < 1, testCase.sudokuInitErrors),
+				SudokuEncoder: testHelpers.NewTestBinarySudokuManager(testCase.decodeError, nil, nil),
+				Solver:        testHelpers.GetNewTestSolver(testCase.sudokuSolutionResult, []error{}),
+			},
+			Stdout: stdout,
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.SolveCommand(),
+				config.ExecuteCommand(),
+				config.CreateCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		output := models.CommandOutputDTO{}
+		err = json.Unmarshal(stdout.Bytes(), &output)
+		if err != nil {
+			t.Errorf("%s: Output is not a valid JSON document: %s", testCase.name, err)
+			continue
+		}
+
+		if output.Command != testCase.arguments[1] {
+			t.Errorf("%s: Expected command '%s', got '%s'", testCase.name, testCase.arguments[1], output.Command)
+		}
+
+		if output.Result != testCase.expectedResult {
+			t.Errorf("%s: Expected result '%s', got '%s'", testCase.name, testCase.expectedResult, output.Result)
+		}
+
+		if (output.Sudoku != nil) != testCase.expectedSudoku {
+			t.Errorf("%s: Expected sudoku in the output: %t", testCase.name, testCase.expectedSudoku)
+		}
+
+		if len(output.ValidationErrors) != testCase.expectedValidationErrors ||
+			len(output.Errors) != testCase.expectedErrors {
+			t.Errorf("%s: Expected %d validation errors and %d errors, got %v and %v", testCase.name,
+				testCase.expectedValidationErrors, testCase.expectedErrors, output.ValidationErrors, output.Errors)
+		}
+
+		if len(output.SavedFiles) != len(testCase.expectedSavedFiles) {
+			t.Errorf("%s: Expected saved files %v, got %v",
+				testCase.name, testCase.expectedSavedFiles, output.SavedFiles)
+		}
+	}
+}
+
+func TestCommandOutput_Text(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+	stdout := &bytes.Buffer{}
+
+	config := &CommandContext{
+		Settings: settings,
+		ServiceCollection: &services.ServiceCollection{
+			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			TerminalPrinter: testPrinter,
+			SudokuInit:      testHelpers.NewTestSudokuInit(true, []error{}),
+			SudokuEncoder:   testHelpers.NewTestBinarySudokuManager(nil, nil, nil),
+			Solver:          testHelpers.GetNewTestSolver(true, []error{}),
+		},
+		Stdout: stdout,
+	}
+
+	app := &cli.App{
+		Name:           "Kangaroo",
+		ExitErrHandler: func(context *cli.Context, err error) {},
+		Commands:       []*cli.Command{config.ExecuteCommand()},
+	}
+
+	err := app.Run([]string{"", "exec", "base64Config"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	if stdout.Len() > 0 {
+		t.Errorf("No JSON document expected in text output format, got '%s'", stdout.String())
+	}
+}
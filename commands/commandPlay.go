@@ -0,0 +1,180 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/urfave/cli/v2"
+)
+
+// PlayCommand provides play command configuration
+func (commandConfig *CommandContext) PlayCommand() *cli.Command {
+	return &cli.Command{
+		Name:    "play",
+		Aliases: []string{"p"},
+		Usage: "Lets you solve a sudoku puzzle in the terminal. Puzzle is read from the file\n" +
+			"provided with -i flag (any supported format), given values are locked. There\n" +
+			"is a timer, pencil marks, highlighting of values breaking the rules, hints and\n" +
+			"checking values against the solution. The game can be saved and resumed later\n" +
+			"with --resume flag - saved game is a JSON file with the puzzle and values\n" +
+			"entered by the player. By default it is saved next to the puzzle file (with\n" +
+			".game.json extension) or to the file of resumed game.",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "input-file",
+				Aliases:     []string{"i"},
+				DefaultText: "",
+				Usage:       "Specify path to sudoku input file (format detected by content or extension)",
+			},
+			&cli.StringFlag{
+				Name:  "resume",
+				Usage: "Specify path to saved game file to resume the game",
+			},
+			&cli.StringFlag{
+				Name:  "save",
+				Usage: "Specify path to file where the game is saved",
+			},
+			&alphabetFlag,
+			&overwriteFileFlag,
+		},
+		Action: func(context *cli.Context) error {
+			request := commandConfig.buildPlayCommandRequest(context)
+			return commandConfig.playCommandHandler(context.Context, request)
+		},
+	}
+}
+
+// playCommandHandler is an entry point function for play command
+func (commandConfig *CommandContext) playCommandHandler(ctx context.Context,
+	request *models.PlayCommandRequest) error {
+
+	commandConfig.startCommandOutput("play")
+	defer commandConfig.finishCommandOutput()
+
+	if (len(request.InputFile) > 0) == (len(request.ResumeFile) > 0) {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Please provide either puzzle file with -i flag or saved game with --resume flag.")
+	}
+
+	game, err := commandConfig.getPlayGame(request)
+	if err != nil {
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	if len(request.Alphabet) > 0 {
+		game.Puzzle.Alphabet = request.Alphabet
+	}
+
+	if _, err := commandConfig.executeSudokuInitialization(game.Puzzle, false); err != nil {
+		return err
+	}
+
+	savePath := getPlaySavePath(request)
+	overwrite := request.Overwrite || savePath == request.ResumeFile
+	saved := false
+
+	actions := &models.SudokuGameActions{
+		Solve: func(puzzle *models.SudokuDTO) (*models.SudokuDTO, error) {
+			return commandConfig.getLibrary().Solve(ctx, puzzle)
+		},
+		Save: func(game *models.SudokuGameDTO) error {
+			written, err := commandConfig.ServiceCollection.DataWriter.SaveDataToFile(savePath, overwrite,
+				func(writer io.Writer) error {
+					encoder := json.NewEncoder(writer)
+					encoder.SetIndent("", "  ")
+					return encoder.Encode(game)
+				})
+			if err != nil {
+				return err
+			}
+
+			if !written {
+				return fmt.Errorf("file '%s' already exists, use -r flag to overwrite it", savePath)
+			}
+
+			// the game file belongs to this game after the first save
+			overwrite, saved = true, true
+			return nil
+		},
+	}
+
+	if err := commandConfig.ServiceCollection.Prompter.PromptPlaySudoku(game, actions); err != nil {
+		commandConfig.reportErrors("Failed to play the sudoku", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+	}
+
+	if game.Solved {
+		commandConfig.ServiceCollection.TerminalPrinter.PrintSuccess(fmt.Sprintf(
+			"Sudoku solved in %s with %d hint(s).", time.Duration(game.ElapsedSeconds)*time.Second, game.Hints))
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	}
+
+	if !saved {
+		commandConfig.ServiceCollection.TerminalPrinter.PrintDefault("The game was not saved.")
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+		return nil
+	}
+
+	if commandConfig.output != nil {
+		commandConfig.output.dto.SavedFiles = append(commandConfig.output.dto.SavedFiles, savePath)
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(
+		fmt.Sprintf("The game was saved to '%s', resume it with --resume flag.", savePath))
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	return nil
+}
+
+// getPlayGame reads the saved game, or starts a new game with
+// all values of the puzzle file as givens
+func (commandConfig *CommandContext) getPlayGame(request *models.PlayCommandRequest) (
+	*models.SudokuGameDTO, error) {
+
+	if len(request.ResumeFile) > 0 {
+		return commandConfig.ServiceCollection.DataReader.ReadSudokuGameFromFile(request.ResumeFile)
+	}
+
+	puzzle, err := commandConfig.ServiceCollection.DataReader.
+		ReadSudokuFromFile(request.InputFile, request.Alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SudokuGameDTO{
+		Puzzle:  puzzle,
+		Entries: models.GenericSlice[*models.SudokuGameEntryDTO]{},
+	}, nil
+}
+
+// getPlaySavePath returns path of the saved game file - the file provided with
+// --save flag, the file of resumed game or the puzzle file with .game.json extension
+func getPlaySavePath(request *models.PlayCommandRequest) string {
+	if len(request.SaveFile) > 0 {
+		return request.SaveFile
+	}
+
+	if len(request.ResumeFile) > 0 {
+		return request.ResumeFile
+	}
+
+	return strings.TrimSuffix(request.InputFile, filepath.Ext(request.InputFile)) + ".game.json"
+}
+
+// buildPlayCommandRequest retrieves options settings from the command
+// and constructs request object.
+func (commandConfig *CommandContext) buildPlayCommandRequest(context *cli.Context) *models.PlayCommandRequest {
+	return &models.PlayCommandRequest{
+		InputFile:  context.String("input-file"),
+		ResumeFile: context.String("resume"),
+		SaveFile:   context.String("save"),
+		Alphabet:   context.String(alphabetFlag.Name),
+		Overwrite:  context.Bool(overwriteFileFlag.Name),
+	}
+}
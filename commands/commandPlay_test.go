@@ -0,0 +1,184 @@
+package commands
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestPlayCommand(t *testing.T) {
+	saveAndSolve := func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error {
+		if _, err := actions.Solve(game.Puzzle); err != nil {
+			return err
+		}
+
+		game.ElapsedSeconds, game.Hints, game.Solved = 75, 2, true
+		return actions.Save(game)
+	}
+
+	saveOnly := func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error {
+		return actions.Save(game)
+	}
+
+	quitWithoutSaving := func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error {
+		return nil
+	}
+
+	failPrompt := func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error {
+		return errors.New("failed to play the sudoku game")
+	}
+
+	testCases := []struct {
+		name             string
+		arguments        []string
+		dataReaderResult *models.SudokuDTO
+		gameResult       *models.SudokuGameDTO
+		dataReaderError  error
+		sudokuInitErrors []error
+		fileWritten      bool
+		playPrompt       func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error
+		printContent     []string
+		expectedExitCode int
+	}{
+		{
+			name:             "No puzzle and no saved game",
+			arguments:        []string{"", "play"},
+			printContent:     []string{"Please provide either puzzle file with -i flag or saved game with --resume flag."},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Both puzzle and saved game",
+			arguments:        []string{"", "play", "-i", "puzzle.json", "--resume", "puzzle.game.json"},
+			printContent:     []string{"Please provide either puzzle file with -i flag or saved game with --resume flag."},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid puzzle file",
+			arguments:        []string{"", "play", "-i", "puzzle.json"},
+			dataReaderError:  errors.New("sudoku data file read error"),
+			printContent:     []string{"Invalid sudoku input"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Missing saved game",
+			arguments:        []string{"", "play", "--resume", "puzzle.game.json"},
+			dataReaderError:  models.WithKind(models.ErrIO, errors.New("saved game file does not exist")),
+			printContent:     []string{"Invalid sudoku input", "Saved game file does not exist"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Invalid puzzle configuration",
+			arguments:        []string{"", "play", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			sudokuInitErrors: []error{errors.New("duplicated value in the row")},
+			printContent:     []string{"Duplicated value in the row"},
+			expectedExitCode: ExitCodeInvalidConfiguration,
+		},
+		{
+			name:             "Prompt failure",
+			arguments:        []string{"", "play", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			playPrompt:       failPrompt,
+			printContent:     []string{"Failed to play the sudoku", "Failed to play the sudoku game"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Solved and saved next to the puzzle",
+			arguments:        []string{"", "play", "-i", "puzzles/puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileWritten:      true,
+			playPrompt:       saveAndSolve,
+			printContent: []string{"Sudoku solved in 1m15s with 2 hint(s).",
+				"The game was saved to 'puzzles/puzzle.game.json'"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Saved to selected file",
+			arguments:        []string{"", "play", "-i", "puzzle.txt", "--save", "my.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileWritten:      true,
+			playPrompt:       saveOnly,
+			printContent:     []string{"The game was saved to 'my.json'"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Resumed game saved to the same file",
+			arguments:        []string{"", "play", "--resume", "saved.json"},
+			gameResult:       &models.SudokuGameDTO{Puzzle: testHelpers.GetTestSudokuDto()},
+			fileWritten:      true,
+			playPrompt:       saveOnly,
+			printContent:     []string{"The game was saved to 'saved.json'"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Saved game file already exists",
+			arguments:        []string{"", "play", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			fileWritten:      false,
+			playPrompt:       saveOnly,
+			printContent:     []string{"File 'puzzle.game.json' already exists, use -r flag to overwrite it"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Quit without saving",
+			arguments:        []string{"", "play", "-i", "puzzle.json"},
+			dataReaderResult: testHelpers.GetTestSudokuDto(),
+			playPrompt:       quitWithoutSaving,
+			printContent:     []string{"The game was not saved."},
+			expectedExitCode: ExitCodeSuccess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		testPrinter := testHelpers.NewTestPrinter()
+		dataReader := testHelpers.NewTestDataReader(testCase.dataReaderResult, testCase.dataReaderError)
+		dataReader.GameResult = testCase.gameResult
+
+		prompterConfig := &testHelpers.TestPrompterConfig{}
+		if testCase.playPrompt != nil {
+			prompterConfig.PlayPromptFunc = &testCase.playPrompt
+		}
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
+				TerminalPrinter: testPrinter,
+				DataReader:      dataReader,
+				DataWriter:      testHelpers.NewTestDataWriter(testCase.fileWritten, nil),
+				SudokuInit:      testHelpers.NewTestSudokuInit(true, testCase.sudokuInitErrors),
+				Solver:          testHelpers.GetNewTestSolver(true, []error{}),
+				Prompter:        testHelpers.GetNewTestPrompter(prompterConfig),
+				SudokuFormats:   newTestSudokuFormats(settings),
+			},
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.PlayCommand(),
+			},
+		}
+
+		err := app.Run(testCase.arguments)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedPrintout := range testCase.printContent {
+			if !strings.Contains(testPrinter.PrintedData, expectedPrintout) {
+				t.Errorf("%s: Console printout is missing the following: '%s'",
+					testCase.name, expectedPrintout)
+			}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"runtime"
+	"syscall"
+	"time"
+
+	"github.com/Michu8258/kangaroo/grpcServer"
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/internal/serverCommon"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/server"
+	"github.com/urfave/cli/v2"
+)
+
+// time given to requests in progress to finish when the server is stopped
+const serverShutdownTimeout = 5 * time.Second
+
+// ServeCommand provides HTTP and gRPC server command configuration
+func (commandConfig *CommandContext) ServeCommand() *cli.Command {
+	return &cli.Command{
+		Name: "serve",
+		Usage: "Runs HTTP server exposing sudoku solution through JSON API. Endpoints:\n" +
+			"POST /solve, POST /validate, POST /hint (sudoku JSON or base64 data in the body),\n" +
+			"POST /generate (box size and layout in JSON body) and GET /health.\n" +
+			"With --grpc flag gRPC server is started instead (kangaroo.v1.SudokuService).\n" +
+			"Server is stopped with interrupt signal (ctrl+c).",
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:  "addr",
+				Value: ":8080",
+				Usage: "Address the server listens on",
+			},
+			&cli.Int64Flag{
+				Name:  "max-request-size",
+				Value: 1024 * 1024,
+				Usage: "Maximum size of request body in bytes",
+			},
+			&cli.DurationFlag{
+				Name:  "timeout",
+				Value: 10 * time.Second,
+				Usage: "Maximum time of processing single request",
+			},
+			&cli.IntFlag{
+				Name:        "max-solves",
+				Value:       runtime.NumCPU(),
+				DefaultText: "number of CPUs",
+				Usage:       "Maximum amount of requests solving or generating sudokus at the same time",
+			},
+			&cli.BoolFlag{
+				Name:  "grpc",
+				Value: false,
+				Usage: "Run gRPC server instead of HTTP server",
+			},
+		},
+		Action: func(context *cli.Context) error {
+			request := commandConfig.buildServeCommandRequest(context)
+			return commandConfig.serveCommandHandler(context.Context, request)
+		},
+	}
+}
+
+// serveCommandHandler is an entry point function for serve command. It runs
+// the server until the context is done or interrupt signal is received.
+func (commandConfig *CommandContext) serveCommandHandler(ctx context.Context,
+	request *models.ServeCommandRequest) error {
+
+	if request.MaximumRequestSize < 1 || request.RequestTimeout <= 0 || request.MaximumConcurrentSolves < 1 {
+		return commandConfig.failCommand(models.ErrInvalidInput,
+			"Maximum request size, request timeout and maximum solves have to be positive values.")
+	}
+
+	startServer := commandConfig.startHttpServer
+	if request.Grpc {
+		startServer = commandConfig.startGrpcServer
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErrors := make(chan error, 1)
+	shutdown, err := startServer(request, serverErrors)
+	if err != nil {
+		return commandConfig.failCommand(models.ErrIO, fmt.Sprintf("Server failure: %s.", err))
+	}
+
+	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary(
+		fmt.Sprintf("Listening on %s", request.Address))
+	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	select {
+	case err := <-serverErrors:
+		return commandConfig.failCommand(models.ErrIO, fmt.Sprintf("Server failure: %s.", err))
+	case <-ctx.Done():
+	}
+
+	shutdownContext, cancel := context.WithTimeout(context.Background(), serverShutdownTimeout)
+	defer cancel()
+
+	err = shutdown(shutdownContext)
+	if err != nil {
+		return commandConfig.failCommand(models.ErrIO, fmt.Sprintf("Failed to stop the server: %s.", err))
+	}
+
+	return nil
+}
+
+// startHttpServer starts HTTP server in the background. Errors of the running server
+// are sent to the channel. Returns function gracefully stopping the server.
+func (commandConfig *CommandContext) startHttpServer(request *models.ServeCommandRequest,
+	serverErrors chan<- error) (func(ctx context.Context) error, error) {
+
+	apiServer := server.NewServer(commandConfig.Settings, commandConfig.ServiceCollection,
+		commandConfig.newLibrary(library.WithMaximumConcurrentSolves(request.MaximumConcurrentSolves)),
+		serverCommon.Options{
+			MaximumRequestSize: request.MaximumRequestSize,
+			RequestTimeout:     request.RequestTimeout,
+		})
+
+	httpServer := &http.Server{
+		Addr:              request.Address,
+		Handler:           apiServer.Handler(),
+		ReadHeaderTimeout: request.RequestTimeout,
+	}
+
+	go func() {
+		serverErrors <- httpServer.ListenAndServe()
+	}()
+
+	return func(ctx context.Context) error {
+		err := httpServer.Shutdown(ctx)
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+
+		return err
+	}, nil
+}
+
+// startGrpcServer starts gRPC server in the background. Errors of the running server
+// are sent to the channel. Returns function gracefully stopping the server.
+func (commandConfig *CommandContext) startGrpcServer(request *models.ServeCommandRequest,
+	serverErrors chan<- error) (func(ctx context.Context) error, error) {
+
+	listener, err := net.Listen("tcp", request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	apiServer := grpcServer.NewServer(commandConfig.Settings, commandConfig.ServiceCollection,
+		commandConfig.newLibrary(library.WithMaximumConcurrentSolves(request.MaximumConcurrentSolves)),
+		serverCommon.Options{
+			MaximumRequestSize: request.MaximumRequestSize,
+			RequestTimeout:     request.RequestTimeout,
+		}).GrpcServer()
+
+	go func() {
+		serverErrors <- apiServer.Serve(listener)
+	}()
+
+	return func(ctx context.Context) error {
+		stopped := make(chan struct{})
+		go func() {
+			apiServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			// requests in progress did not finish in time
+			apiServer.Stop()
+		}
+
+		return nil
+	}, nil
+}
+
+// buildServeCommandRequest retrieves options settings from the command
+// and constructs request object.
+func (commandConfig *CommandContext) buildServeCommandRequest(context *cli.Context) *models.ServeCommandRequest {
+	return &models.ServeCommandRequest{
+		Address:                 context.String("addr"),
+		MaximumRequestSize:      context.Int64("max-request-size"),
+		RequestTimeout:          context.Duration("timeout"),
+		Grpc:                    context.Bool("grpc"),
+		MaximumConcurrentSolves: context.Int("max-solves"),
+	}
+}
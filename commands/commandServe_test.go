@@ -0,0 +1,100 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"github.com/urfave/cli/v2"
+)
+
+func TestServeCommand(t *testing.T) {
+	testCases := []struct {
+		name             string
+		arguments        []string
+		cancelled        bool
+		printContent     []string
+		expectedExitCode int
+	}{
+		{
+			name:             "Invalid request size",
+			arguments:        []string{"", "serve", "--max-request-size", "0"},
+			printContent:     []string{"have to be positive values"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid maximum solves",
+			arguments:        []string{"", "serve", "--max-solves", "0"},
+			printContent:     []string{"have to be positive values"},
+			expectedExitCode: ExitCodeInvalidInput,
+		},
+		{
+			name:             "Invalid address",
+			arguments:        []string{"", "serve", "--addr", "127.0.0.1:99999"},
+			printContent:     []string{"Server failure"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Stopped server",
+			arguments:        []string{"", "serve", "--addr", "127.0.0.1:0"},
+			cancelled:        true,
+			printContent:     []string{"Listening on 127.0.0.1:0"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+		{
+			name:             "Invalid gRPC address",
+			arguments:        []string{"", "serve", "--grpc", "--addr", "127.0.0.1:99999"},
+			printContent:     []string{"Server failure"},
+			expectedExitCode: ExitCodeIOFailure,
+		},
+		{
+			name:             "Stopped gRPC server",
+			arguments:        []string{"", "serve", "--grpc", "--addr", "127.0.0.1:0"},
+			cancelled:        true,
+			printContent:     []string{"Listening on 127.0.0.1:0"},
+			expectedExitCode: ExitCodeSuccess,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		testPrinter := testHelpers.NewTestPrinter()
+
+		config := &CommandContext{
+			Settings: settings,
+			ServiceCollection: &services.ServiceCollection{
+				TerminalPrinter: testPrinter,
+			},
+		}
+
+		app := &cli.App{
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
+			Commands: []*cli.Command{
+				config.ServeCommand(),
+			},
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		if testCase.cancelled {
+			cancel()
+		}
+
+		err := app.RunContext(ctx, testCase.arguments)
+		cancel()
+
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
+		}
+
+		for _, expectedPrintout := range testCase.printContent {
+			if !strings.Contains(testPrinter.PrintedData, expectedPrintout) {
+				t.Errorf("%s: Console printout is missing the following: '%s'",
+					testCase.name, expectedPrintout)
+			}
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"context"
+
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/urfave/cli/v2"
@@ -12,72 +14,100 @@ func (commandConfig *CommandContext) SolveCommand() *cli.Command {
 		Name:    "solve",
 		Aliases: []string{"s"},
 		Usage: "Solves a provided sudoku puzzle. There are few formats supported\n" +
-			"for this command. You can pass an input data json file path using -i flag.\n" +
-			"This option will have precedence over all other because the file contains all\n" +
-			"the data required to build sudoku object. In case no -i flag is passed, then\n" +
-			"cli works in manual mode - it will ask for box size, and sudoku layout and all\n" +
-			"sudoku values. Box size, and sudoku layout prompts may be ommited by using -s,\n" +
-			"--lw and --lh flags. You can save result of sulution to a file with a -o flag.",
+			"for this command. You can pass an input data file path using -i flag - JSON\n" +
+			"file, TXT file saved by the cli, a compact string or a grid of values with\n" +
+			"'.', '0' or '_' as blanks, or a file of other application (SadMan .sdk/.sdm,\n" +
+			"Simple Sudoku .ss, HoDoKu candidates grid, f-puzzles JSON). This option will\n" +
+			"have precedence over all other because the file contains all the data required\n" +
+			"to build sudoku object. In case no -i flag is passed, then cli works in manual\n" +
+			"mode - it will ask for box size, and sudoku layout and all sudoku values. Box\n" +
+			"size, and sudoku layout prompts may be ommited by using -s, --lw and --lh flags.\n" +
+			"You can save result of sulution to a file with a -o flag, format is chosen by\n" +
+			"the file extension (.svg and .png files are images of the solution, .html file\n" +
+			"is a page to play the puzzle in a web browser, with the solution embedded for\n" +
+			"checking). Symbols used to present values can be changed with -a flag. With\n" +
+			"--edit flag sudoku read from the input file is opened in the editor, so it can\n" +
+			"be adjusted before solving. Duration of the solution can be limited with\n" +
+			"--timeout flag.",
 		Flags: []cli.Flag{
 			&boxSizeFlag,
 			&layoutWidthFlag,
 			&layoutHeightFlag,
+			&alphabetFlag,
 			&overwriteFileFlag,
 			&cli.StringFlag{Name: "input-file",
 				Aliases:     []string{"i"},
 				DefaultText: "",
-				Usage:       "Specify path to sudoku JSON configuration file",
+				Usage:       "Specify path to sudoku input file (format detected by content or extension)",
 			},
 			&cli.StringFlag{
 				Name:        "output-file",
 				Aliases:     []string{"o"},
 				DefaultText: "",
-				Usage:       "Specify path to file where you want to save solution of the sudoku (JSON or TXT, JSON is default)",
+				Usage:       "Specify path to file where you want to save solution of the sudoku (format chosen by extension, JSON is default)",
+			},
+			&cli.BoolFlag{
+				Name:  "edit",
+				Usage: "Open sudoku read from the input file in the editor before solving",
 			},
+			&timeoutFlag,
 		},
 		Action: func(context *cli.Context) error {
 			request := commandConfig.buildSolveCommandRequest(context)
-			return commandConfig.solveCommandHandler(request)
+			return commandConfig.solveCommandHandler(context.Context, request)
 		},
 	}
 }
 
 // solveCommandHandler is an entry point function for solve sudoku command
-func (commandConfig *CommandContext) solveCommandHandler(request *models.SolveCommandRequest) error {
+func (commandConfig *CommandContext) solveCommandHandler(ctx context.Context,
+	request *models.SolveCommandRequest) error {
+	commandConfig.startCommandOutput("solve")
+	defer commandConfig.finishCommandOutput()
+
 	rawSudoku, err := commandConfig.getSudokuInputRawData(request)
 	if err != nil {
-		commandConfig.ServiceCollection.DataPrinter.
-			PrintErrors("Invalid sudoku input", err)
-		return nil
+		commandConfig.reportErrors("Invalid sudoku input", err)
+		return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
 	}
 
-	sudoku, ok := commandConfig.executeSudokuInitialization(rawSudoku, true)
-	if !ok {
-		return nil
+	if request.Alphabet != nil {
+		rawSudoku.Alphabet = *request.Alphabet
 	}
 
-	solved, errs := commandConfig.ServiceCollection.Solver.Solve(sudoku)
-	if !solved {
-		commandConfig.ServiceCollection.TerminalPrinter.
-			PrintError("Failed to solve the sudoku.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return nil
+	// sudoku read from the console has been entered in the editor already
+	if request.Edit && request.InputFile != nil {
+		if err := commandConfig.ServiceCollection.Prompter.PromptSudokuValues(rawSudoku, false); err != nil {
+			commandConfig.reportErrors("Failed to edit the sudoku", err)
+			return newExitError(models.WithKind(getErrorKind(err, models.ErrInvalidInput), err))
+		}
 	}
 
-	if commandConfig.Settings.UseDebugPrints && len(errs) >= 1 {
-		commandConfig.ServiceCollection.DataPrinter.PrintErrors(
-			"Sudoku solution failure reasons:", err)
-		return nil
+	sudoku, err := commandConfig.executeSudokuInitialization(rawSudoku, true)
+	if err != nil {
+		return err
+	}
+
+	solutionContext, cancel := withSolutionTimeout(ctx, request.Timeout)
+	defer cancel()
+
+	err = commandConfig.solveInitializedSudoku(solutionContext, sudoku)
+	if err != nil {
+		return commandConfig.failSolution(err)
 	}
 
 	commandConfig.printSudoku("Sudoku puzzle solution:", sudoku)
 
 	if request.OutputFile != nil {
-		validPaths := commandConfig.validateDestinationFilePaths(*request.OutputFile)
-		if len(validPaths) >= 1 {
-			commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-			commandConfig.executeSudokuFilesSave(sudoku, request.AsConfigRequest(), validPaths)
+		validPaths, err := commandConfig.validateDestinationFilePaths(*request.OutputFile)
+		if err != nil {
+			return err
 		}
+
+		// the page saves the solution for checking of entered values
+		commandConfig.Settings.RenderSolution = true
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+		return commandConfig.executeSudokuFilesSave(sudoku, request.AsConfigRequest(), validPaths)
 	}
 
 	return nil
@@ -88,9 +118,14 @@ func (commandConfig *CommandContext) solveCommandHandler(request *models.SolveCo
 func (commandConfig *CommandContext) getSudokuInputRawData(
 	request *models.SolveCommandRequest) (*models.SudokuDTO, error) {
 
-	if request.InputJsonFile != nil {
+	if request.InputFile != nil {
+		alphabet := ""
+		if request.Alphabet != nil {
+			alphabet = *request.Alphabet
+		}
+
 		return commandConfig.ServiceCollection.DataReader.
-			ReadSudokuFromJsonFile(*request.InputJsonFile)
+			ReadSudokuFromFile(*request.InputFile, alphabet)
 	}
 
 	return commandConfig.ServiceCollection.DataReader.
@@ -103,11 +138,15 @@ func (commandConfig *CommandContext) buildSolveCommandRequest(context *cli.Conte
 	boxSize := context.Int(boxSizeFlag.Name)
 	layoutWidth := context.Int(layoutWidthFlag.Name)
 	layoutHeight := context.Int(layoutHeightFlag.Name)
-	inputJsonFile := context.String("input-file")
+	inputFile := context.String("input-file")
 	outputFile := context.String("output-file")
+	alphabet := context.String(alphabetFlag.Name)
 	overwrite := context.Bool(overwriteFileFlag.Name)
 
-	request := &models.SolveCommandRequest{}
+	request := &models.SolveCommandRequest{
+		Edit:    context.Bool("edit"),
+		Timeout: context.Duration(timeoutFlag.Name),
+	}
 
 	if boxSize > 0 {
 		request.BoxSize = helpers.IntToInt8Pointer(boxSize)
@@ -121,14 +160,18 @@ func (commandConfig *CommandContext) buildSolveCommandRequest(context *cli.Conte
 		request.LayoutHeight = helpers.IntToInt8Pointer(layoutHeight)
 	}
 
-	if len(inputJsonFile) > 0 {
-		request.InputJsonFile = &inputJsonFile
+	if len(inputFile) > 0 {
+		request.InputFile = &inputFile
 	}
 
 	if len(outputFile) > 0 {
 		request.OutputFile = &outputFile
 	}
 
+	if len(alphabet) > 0 {
+		request.Alphabet = &alphabet
+	}
+
 	if overwrite {
 		request.Overwrite = true
 	}
@@ -22,10 +22,13 @@ func TestSolveCommand(t *testing.T) {
 		sudokuInitErrors     []error
 		sudokuSolutionResult bool
 		sudokuSolutionErrors []error
+		sudokuPromptError    error
 		printContent         []string
+		expectedExitCode     int
 	}{
 		{
 			name:                 "Invalid file",
+			expectedExitCode:     ExitCodeInvalidInput,
 			arguments:            []string{"", "solve", "-i", "/path/to/sudoku/data/file.json"},
 			dataReaderResult:     nil,
 			dataReaderError:      errors.New("sudoku data file read error"),
@@ -37,6 +40,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "Invalid user input",
+			expectedExitCode:     ExitCodeInvalidInput,
 			arguments:            []string{"", "solve"},
 			dataReaderResult:     nil,
 			dataReaderError:      errors.New("sudoku data file read error"),
@@ -48,6 +52,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "Failed sudoku initialization",
+			expectedExitCode:     ExitCodeInvalidConfiguration,
 			arguments:            []string{"", "solve", "-i", "/path/to/sudoku/data/file.json"},
 			dataReaderResult:     testHelpers.GetTestSudokuDto(),
 			dataReaderError:      nil,
@@ -59,6 +64,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "Solution of sudoku failed",
+			expectedExitCode:     ExitCodeSolverFailure,
 			arguments:            []string{"", "solve", "-s", "3", "--lw", "3", "--lh", "3", "-i", "/path/to/sudoku/data/file.json"},
 			dataReaderResult:     testHelpers.GetTestSudokuDto(),
 			dataReaderError:      nil,
@@ -70,6 +76,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "All good - with flags",
+			expectedExitCode:     ExitCodeSuccess,
 			arguments:            []string{"", "solve", "-s", "3", "--lw", "3", "--lh", "3", "-i", "/path/to/sudoku/data/file.json"},
 			dataReaderResult:     testHelpers.GetTestSudokuDto(),
 			dataReaderError:      nil,
@@ -81,6 +88,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "All good - no flags",
+			expectedExitCode:     ExitCodeSuccess,
 			arguments:            []string{"", "solve"},
 			dataReaderResult:     testHelpers.GetTestSudokuDto(),
 			dataReaderError:      nil,
@@ -92,6 +100,7 @@ func TestSolveCommand(t *testing.T) {
 		},
 		{
 			name:                 "Output file save success",
+			expectedExitCode:     ExitCodeSuccess,
 			arguments:            []string{"", "solve", "-s", "3", "--lw", "3", "--lh", "3", "-r", "-i", "/path/to/sudoku/data/file.json", "-o", "/path/to/sudoku/sulution/file.json"},
 			dataReaderResult:     testHelpers.GetTestSudokuDto(),
 			dataReaderError:      nil,
@@ -101,39 +110,68 @@ func TestSolveCommand(t *testing.T) {
 			sudokuSolutionErrors: []error{},
 			printContent:         []string{"Saving results"},
 		},
+		{
+			name:                 "Sudoku file edited before solution",
+			expectedExitCode:     ExitCodeSuccess,
+			arguments:            []string{"", "solve", "-i", "/path/to/sudoku/data/file.json", "--edit"},
+			dataReaderResult:     testHelpers.GetTestSudokuDto(),
+			dataReaderError:      nil,
+			sudokuInitResult:     true,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			sudokuSolutionErrors: []error{},
+			printContent:         []string{"Sudoku puzzle solution"},
+		},
+		{
+			name:                 "Failed edit of sudoku file",
+			expectedExitCode:     ExitCodeInvalidInput,
+			arguments:            []string{"", "solve", "-i", "/path/to/sudoku/data/file.json", "--edit"},
+			dataReaderResult:     testHelpers.GetTestSudokuDto(),
+			dataReaderError:      nil,
+			sudokuInitResult:     true,
+			sudokuInitErrors:     []error{},
+			sudokuSolutionResult: true,
+			sudokuSolutionErrors: []error{},
+			sudokuPromptError:    errors.New("failed to get sudoku values from manual input"),
+			printContent:         []string{"Failed to edit the sudoku", "Failed to get sudoku values from manual input"},
+		},
 	}
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
 		testPrinter := testHelpers.NewTestPrinter()
-		debugPrinter := testHelpers.NewTestPrinter()
 
 		config := &CommandContext{
 			Settings: settings,
 			ServiceCollection: &services.ServiceCollection{
 				DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
 				TerminalPrinter: testPrinter,
-				DebugPrinter:    debugPrinter,
 				DataReader: testHelpers.NewTestDataReader(
 					testCase.dataReaderResult, testCase.dataReaderError),
 				SudokuInit: testHelpers.NewTestSudokuInit(
 					testCase.sudokuInitResult, testCase.sudokuInitErrors),
-				DataWriter: testHelpers.NewTestDataWriter(true, nil),
+				DataWriter:    testHelpers.NewTestDataWriter(true, nil),
+				SudokuFormats: newTestSudokuFormats(settings),
 				Solver: testHelpers.GetNewTestSolver(
 					testCase.sudokuSolutionResult, testCase.sudokuSolutionErrors),
+				Prompter: testHelpers.GetNewTestPrompter(&testHelpers.TestPrompterConfig{
+					SudokuPromptError: testCase.sudokuPromptError,
+				}),
 			},
 		}
 
 		app := &cli.App{
-			Name: "Kangaroo",
+			Name:           "Kangaroo",
+			ExitErrHandler: func(context *cli.Context, err error) {},
 			Commands: []*cli.Command{
 				config.SolveCommand(),
 			},
 		}
 
 		err := app.Run(testCase.arguments)
-		if err != nil {
-			t.Error(err)
+		if GetExitCode(err) != testCase.expectedExitCode {
+			t.Errorf("%s: Expected exit code %d, got %d (%v)",
+				testCase.name, testCase.expectedExitCode, GetExitCode(err), err)
 		}
 
 		printed := false
@@ -1,16 +1,22 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
+	"time"
 
+	"github.com/Michu8258/kangaroo/internal/library"
 	"github.com/Michu8258/kangaroo/models"
 )
 
 // validateDestinationFilePaths checks if all provided file names have no extension
-// or have json or txt extension. Returns slice of valid names
+// or have extension of a format that sudoku can be written in. Paths without
+// extension are saved as JSON. Returns slice of valid names, or an error if
+// there is no valid name
 func (commandConfig *CommandContext) validateDestinationFilePaths(
-	destinationFilePaths ...string) []string {
+	destinationFilePaths ...string) ([]string, error) {
 
 	validPaths := []string{}
 	errorPaths := []error{}
@@ -22,7 +28,8 @@ func (commandConfig *CommandContext) validateDestinationFilePaths(
 			continue
 		}
 
-		if extension == ".json" || extension == ".txt" {
+		format := commandConfig.ServiceCollection.SudokuFormats.GetFormatByPath(destinationPath)
+		if format != nil && format.CanWrite() {
 			validPaths = append(validPaths, destinationPath)
 			continue
 		}
@@ -31,84 +38,92 @@ func (commandConfig *CommandContext) validateDestinationFilePaths(
 	}
 
 	if len(errorPaths) > 0 {
-		commandConfig.ServiceCollection.DataPrinter.PrintErrors(
+		commandConfig.reportErrors(
 			"Optput files listed below are not supported", errorPaths...)
 	}
 
 	if len(validPaths) < 1 {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(
+		return validPaths, commandConfig.failCommand(models.ErrInvalidInput,
 			"No supported file path to save sudoku data to.")
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
 	}
 
-	return validPaths
+	return validPaths, nil
 }
 
 // executeSudokuFilesSave executes iterative sudoku files save with
-// results printing uncluded
+// results printing uncluded. Returns the first error of files save.
 func (commandConfig *CommandContext) executeSudokuFilesSave(sudoku *models.Sudoku,
-	request *models.SudokuConfigRequest, paths []string) {
+	request *models.SudokuConfigRequest, paths []string) error {
 	commandConfig.ServiceCollection.TerminalPrinter.PrintPrimary("Saving results:")
 	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+
+	var saveErr error
 	for _, path := range paths {
-		commandConfig.saveSudokuToFile(sudoku, request.AsConfigRequest(), path)
+		err := commandConfig.saveSudokuToFile(sudoku, request.AsConfigRequest(), path)
+		if saveErr == nil {
+			saveErr = err
+		}
 	}
+
+	return saveErr
 }
 
 // executes save to file logic
 func (commandConfig *CommandContext) saveSudokuToFile(sudoku *models.Sudoku,
-	request *models.SudokuConfigRequest, path string) {
+	request *models.SudokuConfigRequest, path string) error {
 
-	extension := filepath.Ext(path)
-
-	var written bool
-	var err error
-
-	if extension == ".txt" {
-		written, err = commandConfig.ServiceCollection.DataWriter.
-			SaveSudokuToTxt(sudoku, path, request.Overwrite)
-	} else {
-		written, err = commandConfig.ServiceCollection.DataWriter.
-			SaveSudokuToJson(sudoku, path, request.Overwrite)
-	}
+	written, err := commandConfig.ServiceCollection.DataWriter.
+		SaveSudokuToFile(sudoku, path, request.Overwrite)
+	return commandConfig.reportSudokuFileSave(path, written, err)
+}
 
+// reportSudokuFileSave prints result of saving sudoku to the file and adds
+// the file to the command output if it was written
+func (commandConfig *CommandContext) reportSudokuFileSave(path string, written bool, err error) error {
 	if err != nil {
-		commandConfig.ServiceCollection.TerminalPrinter.PrintError(fmt.Sprintf("- %s", err))
-		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return
+		return commandConfig.failCommand(getErrorKind(err, models.ErrIO), fmt.Sprintf("- %s", err))
 	}
 
 	if written {
 		commandConfig.ServiceCollection.TerminalPrinter.PrintSuccess(
 			fmt.Sprintf("- '%s' written successfully", path))
 		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		return
+
+		if commandConfig.output != nil {
+			commandConfig.output.dto.SavedFiles = append(commandConfig.output.dto.SavedFiles, path)
+		}
+		return nil
 	}
 
 	commandConfig.ServiceCollection.TerminalPrinter.PrintDefault(
 		fmt.Sprintf("- '%s' already exists (ommited)", path))
 	commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+	return nil
 }
 
 // executeSudokuInitialization executes sudoku initialization (validation included)
-// based of dto input object. If everything is OK, sudoku data will be printed.
-// Returns mapped sudoku object and boolean flag indicating if everything is
-// correct up to this point
+// with the library, based of dto input object. If everything is OK, sudoku data
+// will be printed. Returns initialized sudoku object and an error of invalid
+// configuration kind if the sudoku is not correct (sudoku is nil if it can not
+// be printed)
 func (commandConfig *CommandContext) executeSudokuInitialization(
-	sudokuDto *models.SudokuDTO, printSUdokuData bool) (*models.Sudoku, bool) {
-
-	sudoku := sudokuDto.ToSudoku()
-	isSudokuPrintable, errs := commandConfig.ServiceCollection.
-		SudokuInit.InitializeSudoku(sudoku)
+	sudokuDto *models.SudokuDTO, printSUdokuData bool) (*models.Sudoku, error) {
 
-	if len(errs) >= 1 {
-		commandConfig.ServiceCollection.DataPrinter.PrintErrors(
-			"Invalid sudoku configuration:", errs...)
+	sudoku, err := commandConfig.getLibrary().Initialize(sudokuDto)
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		commandConfig.reportValidationErrors(validationError.Errors...)
 		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
-		if isSudokuPrintable {
+		if sudoku != nil {
 			commandConfig.printSudoku("Invalid sudoku values", sudoku)
 		}
-		return sudoku, false
+		return sudoku, newExitError(models.WithKind(models.ErrInvalidConfiguration,
+			errors.Join(validationError.Errors...)))
+	}
+
+	if err != nil {
+		return nil, commandConfig.failCommand(getErrorKind(err, models.ErrInvalidInput),
+			"Failed to initialize the sudoku.")
 	}
 
 	if printSUdokuData {
@@ -117,7 +132,51 @@ func (commandConfig *CommandContext) executeSudokuInitialization(
 		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
 	}
 
-	return sudoku, true
+	return sudoku, nil
+}
+
+// withSolutionTimeout returns context of the solution, which is done after provided
+// timeout. There is no time limit for zero timeout.
+func withSolutionTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, timeout)
+}
+
+// failSolution reports failed solution of the sudoku and returns an error of invalid
+// configuration, unsolvable sudoku or solver failure kind, depending on the error
+// of the solution. Exceeded time limit and cancellation are solver failures.
+func (commandConfig *CommandContext) failSolution(err error) error {
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		commandConfig.reportValidationErrors(validationError.Errors...)
+		commandConfig.ServiceCollection.TerminalPrinter.PrintNewLine()
+		return newExitError(models.WithKind(models.ErrInvalidConfiguration, errors.Join(validationError.Errors...)))
+	}
+
+	if errors.Is(err, models.ErrUnsolvable) {
+		return commandConfig.failCommand(models.ErrUnsolvable, "The sudoku has no solution.")
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return commandConfig.failCommand(models.ErrSolverFailure,
+			"Solution of the sudoku was stopped, because it exceeded the time limit.")
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return commandConfig.failCommand(models.ErrSolverFailure, "Solution of the sudoku was cancelled.")
+	}
+
+	// with debug prints enabled, solution reporting errors is a failure
+	solutionError := &library.SolutionError{}
+	if errors.As(err, &solutionError) && solutionError.Result == models.SuccessfullSolution {
+		commandConfig.reportErrors("Sudoku solution failure reasons:", solutionError.Errors...)
+		return newExitError(models.WithKind(models.ErrSolverFailure, errors.Join(solutionError.Errors...)))
+	}
+
+	return commandConfig.failCommand(models.ErrSolverFailure, "Failed to solve the sudoku.")
 }
 
 // printSudokuConfig prints sudoku configuration with provided printer
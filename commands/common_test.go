@@ -8,7 +8,11 @@ import (
 
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
 	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -19,7 +23,8 @@ func TestValidateDestinationFilePaths_AtLeastOnePathIsOk(t *testing.T) {
 	config := &CommandContext{
 		Settings: settings,
 		ServiceCollection: &services.ServiceCollection{
-			DataPrinter: dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			DataPrinter:   dataPrinters.GetNewDataPrinter(settings, testPrinter),
+			SudokuFormats: newTestSudokuFormats(settings),
 		},
 	}
 
@@ -29,6 +34,9 @@ func TestValidateDestinationFilePaths_AtLeastOnePathIsOk(t *testing.T) {
 		"./directory/validFile",
 		"/root/ok/path/valid",
 		"/root/ok/path/invalid.extension",
+		"/root/ok/path/valid.sdk",
+		"/root/ok/path/valid.SS",
+		"/root/ok/path/valid.fpuzzles",
 	}
 
 	expectedResult := []string{
@@ -36,9 +44,15 @@ func TestValidateDestinationFilePaths_AtLeastOnePathIsOk(t *testing.T) {
 		"./directory/validFile.txt",
 		"./directory/validFile.json",
 		"/root/ok/path/valid.json",
+		"/root/ok/path/valid.sdk",
+		"/root/ok/path/valid.SS",
+		"/root/ok/path/valid.fpuzzles",
 	}
 
-	result := config.validateDestinationFilePaths(destinationFilePaths...)
+	result, err := config.validateDestinationFilePaths(destinationFilePaths...)
+	if err != nil {
+		t.Errorf("Validation failed. Unexpected error: %s", err)
+	}
 
 	if len(result) != len(expectedResult) {
 		t.Errorf("Validation failed. Expected %d valid file paths, got %d.",
@@ -61,6 +75,7 @@ func TestValidateDestinationFilePaths_AllPathsInvalid(t *testing.T) {
 		ServiceCollection: &services.ServiceCollection{
 			DataPrinter:     dataPrinters.GetNewDataPrinter(settings, testPrinter),
 			TerminalPrinter: testPrinter,
+			SudokuFormats:   newTestSudokuFormats(settings),
 		},
 	}
 
@@ -69,7 +84,10 @@ func TestValidateDestinationFilePaths_AllPathsInvalid(t *testing.T) {
 		"/root/directory/validFile.verybad",
 	}
 
-	result := config.validateDestinationFilePaths(destinationFilePaths...)
+	result, err := config.validateDestinationFilePaths(destinationFilePaths...)
+	if GetExitCode(err) != ExitCodeInvalidInput {
+		t.Errorf("Validation failed. Expected exit code %d, got %d.", ExitCodeInvalidInput, GetExitCode(err))
+	}
 
 	if len(result) != 0 {
 		t.Errorf("Validation failed. Expected %d valid file paths, got %d.", 0, len(result))
@@ -145,7 +163,11 @@ func TestSaveSudokuToFile(t *testing.T) {
 			},
 		}
 
-		config.saveSudokuToFile(&models.Sudoku{}, &models.SudokuConfigRequest{}, testCase.path)
+		err := config.saveSudokuToFile(&models.Sudoku{}, &models.SudokuConfigRequest{}, testCase.path)
+
+		if (err != nil) != (testCase.writeError != nil) {
+			t.Errorf("%d: Expected save error: %t, got: %v", testIndex, testCase.writeError != nil, err)
+		}
 
 		if testCase.writeError != nil {
 			errorMsg := testCase.writeError.Error()
@@ -211,9 +233,10 @@ func TestExecuteSudokuInitialization(t *testing.T) {
 			},
 		}
 
-		sudoku, ok := config.executeSudokuInitialization(testHelpers.GetTestSudokuDto(), true)
+		sudoku, err := config.executeSudokuInitialization(testHelpers.GetTestSudokuDto(), true)
+		ok := err == nil
 
-		if sudoku == nil {
+		if sudoku == nil && testCase.sudokuPrintable {
 			t.Errorf("%v: Sudoku pointer is nil. Expected non nil pointer to sudoku object.",
 				testIndex)
 		}
@@ -229,8 +252,9 @@ func TestExecuteSudokuInitialization(t *testing.T) {
 					testIndex)
 			}
 
-			if ok {
-				t.Errorf("%v: Sudoku initialization should fail, but it did not.", testIndex)
+			if GetExitCode(err) != ExitCodeInvalidConfiguration {
+				t.Errorf("%v: Sudoku initialization should fail with exit code %d, got %d.",
+					testIndex, ExitCodeInvalidConfiguration, GetExitCode(err))
 			}
 		} else {
 			if !ok {
@@ -249,3 +273,10 @@ func TestExecuteSudokuInitialization(t *testing.T) {
 		}
 	}
 }
+
+func newTestSudokuFormats(settings *models.Settings) sudokuFormats.ISudokuFormats {
+	return sudokuFormats.GetNewSudokuFormats(settings, textSudokuParser.GetNewTextSudokuParser(settings),
+		dataPrinters.GetNewDataPrinter(settings, testHelpers.NewTestPrinter()),
+		binarySudokuManager.GetNewBinarySudokuManager(settings),
+		sudokuRenderer.GetNewSudokuRenderer(settings))
+}
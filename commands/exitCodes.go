@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/urfave/cli/v2"
+)
+
+// Exit codes of the process. Code 1 is used for incorrect usage of the cli
+// and any error not classified as one of the kinds from models package.
+const (
+	ExitCodeSuccess              = 0
+	ExitCodeFailure              = 1
+	ExitCodeInvalidInput         = 2
+	ExitCodeInvalidConfiguration = 3
+	ExitCodeUnsolvable           = 4
+	ExitCodeSolverFailure        = 5
+	ExitCodeIOFailure            = 6
+)
+
+var errorKindsExitCodes = []struct {
+	kind     error
+	exitCode int
+}{
+	{kind: models.ErrInvalidInput, exitCode: ExitCodeInvalidInput},
+	{kind: models.ErrInvalidConfiguration, exitCode: ExitCodeInvalidConfiguration},
+	{kind: models.ErrUnsolvable, exitCode: ExitCodeUnsolvable},
+	{kind: models.ErrSolverFailure, exitCode: ExitCodeSolverFailure},
+	{kind: models.ErrIO, exitCode: ExitCodeIOFailure},
+}
+
+// GetExitCode returns process exit code for an error returned by the cli app
+func GetExitCode(err error) int {
+	if err == nil {
+		return ExitCodeSuccess
+	}
+
+	exitCoder := cli.ExitCoder(nil)
+	if errors.As(err, &exitCoder) {
+		return exitCoder.ExitCode()
+	}
+
+	return ExitCodeFailure
+}
+
+// newExitError wraps the error with exit code matching its kind, so the
+// cli app returns it as process exit code
+func newExitError(err error) error {
+	for _, kindExitCode := range errorKindsExitCodes {
+		if errors.Is(err, kindExitCode.kind) {
+			return cli.Exit(err, kindExitCode.exitCode)
+		}
+	}
+
+	return cli.Exit(err, ExitCodeFailure)
+}
+
+// failCommand reports the error message and returns an error of provided kind
+// to be returned from command handler
+func (commandConfig *CommandContext) failCommand(kind error, message string) error {
+	commandConfig.reportError(message)
+	return newExitError(models.WithKind(kind, errors.New(message)))
+}
+
+// getErrorKind returns kind of the error, or the default kind if the error
+// has no kind assigned
+func getErrorKind(err error, defaultKind error) error {
+	for _, kindExitCode := range errorKindsExitCodes {
+		if errors.Is(err, kindExitCode.kind) {
+			return kindExitCode.kind
+		}
+	}
+
+	return defaultKind
+}
@@ -29,3 +29,22 @@ var overwriteFileFlag cli.BoolFlag = cli.BoolFlag{
 	DefaultText: "false",
 	Usage:       "Overwrite provided file(s) paths if exist",
 }
+
+var alphabetFlag cli.StringFlag = cli.StringFlag{
+	Name:        "alphabet",
+	Aliases:     []string{"a"},
+	DefaultText: "",
+	Usage:       "Symbols used to present sudoku values, e.g. 1-9A-G for box size 4, A-Y for box size 5 or a custom list of characters",
+}
+
+var candidatesFlag cli.BoolFlag = cli.BoolFlag{
+	Name:        "candidates",
+	DefaultText: "false",
+	Usage:       "Draw candidates of empty cells in SVG and PNG images",
+}
+
+var timeoutFlag cli.DurationFlag = cli.DurationFlag{
+	Name:        "timeout",
+	DefaultText: "no limit",
+	Usage:       "Maximum duration of solution of single sudoku, e.g. 30s - solution is stopped when it is exceeded",
+}
@@ -0,0 +1,277 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/Michu8258/kangaroo/models"
+	"gopkg.in/yaml.v3"
+)
+
+// EnvironmentPrefix is the prefix of environment variables overriding settings
+const EnvironmentPrefix = "KANGAROO_"
+
+// Values contains settings which can be changed with configuration file or
+// environment variables. Nil value means the setting is not changed.
+type Values struct {
+	MinimumBoxSize    *int8   `yaml:"minBoxSize,omitempty" json:"minBoxSize,omitempty"`
+	MaximumBoxSize    *int8   `yaml:"maxBoxSize,omitempty" json:"maxBoxSize,omitempty"`
+	DefaultBoxSize    *int8   `yaml:"defaultBoxSize,omitempty" json:"defaultBoxSize,omitempty"`
+	MinimumLayoutSize *int8   `yaml:"minLayoutSize,omitempty" json:"minLayoutSize,omitempty"`
+	MaximumLayoutSize *int8   `yaml:"maxLayoutSize,omitempty" json:"maxLayoutSize,omitempty"`
+	DefaultLayoutSize *int8   `yaml:"defaultLayoutSize,omitempty" json:"defaultLayoutSize,omitempty"`
+	ValuePadding      *int8   `yaml:"valuePadding,omitempty" json:"valuePadding,omitempty"`
+	DebugPrints       *bool   `yaml:"debugPrints,omitempty" json:"debugPrints,omitempty"`
+	Silent            *bool   `yaml:"silent,omitempty" json:"silent,omitempty"`
+	EncoderVersion    *uint16 `yaml:"encoderVersion,omitempty" json:"encoderVersion,omitempty"`
+	Output            *string `yaml:"output,omitempty" json:"output,omitempty"`
+	LogLevel          *string `yaml:"logLevel,omitempty" json:"logLevel,omitempty"`
+	LogFormat         *string `yaml:"logFormat,omitempty" json:"logFormat,omitempty"`
+	LogFile           *string `yaml:"logFile,omitempty" json:"logFile,omitempty"`
+}
+
+// environmentVariables maps names of environment variables (without prefix)
+// to functions parsing the variable value into the setting
+var environmentVariables = []struct {
+	name  string
+	parse func(values *Values, value string) error
+}{
+	{name: "MIN_BOX_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.MinimumBoxSize)
+	}},
+	{name: "MAX_BOX_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.MaximumBoxSize)
+	}},
+	{name: "DEFAULT_BOX_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.DefaultBoxSize)
+	}},
+	{name: "MIN_LAYOUT_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.MinimumLayoutSize)
+	}},
+	{name: "MAX_LAYOUT_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.MaximumLayoutSize)
+	}},
+	{name: "DEFAULT_LAYOUT_SIZE", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.DefaultLayoutSize)
+	}},
+	{name: "VALUE_PADDING", parse: func(values *Values, value string) error {
+		return parseInt8(value, &values.ValuePadding)
+	}},
+	{name: "DEBUG_PRINTS", parse: func(values *Values, value string) error {
+		return parseBool(value, &values.DebugPrints)
+	}},
+	{name: "SILENT", parse: func(values *Values, value string) error {
+		return parseBool(value, &values.Silent)
+	}},
+	{name: "ENCODER_VERSION", parse: func(values *Values, value string) error {
+		version, err := strconv.ParseUint(value, 10, 16)
+		if err != nil {
+			return err
+		}
+
+		values.EncoderVersion = new(uint16)
+		*values.EncoderVersion = uint16(version)
+		return nil
+	}},
+	{name: "OUTPUT", parse: func(values *Values, value string) error {
+		values.Output = &value
+		return nil
+	}},
+	{name: "LOG_LEVEL", parse: func(values *Values, value string) error {
+		values.LogLevel = &value
+		return nil
+	}},
+	{name: "LOG_FORMAT", parse: func(values *Values, value string) error {
+		values.LogFormat = &value
+		return nil
+	}},
+	{name: "LOG_FILE", parse: func(values *Values, value string) error {
+		values.LogFile = &value
+		return nil
+	}},
+}
+
+// Load changes settings with values from configuration file and environment variables
+// (in that order, so environment variables take precedence) and validates the result.
+// If the file path is empty, default configuration file is used if it exists. Returns
+// path of loaded configuration file, or empty string if no file was loaded.
+func Load(settings *models.Settings, filePath string,
+	lookupEnv func(key string) (string, bool)) (string, error) {
+
+	required := len(filePath) > 0
+	if !required {
+		filePath = DefaultFilePath(lookupEnv)
+	}
+
+	loadedFilePath := ""
+	if len(filePath) > 0 {
+		values, err := ReadFile(filePath)
+		if err == nil {
+			loadedFilePath = filePath
+			err = values.Apply(settings)
+		}
+
+		if err != nil && (required || !errors.Is(err, os.ErrNotExist)) {
+			return "", err
+		}
+	}
+
+	values, err := ReadEnvironment(lookupEnv)
+	if err == nil {
+		err = values.Apply(settings)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if err = settings.Validate(); err != nil {
+		return "", models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("invalid settings: %w", err))
+	}
+
+	return loadedFilePath, nil
+}
+
+// DefaultFilePath returns path of the configuration file in user configuration
+// directory ($XDG_CONFIG_HOME/kangaroo/config.yaml, ~/.config/kangaroo/config.yaml
+// if the variable is not set). Returns empty string if the directory is unknown.
+func DefaultFilePath(lookupEnv func(key string) (string, bool)) string {
+	configDirectory, ok := lookupEnv("XDG_CONFIG_HOME")
+	if !ok || len(configDirectory) < 1 {
+		homeDirectory, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+
+		configDirectory = filepath.Join(homeDirectory, ".config")
+	}
+
+	return filepath.Join(configDirectory, "kangaroo", "config.yaml")
+}
+
+// ReadFile reads settings values from YAML configuration file
+func ReadFile(filePath string) (*Values, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, models.WithKind(models.ErrIO,
+			fmt.Errorf("failed to read configuration file '%s': %w", filePath, err))
+	}
+
+	values := &Values{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(values)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("invalid configuration file '%s': %w", filePath, err))
+	}
+
+	return values, nil
+}
+
+// ReadEnvironment reads settings values from KANGAROO_* environment variables
+func ReadEnvironment(lookupEnv func(key string) (string, bool)) (*Values, error) {
+	values := &Values{}
+	errs := []error{}
+
+	for _, variable := range environmentVariables {
+		value, ok := lookupEnv(EnvironmentPrefix + variable.name)
+		if !ok {
+			continue
+		}
+
+		if err := variable.parse(values, value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value '%s' of environment variable %s%s",
+				value, EnvironmentPrefix, variable.name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return nil, models.WithKind(models.ErrInvalidInput, errors.Join(errs...))
+	}
+
+	return values, nil
+}
+
+// FromSettings returns values of all settings which can be configured
+func FromSettings(settings *models.Settings) *Values {
+	return &Values{
+		MinimumBoxSize:    &settings.MinimumBoxSizeInclusive,
+		MaximumBoxSize:    &settings.MaximumBoxSizeInclusive,
+		DefaultBoxSize:    &settings.DefaultBoxSize,
+		MinimumLayoutSize: &settings.MinimumLayoutSizeInclusive,
+		MaximumLayoutSize: &settings.MaximumLayoutSizeInclusive,
+		DefaultLayoutSize: &settings.DefaultLayoutSize,
+		ValuePadding:      &settings.SudokuPrintoutValuePaddingLength,
+		DebugPrints:       &settings.UseDebugPrints,
+		Silent:            &settings.SilentConsolePrints,
+		EncoderVersion:    &settings.SudokuBinaryEncoderVersion,
+		Output:            &settings.OutputFormat,
+		LogLevel:          &settings.LogLevel,
+		LogFormat:         &settings.LogFormat,
+		LogFile:           &settings.LogFile,
+	}
+}
+
+// Apply changes settings with all specified values. Values are not
+// validated, use Validate method of the settings afterwards.
+func (values *Values) Apply(settings *models.Settings) error {
+	applyValue(values.MinimumBoxSize, &settings.MinimumBoxSizeInclusive)
+	applyValue(values.MaximumBoxSize, &settings.MaximumBoxSizeInclusive)
+	applyValue(values.DefaultBoxSize, &settings.DefaultBoxSize)
+	applyValue(values.MinimumLayoutSize, &settings.MinimumLayoutSizeInclusive)
+	applyValue(values.MaximumLayoutSize, &settings.MaximumLayoutSizeInclusive)
+	applyValue(values.DefaultLayoutSize, &settings.DefaultLayoutSize)
+	applyValue(values.ValuePadding, &settings.SudokuPrintoutValuePaddingLength)
+	applyValue(values.DebugPrints, &settings.UseDebugPrints)
+	applyValue(values.Silent, &settings.SilentConsolePrints)
+	applyValue(values.EncoderVersion, &settings.SudokuBinaryEncoderVersion)
+	applyValue(values.LogLevel, &settings.LogLevel)
+	applyValue(values.LogFormat, &settings.LogFormat)
+	applyValue(values.LogFile, &settings.LogFile)
+
+	if values.Output != nil {
+		if err := settings.SetOutputFormat(*values.Output); err != nil {
+			return models.WithKind(models.ErrInvalidInput, err)
+		}
+	}
+
+	return nil
+}
+
+// applyValue assigns the value to the destination if the value is specified
+func applyValue[T any](value *T, destination *T) {
+	if value != nil {
+		*destination = *value
+	}
+}
+
+// parseInt8 parses the text into a new int8 value
+func parseInt8(text string, destination **int8) error {
+	value, err := strconv.ParseInt(text, 10, 8)
+	if err != nil {
+		return err
+	}
+
+	*destination = new(int8)
+	**destination = int8(value)
+	return nil
+}
+
+// parseBool parses the text into a new bool value
+func parseBool(text string, destination **bool) error {
+	value, err := strconv.ParseBool(text)
+	if err != nil {
+		return err
+	}
+
+	*destination = &value
+	return nil
+}
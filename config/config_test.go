@@ -0,0 +1,206 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestLoad(t *testing.T) {
+	directory := t.TempDir()
+	writeFile(t, filepath.Join(directory, "kangaroo", "config.yaml"),
+		"maxBoxSize: 4\ndebugPrints: true\nencoderVersion: 2\n")
+	writeFile(t, filepath.Join(directory, "custom.yaml"), "maxLayoutSize: 4\nvaluePadding: 2\n")
+	writeFile(t, filepath.Join(directory, "unknown.yaml"), "boxSize: 4\n")
+	writeFile(t, filepath.Join(directory, "invalid.yaml"), "maxBoxSize: [4]\n")
+	writeFile(t, filepath.Join(directory, "empty.yaml"), "")
+	writeFile(t, filepath.Join(directory, "json.yaml"), "output: json\n")
+
+	testCases := []struct {
+		name             string
+		filePath         string
+		environment      map[string]string
+		expectedFilePath string
+		expectedError    error
+		verify           func(settings *models.Settings) bool
+	}{
+		{
+			name:             "Default configuration file",
+			environment:      map[string]string{"XDG_CONFIG_HOME": directory},
+			expectedFilePath: filepath.Join(directory, "kangaroo", "config.yaml"),
+			verify: func(settings *models.Settings) bool {
+				return settings.MaximumBoxSizeInclusive == 4 && settings.UseDebugPrints &&
+					settings.SudokuBinaryEncoderVersion == 2
+			},
+		},
+		{
+			name:        "Missing default configuration file",
+			environment: map[string]string{"XDG_CONFIG_HOME": filepath.Join(directory, "missing")},
+			verify: func(settings *models.Settings) bool {
+				return settings.MaximumBoxSizeInclusive == 5
+			},
+		},
+		{
+			name:             "Provided configuration file",
+			filePath:         filepath.Join(directory, "custom.yaml"),
+			environment:      map[string]string{"XDG_CONFIG_HOME": directory},
+			expectedFilePath: filepath.Join(directory, "custom.yaml"),
+			verify: func(settings *models.Settings) bool {
+				return settings.MaximumBoxSizeInclusive == 5 && settings.MaximumLayoutSizeInclusive == 4 &&
+					settings.SudokuPrintoutValuePaddingLength == 2
+			},
+		},
+		{
+			name:             "Empty configuration file",
+			filePath:         filepath.Join(directory, "empty.yaml"),
+			expectedFilePath: filepath.Join(directory, "empty.yaml"),
+			verify: func(settings *models.Settings) bool {
+				return settings.MaximumBoxSizeInclusive == 5
+			},
+		},
+		{
+			name:          "Missing provided configuration file",
+			filePath:      filepath.Join(directory, "missing.yaml"),
+			expectedError: models.ErrIO,
+		},
+		{
+			name:          "Unknown setting in configuration file",
+			filePath:      filepath.Join(directory, "unknown.yaml"),
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Invalid configuration file",
+			filePath:      filepath.Join(directory, "invalid.yaml"),
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name: "Environment variables override configuration file",
+			environment: map[string]string{
+				"XDG_CONFIG_HOME":          directory,
+				"KANGAROO_MAX_BOX_SIZE":    "3",
+				"KANGAROO_DEBUG_PRINTS":    "false",
+				"KANGAROO_ENCODER_VERSION": "1",
+				"KANGAROO_OUTPUT":          "json",
+				"KANGAROO_LOG_FORMAT":      "json",
+			},
+			expectedFilePath: filepath.Join(directory, "kangaroo", "config.yaml"),
+			verify: func(settings *models.Settings) bool {
+				return settings.MaximumBoxSizeInclusive == 3 && !settings.UseDebugPrints &&
+					settings.SudokuBinaryEncoderVersion == 1 && settings.IsConsoleSilent() &&
+					settings.OutputFormat == models.JSONOutputFormat &&
+					settings.LogFormat == models.JSONLogFormat
+			},
+		},
+		{
+			name:             "Text output restores console prints suppressed by JSON output",
+			filePath:         filepath.Join(directory, "json.yaml"),
+			environment:      map[string]string{"KANGAROO_OUTPUT": "text"},
+			expectedFilePath: filepath.Join(directory, "json.yaml"),
+			verify: func(settings *models.Settings) bool {
+				return settings.OutputFormat == models.TextOutputFormat &&
+					!settings.SilentConsolePrints && !settings.IsConsoleSilent()
+			},
+		},
+		{
+			name:          "Invalid environment variable",
+			environment:   map[string]string{"KANGAROO_SILENT": "maybe"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Out of range environment variable",
+			environment:   map[string]string{"KANGAROO_MAX_LAYOUT_SIZE": "300"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Invalid output format",
+			environment:   map[string]string{"KANGAROO_OUTPUT": "xml"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Default box size above maximum",
+			environment:   map[string]string{"KANGAROO_MAX_BOX_SIZE": "2"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Unsupported maximum box size",
+			environment:   map[string]string{"KANGAROO_MAX_BOX_SIZE": "9"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Negative padding",
+			environment:   map[string]string{"KANGAROO_VALUE_PADDING": "-1"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Invalid log level",
+			environment:   map[string]string{"KANGAROO_LOG_LEVEL": "verbose"},
+			expectedError: models.ErrInvalidInput,
+		},
+		{
+			name:          "Unsupported encoder version",
+			environment:   map[string]string{"KANGAROO_ENCODER_VERSION": "3"},
+			expectedError: models.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		filePath, err := Load(settings, testCase.filePath, func(key string) (string, bool) {
+			value, ok := testCase.environment[key]
+			if !ok && key == "XDG_CONFIG_HOME" {
+				return filepath.Join(directory, "missing"), true
+			}
+			return value, ok
+		})
+
+		if testCase.expectedError != nil {
+			if !errors.Is(err, testCase.expectedError) {
+				t.Errorf("%s: Expected error '%v', got '%v'.", testCase.name, testCase.expectedError, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Unexpected error: %s", testCase.name, err)
+			continue
+		}
+
+		if filePath != testCase.expectedFilePath {
+			t.Errorf("%s: Expected configuration file '%s', got '%s'.",
+				testCase.name, testCase.expectedFilePath, filePath)
+		}
+
+		if !testCase.verify(settings) {
+			t.Errorf("%s: Unexpected settings %+v.", testCase.name, settings)
+		}
+	}
+}
+
+func TestFromSettings(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	changedSettings := &models.Settings{}
+
+	err := FromSettings(settings).Apply(changedSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *changedSettings != *settings {
+		t.Errorf("Expected settings %+v, got %+v.", settings, changedSettings)
+	}
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err == nil {
+		err = os.WriteFile(path, []byte(content), 0644)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,222 @@
+package grpcServer
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Michu8258/kangaroo/grpcServer/kangaroopb"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// result types of the sudoku solution represented in gRPC messages
+var resultTypes = map[models.SudokuResultType]kangaroopb.Result{
+	models.Unspecified:         kangaroopb.Result_RESULT_UNSPECIFIED,
+	models.SuccessfullSolution: kangaroopb.Result_RESULT_SUCCESS,
+	models.Failure:             kangaroopb.Result_RESULT_FAILURE,
+	models.InvalidGuess:        kangaroopb.Result_RESULT_INVALID_GUESS,
+	models.UnsolvableSudoku:    kangaroopb.Result_RESULT_UNSOLVABLE,
+}
+
+// toSudokuDto converts sudoku gRPC message to sudoku DTO object. Returns an
+// error if the sudoku is missing or its sizes and indexes are out of range.
+func toSudokuDto(sudokuMessage *kangaroopb.Sudoku) (*models.SudokuDTO, error) {
+	if sudokuMessage == nil {
+		return nil, models.WithKind(models.ErrInvalidInput, errors.New("sudoku is missing in the request"))
+	}
+
+	converter := &int8Converter{}
+	sudokuDto := &models.SudokuDTO{
+		BoxSize: converter.convert(sudokuMessage.GetBoxSize(), "box size"),
+		Layout: models.SudokuLayoutDTO{
+			Width:  converter.convert(sudokuMessage.GetLayout().GetWidth(), "layout width"),
+			Height: converter.convert(sudokuMessage.GetLayout().GetHeight(), "layout height"),
+		},
+		Alphabet: sudokuMessage.GetAlphabet(),
+		Boxes:    models.GenericSlice[*models.SudokuBoxDTO]{},
+	}
+
+	for _, boxMessage := range sudokuMessage.GetBoxes() {
+		boxDto := &models.SudokuBoxDTO{
+			Disabled:    boxMessage.GetDisabled(),
+			IndexRow:    converter.convert(boxMessage.GetIndexRow(), "box row index"),
+			IndexColumn: converter.convert(boxMessage.GetIndexColumn(), "box column index"),
+			Cells:       models.GenericSlice[*models.SudokuCellDTO]{},
+		}
+
+		for _, cellMessage := range boxMessage.GetCells() {
+			cellDto := &models.SudokuCellDTO{
+				Symbol:           cellMessage.GetSymbol(),
+				IndexRowInBox:    converter.convert(cellMessage.GetIndexRowInBox(), "cell row index"),
+				IndexColumnInBox: converter.convert(cellMessage.GetIndexColumnInBox(), "cell column index"),
+			}
+
+			if cellMessage.Value != nil {
+				value := int(cellMessage.GetValue())
+				cellDto.Value = &value
+			}
+
+			boxDto.Cells = append(boxDto.Cells, cellDto)
+		}
+
+		sudokuDto.Boxes = append(sudokuDto.Boxes, boxDto)
+	}
+
+	if converter.err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput, converter.err)
+	}
+
+	for _, thermometerMessage := range sudokuMessage.GetThermometers() {
+		sudokuDto.Thermometers = append(sudokuDto.Thermometers, &models.SudokuThermometerDTO{
+			Cells: toCellPositionDtos(thermometerMessage.GetCells()),
+		})
+	}
+
+	for _, arrowMessage := range sudokuMessage.GetArrows() {
+		sudokuDto.Arrows = append(sudokuDto.Arrows, &models.SudokuArrowDTO{
+			Circle: toCellPositionDto(arrowMessage.GetCircle()),
+			Cells:  toCellPositionDtos(arrowMessage.GetCells()),
+		})
+	}
+
+	for _, comparisonMessage := range sudokuMessage.GetComparisons() {
+		sudokuDto.Comparisons = append(sudokuDto.Comparisons, &models.SudokuComparisonDTO{
+			Greater: toCellPositionDto(comparisonMessage.GetGreater()),
+			Lesser:  toCellPositionDto(comparisonMessage.GetLesser()),
+		})
+	}
+
+	err := sudokuDto.ResolveSymbols()
+	if err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput, err)
+	}
+
+	return sudokuDto, nil
+}
+
+// toSudokuMessage converts sudoku DTO object to gRPC message
+func toSudokuMessage(sudokuDto *models.SudokuDTO) *kangaroopb.Sudoku {
+	sudokuMessage := &kangaroopb.Sudoku{
+		BoxSize: int32(sudokuDto.BoxSize),
+		Layout: &kangaroopb.Layout{
+			Width:  int32(sudokuDto.Layout.Width),
+			Height: int32(sudokuDto.Layout.Height),
+		},
+		Alphabet: sudokuDto.Alphabet,
+	}
+
+	for _, boxDto := range sudokuDto.Boxes {
+		boxMessage := &kangaroopb.Box{
+			Disabled:    boxDto.Disabled,
+			IndexRow:    int32(boxDto.IndexRow),
+			IndexColumn: int32(boxDto.IndexColumn),
+		}
+
+		for _, cellDto := range boxDto.Cells {
+			cellMessage := &kangaroopb.Cell{
+				Symbol:           cellDto.Symbol,
+				IndexRowInBox:    int32(cellDto.IndexRowInBox),
+				IndexColumnInBox: int32(cellDto.IndexColumnInBox),
+			}
+
+			if cellDto.Value != nil {
+				value := int32(*cellDto.Value)
+				cellMessage.Value = &value
+			}
+
+			boxMessage.Cells = append(boxMessage.Cells, cellMessage)
+		}
+
+		sudokuMessage.Boxes = append(sudokuMessage.Boxes, boxMessage)
+	}
+
+	for _, thermometerDto := range sudokuDto.Thermometers {
+		sudokuMessage.Thermometers = append(sudokuMessage.Thermometers, &kangaroopb.Thermometer{
+			Cells: toCellPositionMessages(thermometerDto.Cells),
+		})
+	}
+
+	for _, arrowDto := range sudokuDto.Arrows {
+		sudokuMessage.Arrows = append(sudokuMessage.Arrows, &kangaroopb.Arrow{
+			Circle: toCellPositionMessage(arrowDto.Circle),
+			Cells:  toCellPositionMessages(arrowDto.Cells),
+		})
+	}
+
+	for _, comparisonDto := range sudokuDto.Comparisons {
+		sudokuMessage.Comparisons = append(sudokuMessage.Comparisons, &kangaroopb.Comparison{
+			Greater: toCellPositionMessage(comparisonDto.Greater),
+			Lesser:  toCellPositionMessage(comparisonDto.Lesser),
+		})
+	}
+
+	return sudokuMessage
+}
+
+// toResultMessage converts result type of the sudoku solution to gRPC enum
+func toResultMessage(resultType models.SudokuResultType) kangaroopb.Result {
+	result, ok := resultTypes[resultType]
+	if !ok {
+		return kangaroopb.Result_RESULT_UNSPECIFIED
+	}
+
+	return result
+}
+
+// toCellPositionDto converts cell position gRPC message to DTO object
+func toCellPositionDto(positionMessage *kangaroopb.CellPosition) models.SudokuCellPositionDTO {
+	return models.SudokuCellPositionDTO{
+		Row:    int(positionMessage.GetRow()),
+		Column: int(positionMessage.GetColumn()),
+	}
+}
+
+// toCellPositionDtos converts cell positions gRPC messages to DTO objects
+func toCellPositionDtos(positionMessages []*kangaroopb.CellPosition) models.GenericSlice[*models.SudokuCellPositionDTO] {
+	positionDtos := models.GenericSlice[*models.SudokuCellPositionDTO]{}
+	for _, positionMessage := range positionMessages {
+		positionDto := toCellPositionDto(positionMessage)
+		positionDtos = append(positionDtos, &positionDto)
+	}
+
+	return positionDtos
+}
+
+// toCellPositionMessage converts cell position DTO object to gRPC message
+func toCellPositionMessage(positionDto models.SudokuCellPositionDTO) *kangaroopb.CellPosition {
+	return &kangaroopb.CellPosition{
+		Row:    int32(positionDto.Row),
+		Column: int32(positionDto.Column),
+	}
+}
+
+// toCellPositionMessages converts cell positions DTO objects to gRPC messages
+func toCellPositionMessages(positionDtos models.GenericSlice[*models.SudokuCellPositionDTO]) []*kangaroopb.CellPosition {
+	positionMessages := []*kangaroopb.CellPosition{}
+	for _, positionDto := range positionDtos {
+		if positionDto != nil {
+			positionMessages = append(positionMessages, toCellPositionMessage(*positionDto))
+		}
+	}
+
+	return positionMessages
+}
+
+// int8Converter converts gRPC integers to sizes and indexes of sudoku DTO object.
+// Keeps the first out of range error.
+type int8Converter struct {
+	err error
+}
+
+// convert returns the value as int8, or 0 if the value is out of range
+func (converter *int8Converter) convert(value int32, name string) int8 {
+	if value < math.MinInt8 || value > math.MaxInt8 {
+		if converter.err == nil {
+			converter.err = fmt.Errorf("%s %d is out of range", name, value)
+		}
+
+		return 0
+	}
+
+	return int8(value)
+}
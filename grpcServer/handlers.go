@@ -0,0 +1,187 @@
+package grpcServer
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/Michu8258/kangaroo/grpcServer/kangaroopb"
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Solve solves the sudoku with the library. Solution is stopped when the context is done.
+func (server *Server) Solve(ctx context.Context, request *kangaroopb.SolveRequest) (
+	*kangaroopb.SolveResponse, error) {
+
+	sudokuDto, err := toSudokuDto(request.GetSudoku())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	release, err := server.Library.AcquireSolve(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	defer release()
+
+	solution, err := server.Library.Solve(ctx, sudokuDto)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &kangaroopb.SolveResponse{
+		Result: toResultMessage(models.SuccessfullSolution),
+		Sudoku: toSudokuMessage(solution),
+	}, nil
+}
+
+// Validate checks sudoku configuration and values against sudoku rules and
+// counts its solutions (up to 2, which means there are at least 2 solutions).
+// Valid means that the sudoku follows the rules, it can still have no solution.
+func (server *Server) Validate(ctx context.Context, request *kangaroopb.ValidateRequest) (
+	*kangaroopb.ValidateResponse, error) {
+
+	sudokuDto, err := toSudokuDto(request.GetSudoku())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response := &kangaroopb.ValidateResponse{
+		ValidationErrors: []string{},
+	}
+
+	sudoku, err := server.Library.Initialize(sudokuDto)
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		for _, err := range validationError.Errors {
+			response.ValidationErrors = append(response.ValidationErrors, err.Error())
+		}
+
+		return response, nil
+	}
+
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	release, err := server.Library.AcquireSolve(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	defer release()
+
+	solutionsCount, err := server.Library.CountSolutions(ctx, sudoku)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	response.Valid = true
+	response.SolutionsCount = int32(solutionsCount)
+	return response, nil
+}
+
+// Encode converts the sudoku to binary data format
+func (server *Server) Encode(ctx context.Context, request *kangaroopb.EncodeRequest) (
+	*kangaroopb.EncodeResponse, error) {
+
+	sudokuDto, err := toSudokuDto(request.GetSudoku())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	data, err := server.Library.Encode(sudokuDto, library.EncodingRaw)
+	if err != nil {
+		return nil, toStatusError(models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("failed to encode the sudoku: %s", err)))
+	}
+
+	return &kangaroopb.EncodeResponse{
+		Data:   data,
+		Base64: base64.StdEncoding.EncodeToString(data),
+	}, nil
+}
+
+// Decode reads the sudoku from binary data format
+func (server *Server) Decode(ctx context.Context, request *kangaroopb.DecodeRequest) (
+	*kangaroopb.DecodeResponse, error) {
+
+	var sudokuDto *models.SudokuDTO
+	var err error
+
+	switch input := request.GetInput().(type) {
+	case *kangaroopb.DecodeRequest_Data:
+		sudokuDto, err = server.Library.Decode(input.Data, library.EncodingRaw)
+		if err != nil {
+			err = models.WithKind(models.ErrInvalidInput,
+				fmt.Errorf("failed to parse sudoku binary data: %s", err))
+		}
+	case *kangaroopb.DecodeRequest_Base64:
+		sudokuDto, err = server.Library.Parse([]byte(input.Base64), library.WithFormat(library.FormatBase64))
+	default:
+		err = models.WithKind(models.ErrInvalidInput, errors.New("sudoku data is missing in the request"))
+	}
+
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &kangaroopb.DecodeResponse{
+		Sudoku: toSudokuMessage(sudokuDto),
+	}, nil
+}
+
+// EnumerateSolutions streams solutions of the sudoku, up to the limit
+func (server *Server) EnumerateSolutions(request *kangaroopb.EnumerateSolutionsRequest,
+	stream kangaroopb.SudokuService_EnumerateSolutionsServer) error {
+
+	if request.GetLimit() < 0 {
+		return toStatusError(models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("solutions limit %d can not be negative", request.GetLimit())))
+	}
+
+	sudoku, err := server.readSudoku(request.GetSudoku())
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	release, err := server.Library.AcquireSolve(stream.Context())
+	if err != nil {
+		return toStatusError(err)
+	}
+	defer release()
+
+	var sendError error
+	solutionNumber := int32(0)
+	_, err = server.Library.FindSolutions(stream.Context(), sudoku,
+		int(request.GetLimit()), func(solution *library.Sudoku) bool {
+			solutionNumber++
+			sendError = stream.Send(&kangaroopb.EnumerateSolutionsResponse{
+				Number:   solutionNumber,
+				Solution: toSudokuMessage(solution),
+			})
+
+			return sendError == nil
+		})
+
+	if sendError != nil {
+		return sendError
+	}
+
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	return nil
+}
+
+// readSudoku converts sudoku message to sudoku object and initializes it with the library
+func (server *Server) readSudoku(sudokuMessage *kangaroopb.Sudoku) (*models.Sudoku, error) {
+	sudokuDto, err := toSudokuDto(sudokuMessage)
+	if err != nil {
+		return nil, err
+	}
+
+	return server.Library.Initialize(sudokuDto)
+}
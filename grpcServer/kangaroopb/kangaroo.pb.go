@@ -0,0 +1,1621 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: kangaroopb/kangaroo.proto
+
+package kangaroopb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Result int32
+
+const (
+	Result_RESULT_UNSPECIFIED   Result = 0
+	Result_RESULT_SUCCESS       Result = 1
+	Result_RESULT_FAILURE       Result = 2
+	Result_RESULT_INVALID_GUESS Result = 3
+	Result_RESULT_UNSOLVABLE    Result = 4
+)
+
+// Enum value maps for Result.
+var (
+	Result_name = map[int32]string{
+		0: "RESULT_UNSPECIFIED",
+		1: "RESULT_SUCCESS",
+		2: "RESULT_FAILURE",
+		3: "RESULT_INVALID_GUESS",
+		4: "RESULT_UNSOLVABLE",
+	}
+	Result_value = map[string]int32{
+		"RESULT_UNSPECIFIED":   0,
+		"RESULT_SUCCESS":       1,
+		"RESULT_FAILURE":       2,
+		"RESULT_INVALID_GUESS": 3,
+		"RESULT_UNSOLVABLE":    4,
+	}
+)
+
+func (x Result) Enum() *Result {
+	p := new(Result)
+	*p = x
+	return p
+}
+
+func (x Result) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Result) Descriptor() protoreflect.EnumDescriptor {
+	return file_kangaroopb_kangaroo_proto_enumTypes[0].Descriptor()
+}
+
+func (Result) Type() protoreflect.EnumType {
+	return &file_kangaroopb_kangaroo_proto_enumTypes[0]
+}
+
+func (x Result) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Result.Descriptor instead.
+func (Result) EnumDescriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{0}
+}
+
+type Layout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Width  int32 `protobuf:"varint,1,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *Layout) Reset() {
+	*x = Layout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Layout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Layout) ProtoMessage() {}
+
+func (x *Layout) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Layout.ProtoReflect.Descriptor instead.
+func (*Layout) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Layout) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Layout) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type Cell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// value of the cell, not set for empty cells
+	Value *int32 `protobuf:"varint,1,opt,name=value,proto3,oneof" json:"value,omitempty"`
+	// symbol of the value according to the sudoku alphabet
+	Symbol           string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	IndexRowInBox    int32  `protobuf:"varint,3,opt,name=index_row_in_box,json=indexRowInBox,proto3" json:"index_row_in_box,omitempty"`
+	IndexColumnInBox int32  `protobuf:"varint,4,opt,name=index_column_in_box,json=indexColumnInBox,proto3" json:"index_column_in_box,omitempty"`
+}
+
+func (x *Cell) Reset() {
+	*x = Cell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Cell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Cell) ProtoMessage() {}
+
+func (x *Cell) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Cell.ProtoReflect.Descriptor instead.
+func (*Cell) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Cell) GetValue() int32 {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return 0
+}
+
+func (x *Cell) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *Cell) GetIndexRowInBox() int32 {
+	if x != nil {
+		return x.IndexRowInBox
+	}
+	return 0
+}
+
+func (x *Cell) GetIndexColumnInBox() int32 {
+	if x != nil {
+		return x.IndexColumnInBox
+	}
+	return 0
+}
+
+type Box struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Disabled    bool    `protobuf:"varint,1,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	IndexRow    int32   `protobuf:"varint,2,opt,name=index_row,json=indexRow,proto3" json:"index_row,omitempty"`
+	IndexColumn int32   `protobuf:"varint,3,opt,name=index_column,json=indexColumn,proto3" json:"index_column,omitempty"`
+	Cells       []*Cell `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *Box) Reset() {
+	*x = Box{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Box) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Box) ProtoMessage() {}
+
+func (x *Box) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Box.ProtoReflect.Descriptor instead.
+func (*Box) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Box) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Box) GetIndexRow() int32 {
+	if x != nil {
+		return x.IndexRow
+	}
+	return 0
+}
+
+func (x *Box) GetIndexColumn() int32 {
+	if x != nil {
+		return x.IndexColumn
+	}
+	return 0
+}
+
+func (x *Box) GetCells() []*Cell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+// CellPosition is a zero based position of the cell in the entire sudoku
+type CellPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Row    int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Column int32 `protobuf:"varint,2,opt,name=column,proto3" json:"column,omitempty"`
+}
+
+func (x *CellPosition) Reset() {
+	*x = CellPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CellPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CellPosition) ProtoMessage() {}
+
+func (x *CellPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CellPosition.ProtoReflect.Descriptor instead.
+func (*CellPosition) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CellPosition) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *CellPosition) GetColumn() int32 {
+	if x != nil {
+		return x.Column
+	}
+	return 0
+}
+
+type Thermometer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*CellPosition `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *Thermometer) Reset() {
+	*x = Thermometer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Thermometer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Thermometer) ProtoMessage() {}
+
+func (x *Thermometer) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Thermometer.ProtoReflect.Descriptor instead.
+func (*Thermometer) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Thermometer) GetCells() []*CellPosition {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type Arrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Circle *CellPosition   `protobuf:"bytes,1,opt,name=circle,proto3" json:"circle,omitempty"`
+	Cells  []*CellPosition `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
+}
+
+func (x *Arrow) Reset() {
+	*x = Arrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Arrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Arrow) ProtoMessage() {}
+
+func (x *Arrow) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Arrow.ProtoReflect.Descriptor instead.
+func (*Arrow) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Arrow) GetCircle() *CellPosition {
+	if x != nil {
+		return x.Circle
+	}
+	return nil
+}
+
+func (x *Arrow) GetCells() []*CellPosition {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+type Comparison struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Greater *CellPosition `protobuf:"bytes,1,opt,name=greater,proto3" json:"greater,omitempty"`
+	Lesser  *CellPosition `protobuf:"bytes,2,opt,name=lesser,proto3" json:"lesser,omitempty"`
+}
+
+func (x *Comparison) Reset() {
+	*x = Comparison{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Comparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Comparison) ProtoMessage() {}
+
+func (x *Comparison) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Comparison.ProtoReflect.Descriptor instead.
+func (*Comparison) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Comparison) GetGreater() *CellPosition {
+	if x != nil {
+		return x.Greater
+	}
+	return nil
+}
+
+func (x *Comparison) GetLesser() *CellPosition {
+	if x != nil {
+		return x.Lesser
+	}
+	return nil
+}
+
+type Sudoku struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BoxSize      int32          `protobuf:"varint,1,opt,name=box_size,json=boxSize,proto3" json:"box_size,omitempty"`
+	Layout       *Layout        `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
+	Alphabet     string         `protobuf:"bytes,3,opt,name=alphabet,proto3" json:"alphabet,omitempty"`
+	Boxes        []*Box         `protobuf:"bytes,4,rep,name=boxes,proto3" json:"boxes,omitempty"`
+	Thermometers []*Thermometer `protobuf:"bytes,5,rep,name=thermometers,proto3" json:"thermometers,omitempty"`
+	Arrows       []*Arrow       `protobuf:"bytes,6,rep,name=arrows,proto3" json:"arrows,omitempty"`
+	Comparisons  []*Comparison  `protobuf:"bytes,7,rep,name=comparisons,proto3" json:"comparisons,omitempty"`
+}
+
+func (x *Sudoku) Reset() {
+	*x = Sudoku{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Sudoku) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sudoku) ProtoMessage() {}
+
+func (x *Sudoku) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sudoku.ProtoReflect.Descriptor instead.
+func (*Sudoku) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Sudoku) GetBoxSize() int32 {
+	if x != nil {
+		return x.BoxSize
+	}
+	return 0
+}
+
+func (x *Sudoku) GetLayout() *Layout {
+	if x != nil {
+		return x.Layout
+	}
+	return nil
+}
+
+func (x *Sudoku) GetAlphabet() string {
+	if x != nil {
+		return x.Alphabet
+	}
+	return ""
+}
+
+func (x *Sudoku) GetBoxes() []*Box {
+	if x != nil {
+		return x.Boxes
+	}
+	return nil
+}
+
+func (x *Sudoku) GetThermometers() []*Thermometer {
+	if x != nil {
+		return x.Thermometers
+	}
+	return nil
+}
+
+func (x *Sudoku) GetArrows() []*Arrow {
+	if x != nil {
+		return x.Arrows
+	}
+	return nil
+}
+
+func (x *Sudoku) GetComparisons() []*Comparison {
+	if x != nil {
+		return x.Comparisons
+	}
+	return nil
+}
+
+type SolveRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sudoku *Sudoku `protobuf:"bytes,1,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+}
+
+func (x *SolveRequest) Reset() {
+	*x = SolveRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveRequest) ProtoMessage() {}
+
+func (x *SolveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveRequest.ProtoReflect.Descriptor instead.
+func (*SolveRequest) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SolveRequest) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+type SolveResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Result Result  `protobuf:"varint,1,opt,name=result,proto3,enum=kangaroo.v1.Result" json:"result,omitempty"`
+	Sudoku *Sudoku `protobuf:"bytes,2,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+}
+
+func (x *SolveResponse) Reset() {
+	*x = SolveResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SolveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolveResponse) ProtoMessage() {}
+
+func (x *SolveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolveResponse.ProtoReflect.Descriptor instead.
+func (*SolveResponse) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SolveResponse) GetResult() Result {
+	if x != nil {
+		return x.Result
+	}
+	return Result_RESULT_UNSPECIFIED
+}
+
+func (x *SolveResponse) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+type ValidateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sudoku *Sudoku `protobuf:"bytes,1,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+}
+
+func (x *ValidateRequest) Reset() {
+	*x = ValidateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateRequest) ProtoMessage() {}
+
+func (x *ValidateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateRequest.ProtoReflect.Descriptor instead.
+func (*ValidateRequest) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ValidateRequest) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+type ValidateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the sudoku follows the rules, it can still have no solution
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// amount of solutions, 2 means there are at least 2 solutions
+	SolutionsCount   int32    `protobuf:"varint,2,opt,name=solutions_count,json=solutionsCount,proto3" json:"solutions_count,omitempty"`
+	ValidationErrors []string `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+}
+
+func (x *ValidateResponse) Reset() {
+	*x = ValidateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateResponse) ProtoMessage() {}
+
+func (x *ValidateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateResponse.ProtoReflect.Descriptor instead.
+func (*ValidateResponse) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ValidateResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateResponse) GetSolutionsCount() int32 {
+	if x != nil {
+		return x.SolutionsCount
+	}
+	return 0
+}
+
+func (x *ValidateResponse) GetValidationErrors() []string {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+type EncodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sudoku *Sudoku `protobuf:"bytes,1,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+}
+
+func (x *EncodeRequest) Reset() {
+	*x = EncodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeRequest) ProtoMessage() {}
+
+func (x *EncodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeRequest.ProtoReflect.Descriptor instead.
+func (*EncodeRequest) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EncodeRequest) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+type EncodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// data encoded with standard base64 encoding
+	Base64 string `protobuf:"bytes,2,opt,name=base64,proto3" json:"base64,omitempty"`
+}
+
+func (x *EncodeResponse) Reset() {
+	*x = EncodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EncodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EncodeResponse) ProtoMessage() {}
+
+func (x *EncodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EncodeResponse.ProtoReflect.Descriptor instead.
+func (*EncodeResponse) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EncodeResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *EncodeResponse) GetBase64() string {
+	if x != nil {
+		return x.Base64
+	}
+	return ""
+}
+
+type DecodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//	*DecodeRequest_Data
+	//	*DecodeRequest_Base64
+	Input isDecodeRequest_Input `protobuf_oneof:"input"`
+}
+
+func (x *DecodeRequest) Reset() {
+	*x = DecodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeRequest) ProtoMessage() {}
+
+func (x *DecodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeRequest.ProtoReflect.Descriptor instead.
+func (*DecodeRequest) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *DecodeRequest) GetInput() isDecodeRequest_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *DecodeRequest) GetData() []byte {
+	if x, ok := x.GetInput().(*DecodeRequest_Data); ok {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *DecodeRequest) GetBase64() string {
+	if x, ok := x.GetInput().(*DecodeRequest_Base64); ok {
+		return x.Base64
+	}
+	return ""
+}
+
+type isDecodeRequest_Input interface {
+	isDecodeRequest_Input()
+}
+
+type DecodeRequest_Data struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3,oneof"`
+}
+
+type DecodeRequest_Base64 struct {
+	// standard or URL-safe base64 encoded data
+	Base64 string `protobuf:"bytes,2,opt,name=base64,proto3,oneof"`
+}
+
+func (*DecodeRequest_Data) isDecodeRequest_Input() {}
+
+func (*DecodeRequest_Base64) isDecodeRequest_Input() {}
+
+type DecodeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sudoku *Sudoku `protobuf:"bytes,1,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+}
+
+func (x *DecodeResponse) Reset() {
+	*x = DecodeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DecodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodeResponse) ProtoMessage() {}
+
+func (x *DecodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodeResponse.ProtoReflect.Descriptor instead.
+func (*DecodeResponse) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DecodeResponse) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+type EnumerateSolutionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sudoku *Sudoku `protobuf:"bytes,1,opt,name=sudoku,proto3" json:"sudoku,omitempty"`
+	// maximum amount of streamed solutions, 0 means no limit (the stream
+	// is still limited by the request timeout)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *EnumerateSolutionsRequest) Reset() {
+	*x = EnumerateSolutionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumerateSolutionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumerateSolutionsRequest) ProtoMessage() {}
+
+func (x *EnumerateSolutionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumerateSolutionsRequest.ProtoReflect.Descriptor instead.
+func (*EnumerateSolutionsRequest) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EnumerateSolutionsRequest) GetSudoku() *Sudoku {
+	if x != nil {
+		return x.Sudoku
+	}
+	return nil
+}
+
+func (x *EnumerateSolutionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type EnumerateSolutionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// one based number of the solution
+	Number   int32   `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	Solution *Sudoku `protobuf:"bytes,2,opt,name=solution,proto3" json:"solution,omitempty"`
+}
+
+func (x *EnumerateSolutionsResponse) Reset() {
+	*x = EnumerateSolutionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kangaroopb_kangaroo_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumerateSolutionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumerateSolutionsResponse) ProtoMessage() {}
+
+func (x *EnumerateSolutionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_kangaroopb_kangaroo_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumerateSolutionsResponse.ProtoReflect.Descriptor instead.
+func (*EnumerateSolutionsResponse) Descriptor() ([]byte, []int) {
+	return file_kangaroopb_kangaroo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EnumerateSolutionsResponse) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *EnumerateSolutionsResponse) GetSolution() *Sudoku {
+	if x != nil {
+		return x.Solution
+	}
+	return nil
+}
+
+var File_kangaroopb_kangaroo_proto protoreflect.FileDescriptor
+
+var file_kangaroopb_kangaroo_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x70, 0x62, 0x2f, 0x6b, 0x61, 0x6e,
+	0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6b, 0x61, 0x6e,
+	0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x22, 0x36, 0x0a, 0x06, 0x4c, 0x61, 0x79, 0x6f,
+	0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x9b, 0x01, 0x0a, 0x04, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x19, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12, 0x27, 0x0a, 0x10,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x6f, 0x77,
+	0x49, 0x6e, 0x42, 0x6f, 0x78, 0x12, 0x2d, 0x0a, 0x13, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x49,
+	0x6e, 0x42, 0x6f, 0x78, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a,
+	0x01, 0x0a, 0x03, 0x42, 0x6f, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x72, 0x6f, 0x77, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x6f, 0x77, 0x12,
+	0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x43, 0x6f, 0x6c, 0x75,
+	0x6d, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x38, 0x0a, 0x0c, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x12, 0x16, 0x0a,
+	0x06, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63,
+	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x22, 0x3e, 0x0a, 0x0b, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05,
+	0x63, 0x65, 0x6c, 0x6c, 0x73, 0x22, 0x6b, 0x0a, 0x05, 0x41, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x31,
+	0x0a, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c,
+	0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x12, 0x2f, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x63, 0x65, 0x6c,
+	0x6c, 0x73, 0x22, 0x74, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x07, 0x67, 0x72, 0x65, 0x61, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x67, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x6c, 0x65, 0x73, 0x73, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x6c, 0x6c, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x06, 0x6c, 0x65, 0x73, 0x73, 0x65, 0x72, 0x22, 0xb9, 0x02, 0x0a, 0x06, 0x53, 0x75, 0x64,
+	0x6f, 0x6b, 0x75, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6f, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x62, 0x6f, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b,
+	0x0a, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x79,
+	0x6f, 0x75, 0x74, 0x52, 0x06, 0x6c, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x6c, 0x70, 0x68, 0x61, 0x62, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x05, 0x62, 0x6f, 0x78, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x78, 0x52, 0x05, 0x62, 0x6f, 0x78, 0x65, 0x73, 0x12,
+	0x3c, 0x0a, 0x0c, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x52,
+	0x0c, 0x74, 0x68, 0x65, 0x72, 0x6d, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a,
+	0x06, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x6f,
+	0x77, 0x52, 0x06, 0x61, 0x72, 0x72, 0x6f, 0x77, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x73, 0x22, 0x3b, 0x0a, 0x0c, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b,
+	0x75, 0x22, 0x69, 0x0a, 0x0d, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x64, 0x6f, 0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x22, 0x3e, 0x0a, 0x0f,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2b, 0x0a, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
+	0x64, 0x6f, 0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x22, 0x7e, 0x0a, 0x10,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0e, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x2b, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x0d,
+	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a,
+	0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x64, 0x6f,
+	0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x22, 0x3c, 0x0a, 0x0e, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x22, 0x48, 0x0a, 0x0d, 0x44, 0x65, 0x63, 0x6f,
+	0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x18, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0x3d, 0x0a, 0x0e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b,
+	0x75, 0x22, 0x5e, 0x0a, 0x19, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x64,
+	0x6f, 0x6b, 0x75, 0x52, 0x06, 0x73, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x65, 0x0a, 0x1a, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x61, 0x6e, 0x67,
+	0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x52, 0x08,
+	0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x79, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45,
+	0x53, 0x55, 0x4c, 0x54, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45,
+	0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11,
+	0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x4f, 0x4c, 0x56, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x04, 0x32, 0x87, 0x03, 0x0a, 0x0d, 0x53, 0x75, 0x64, 0x6f, 0x6b, 0x75, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x05, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x12, 0x19,
+	0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c,
+	0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x6b, 0x61, 0x6e, 0x67,
+	0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x6c, 0x76, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x1c, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x06, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61,
+	0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x06, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x61,
+	0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72,
+	0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x61, 0x6e,
+	0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x35, 0x5a,
+	0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4d, 0x69, 0x63, 0x68,
+	0x75, 0x38, 0x32, 0x35, 0x38, 0x2f, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72, 0x6f, 0x6f, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6b, 0x61, 0x6e, 0x67, 0x61, 0x72,
+	0x6f, 0x6f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kangaroopb_kangaroo_proto_rawDescOnce sync.Once
+	file_kangaroopb_kangaroo_proto_rawDescData = file_kangaroopb_kangaroo_proto_rawDesc
+)
+
+func file_kangaroopb_kangaroo_proto_rawDescGZIP() []byte {
+	file_kangaroopb_kangaroo_proto_rawDescOnce.Do(func() {
+		file_kangaroopb_kangaroo_proto_rawDescData = protoimpl.X.CompressGZIP(file_kangaroopb_kangaroo_proto_rawDescData)
+	})
+	return file_kangaroopb_kangaroo_proto_rawDescData
+}
+
+var file_kangaroopb_kangaroo_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_kangaroopb_kangaroo_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_kangaroopb_kangaroo_proto_goTypes = []any{
+	(Result)(0),                        // 0: kangaroo.v1.Result
+	(*Layout)(nil),                     // 1: kangaroo.v1.Layout
+	(*Cell)(nil),                       // 2: kangaroo.v1.Cell
+	(*Box)(nil),                        // 3: kangaroo.v1.Box
+	(*CellPosition)(nil),               // 4: kangaroo.v1.CellPosition
+	(*Thermometer)(nil),                // 5: kangaroo.v1.Thermometer
+	(*Arrow)(nil),                      // 6: kangaroo.v1.Arrow
+	(*Comparison)(nil),                 // 7: kangaroo.v1.Comparison
+	(*Sudoku)(nil),                     // 8: kangaroo.v1.Sudoku
+	(*SolveRequest)(nil),               // 9: kangaroo.v1.SolveRequest
+	(*SolveResponse)(nil),              // 10: kangaroo.v1.SolveResponse
+	(*ValidateRequest)(nil),            // 11: kangaroo.v1.ValidateRequest
+	(*ValidateResponse)(nil),           // 12: kangaroo.v1.ValidateResponse
+	(*EncodeRequest)(nil),              // 13: kangaroo.v1.EncodeRequest
+	(*EncodeResponse)(nil),             // 14: kangaroo.v1.EncodeResponse
+	(*DecodeRequest)(nil),              // 15: kangaroo.v1.DecodeRequest
+	(*DecodeResponse)(nil),             // 16: kangaroo.v1.DecodeResponse
+	(*EnumerateSolutionsRequest)(nil),  // 17: kangaroo.v1.EnumerateSolutionsRequest
+	(*EnumerateSolutionsResponse)(nil), // 18: kangaroo.v1.EnumerateSolutionsResponse
+}
+var file_kangaroopb_kangaroo_proto_depIdxs = []int32{
+	2,  // 0: kangaroo.v1.Box.cells:type_name -> kangaroo.v1.Cell
+	4,  // 1: kangaroo.v1.Thermometer.cells:type_name -> kangaroo.v1.CellPosition
+	4,  // 2: kangaroo.v1.Arrow.circle:type_name -> kangaroo.v1.CellPosition
+	4,  // 3: kangaroo.v1.Arrow.cells:type_name -> kangaroo.v1.CellPosition
+	4,  // 4: kangaroo.v1.Comparison.greater:type_name -> kangaroo.v1.CellPosition
+	4,  // 5: kangaroo.v1.Comparison.lesser:type_name -> kangaroo.v1.CellPosition
+	1,  // 6: kangaroo.v1.Sudoku.layout:type_name -> kangaroo.v1.Layout
+	3,  // 7: kangaroo.v1.Sudoku.boxes:type_name -> kangaroo.v1.Box
+	5,  // 8: kangaroo.v1.Sudoku.thermometers:type_name -> kangaroo.v1.Thermometer
+	6,  // 9: kangaroo.v1.Sudoku.arrows:type_name -> kangaroo.v1.Arrow
+	7,  // 10: kangaroo.v1.Sudoku.comparisons:type_name -> kangaroo.v1.Comparison
+	8,  // 11: kangaroo.v1.SolveRequest.sudoku:type_name -> kangaroo.v1.Sudoku
+	0,  // 12: kangaroo.v1.SolveResponse.result:type_name -> kangaroo.v1.Result
+	8,  // 13: kangaroo.v1.SolveResponse.sudoku:type_name -> kangaroo.v1.Sudoku
+	8,  // 14: kangaroo.v1.ValidateRequest.sudoku:type_name -> kangaroo.v1.Sudoku
+	8,  // 15: kangaroo.v1.EncodeRequest.sudoku:type_name -> kangaroo.v1.Sudoku
+	8,  // 16: kangaroo.v1.DecodeResponse.sudoku:type_name -> kangaroo.v1.Sudoku
+	8,  // 17: kangaroo.v1.EnumerateSolutionsRequest.sudoku:type_name -> kangaroo.v1.Sudoku
+	8,  // 18: kangaroo.v1.EnumerateSolutionsResponse.solution:type_name -> kangaroo.v1.Sudoku
+	9,  // 19: kangaroo.v1.SudokuService.Solve:input_type -> kangaroo.v1.SolveRequest
+	11, // 20: kangaroo.v1.SudokuService.Validate:input_type -> kangaroo.v1.ValidateRequest
+	13, // 21: kangaroo.v1.SudokuService.Encode:input_type -> kangaroo.v1.EncodeRequest
+	15, // 22: kangaroo.v1.SudokuService.Decode:input_type -> kangaroo.v1.DecodeRequest
+	17, // 23: kangaroo.v1.SudokuService.EnumerateSolutions:input_type -> kangaroo.v1.EnumerateSolutionsRequest
+	10, // 24: kangaroo.v1.SudokuService.Solve:output_type -> kangaroo.v1.SolveResponse
+	12, // 25: kangaroo.v1.SudokuService.Validate:output_type -> kangaroo.v1.ValidateResponse
+	14, // 26: kangaroo.v1.SudokuService.Encode:output_type -> kangaroo.v1.EncodeResponse
+	16, // 27: kangaroo.v1.SudokuService.Decode:output_type -> kangaroo.v1.DecodeResponse
+	18, // 28: kangaroo.v1.SudokuService.EnumerateSolutions:output_type -> kangaroo.v1.EnumerateSolutionsResponse
+	24, // [24:29] is the sub-list for method output_type
+	19, // [19:24] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
+}
+
+func init() { file_kangaroopb_kangaroo_proto_init() }
+func file_kangaroopb_kangaroo_proto_init() {
+	if File_kangaroopb_kangaroo_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kangaroopb_kangaroo_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*Layout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*Cell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*Box); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*CellPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Thermometer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Arrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Comparison); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Sudoku); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*SolveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*SolveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*ValidateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*EncodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*EncodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*DecodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*DecodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*EnumerateSolutionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kangaroopb_kangaroo_proto_msgTypes[17].Exporter = func(v any, i int) any {
+			switch v := v.(*EnumerateSolutionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_kangaroopb_kangaroo_proto_msgTypes[1].OneofWrappers = []any{}
+	file_kangaroopb_kangaroo_proto_msgTypes[14].OneofWrappers = []any{
+		(*DecodeRequest_Data)(nil),
+		(*DecodeRequest_Base64)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kangaroopb_kangaroo_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_kangaroopb_kangaroo_proto_goTypes,
+		DependencyIndexes: file_kangaroopb_kangaroo_proto_depIdxs,
+		EnumInfos:         file_kangaroopb_kangaroo_proto_enumTypes,
+		MessageInfos:      file_kangaroopb_kangaroo_proto_msgTypes,
+	}.Build()
+	File_kangaroopb_kangaroo_proto = out.File
+	file_kangaroopb_kangaroo_proto_rawDesc = nil
+	file_kangaroopb_kangaroo_proto_goTypes = nil
+	file_kangaroopb_kangaroo_proto_depIdxs = nil
+}
@@ -0,0 +1,293 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: kangaroopb/kangaroo.proto
+
+package kangaroopb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SudokuService_Solve_FullMethodName              = "/kangaroo.v1.SudokuService/Solve"
+	SudokuService_Validate_FullMethodName           = "/kangaroo.v1.SudokuService/Validate"
+	SudokuService_Encode_FullMethodName             = "/kangaroo.v1.SudokuService/Encode"
+	SudokuService_Decode_FullMethodName             = "/kangaroo.v1.SudokuService/Decode"
+	SudokuService_EnumerateSolutions_FullMethodName = "/kangaroo.v1.SudokuService/EnumerateSolutions"
+)
+
+// SudokuServiceClient is the client API for SudokuService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SudokuService exposes sudoku solver and binary data codec
+type SudokuServiceClient interface {
+	// Solve solves the sudoku
+	Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error)
+	// Validate checks sudoku configuration and values against sudoku rules and
+	// counts its solutions (up to 2, which means there are at least 2 solutions)
+	Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error)
+	// Encode converts the sudoku to binary data format
+	Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error)
+	// Decode reads the sudoku from binary data format
+	Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error)
+	// EnumerateSolutions streams solutions of the sudoku, up to the limit
+	EnumerateSolutions(ctx context.Context, in *EnumerateSolutionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EnumerateSolutionsResponse], error)
+}
+
+type sudokuServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSudokuServiceClient(cc grpc.ClientConnInterface) SudokuServiceClient {
+	return &sudokuServiceClient{cc}
+}
+
+func (c *sudokuServiceClient) Solve(ctx context.Context, in *SolveRequest, opts ...grpc.CallOption) (*SolveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SolveResponse)
+	err := c.cc.Invoke(ctx, SudokuService_Solve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sudokuServiceClient) Validate(ctx context.Context, in *ValidateRequest, opts ...grpc.CallOption) (*ValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateResponse)
+	err := c.cc.Invoke(ctx, SudokuService_Validate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sudokuServiceClient) Encode(ctx context.Context, in *EncodeRequest, opts ...grpc.CallOption) (*EncodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EncodeResponse)
+	err := c.cc.Invoke(ctx, SudokuService_Encode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sudokuServiceClient) Decode(ctx context.Context, in *DecodeRequest, opts ...grpc.CallOption) (*DecodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DecodeResponse)
+	err := c.cc.Invoke(ctx, SudokuService_Decode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sudokuServiceClient) EnumerateSolutions(ctx context.Context, in *EnumerateSolutionsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EnumerateSolutionsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SudokuService_ServiceDesc.Streams[0], SudokuService_EnumerateSolutions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[EnumerateSolutionsRequest, EnumerateSolutionsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SudokuService_EnumerateSolutionsClient = grpc.ServerStreamingClient[EnumerateSolutionsResponse]
+
+// SudokuServiceServer is the server API for SudokuService service.
+// All implementations must embed UnimplementedSudokuServiceServer
+// for forward compatibility.
+//
+// SudokuService exposes sudoku solver and binary data codec
+type SudokuServiceServer interface {
+	// Solve solves the sudoku
+	Solve(context.Context, *SolveRequest) (*SolveResponse, error)
+	// Validate checks sudoku configuration and values against sudoku rules and
+	// counts its solutions (up to 2, which means there are at least 2 solutions)
+	Validate(context.Context, *ValidateRequest) (*ValidateResponse, error)
+	// Encode converts the sudoku to binary data format
+	Encode(context.Context, *EncodeRequest) (*EncodeResponse, error)
+	// Decode reads the sudoku from binary data format
+	Decode(context.Context, *DecodeRequest) (*DecodeResponse, error)
+	// EnumerateSolutions streams solutions of the sudoku, up to the limit
+	EnumerateSolutions(*EnumerateSolutionsRequest, grpc.ServerStreamingServer[EnumerateSolutionsResponse]) error
+	mustEmbedUnimplementedSudokuServiceServer()
+}
+
+// UnimplementedSudokuServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSudokuServiceServer struct{}
+
+func (UnimplementedSudokuServiceServer) Solve(context.Context, *SolveRequest) (*SolveResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Solve not implemented")
+}
+func (UnimplementedSudokuServiceServer) Validate(context.Context, *ValidateRequest) (*ValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Validate not implemented")
+}
+func (UnimplementedSudokuServiceServer) Encode(context.Context, *EncodeRequest) (*EncodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Encode not implemented")
+}
+func (UnimplementedSudokuServiceServer) Decode(context.Context, *DecodeRequest) (*DecodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decode not implemented")
+}
+func (UnimplementedSudokuServiceServer) EnumerateSolutions(*EnumerateSolutionsRequest, grpc.ServerStreamingServer[EnumerateSolutionsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method EnumerateSolutions not implemented")
+}
+func (UnimplementedSudokuServiceServer) mustEmbedUnimplementedSudokuServiceServer() {}
+func (UnimplementedSudokuServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeSudokuServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SudokuServiceServer will
+// result in compilation errors.
+type UnsafeSudokuServiceServer interface {
+	mustEmbedUnimplementedSudokuServiceServer()
+}
+
+func RegisterSudokuServiceServer(s grpc.ServiceRegistrar, srv SudokuServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSudokuServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SudokuService_ServiceDesc, srv)
+}
+
+func _SudokuService_Solve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SudokuServiceServer).Solve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SudokuService_Solve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SudokuServiceServer).Solve(ctx, req.(*SolveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SudokuService_Validate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SudokuServiceServer).Validate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SudokuService_Validate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SudokuServiceServer).Validate(ctx, req.(*ValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SudokuService_Encode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SudokuServiceServer).Encode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SudokuService_Encode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SudokuServiceServer).Encode(ctx, req.(*EncodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SudokuService_Decode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SudokuServiceServer).Decode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SudokuService_Decode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SudokuServiceServer).Decode(ctx, req.(*DecodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SudokuService_EnumerateSolutions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EnumerateSolutionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SudokuServiceServer).EnumerateSolutions(m, &grpc.GenericServerStream[EnumerateSolutionsRequest, EnumerateSolutionsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SudokuService_EnumerateSolutionsServer = grpc.ServerStreamingServer[EnumerateSolutionsResponse]
+
+// SudokuService_ServiceDesc is the grpc.ServiceDesc for SudokuService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SudokuService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kangaroo.v1.SudokuService",
+	HandlerType: (*SudokuServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Solve",
+			Handler:    _SudokuService_Solve_Handler,
+		},
+		{
+			MethodName: "Validate",
+			Handler:    _SudokuService_Validate_Handler,
+		},
+		{
+			MethodName: "Encode",
+			Handler:    _SudokuService_Encode_Handler,
+		},
+		{
+			MethodName: "Decode",
+			Handler:    _SudokuService_Decode_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EnumerateSolutions",
+			Handler:       _SudokuService_EnumerateSolutions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "kangaroopb/kangaroo.proto",
+}
@@ -0,0 +1,89 @@
+// Package grpcServer exposes sudoku solver and binary data codec through gRPC API
+// described in kangaroopb/kangaroo.proto
+package grpcServer
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative kangaroopb/kangaroo.proto
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Michu8258/kangaroo/grpcServer/kangaroopb"
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/internal/serverCommon"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type Server struct {
+	kangaroopb.UnimplementedSudokuServiceServer
+	Settings          *models.Settings
+	ServiceCollection *services.ServiceCollection
+	Library           *library.Library
+	Options           serverCommon.Options
+}
+
+func NewServer(settings *models.Settings, serviceCollection *services.ServiceCollection,
+	sudokuLibrary *library.Library, options serverCommon.Options) *Server {
+	return &Server{
+		Settings:          settings,
+		ServiceCollection: serviceCollection,
+		Library:           sudokuLibrary,
+		Options:           options,
+	}
+}
+
+// GrpcServer returns gRPC server with the sudoku service registered. Context of
+// a request is cancelled when the request timeout is exceeded.
+func (server *Server) GrpcServer() *grpc.Server {
+	grpcServer := grpc.NewServer(
+		grpc.MaxRecvMsgSize(int(server.Options.MaximumRequestSize)),
+		grpc.UnaryInterceptor(server.unaryTimeoutInterceptor),
+		grpc.StreamInterceptor(server.streamTimeoutInterceptor),
+	)
+
+	kangaroopb.RegisterSudokuServiceServer(grpcServer, server)
+	return grpcServer
+}
+
+// unaryTimeoutInterceptor limits processing time of a request to the request timeout
+func (server *Server) unaryTimeoutInterceptor(ctx context.Context, request any,
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, server.Options.RequestTimeout)
+	defer cancel()
+
+	return handler(ctx, request)
+}
+
+// streamTimeoutInterceptor limits processing time of a stream to the request timeout
+func (server *Server) streamTimeoutInterceptor(service any, stream grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+	ctx, cancel := context.WithTimeout(stream.Context(), server.Options.RequestTimeout)
+	defer cancel()
+
+	return handler(service, &timeoutServerStream{ServerStream: stream, ctx: ctx})
+}
+
+// timeoutServerStream replaces context of the stream with one limited by the request timeout
+type timeoutServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns context of the stream limited by the request timeout
+func (stream *timeoutServerStream) Context() context.Context {
+	return stream.ctx
+}
+
+// toStatusError converts the error to gRPC status error with code matching kind of the error
+func toStatusError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(serverCommon.GetErrorKindStatus(err).GrpcCode, err.Error())
+}
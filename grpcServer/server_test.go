@@ -0,0 +1,377 @@
+package grpcServer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Michu8258/kangaroo/grpcServer/kangaroopb"
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/internal/serverCommon"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	crook "github.com/Michu8258/kangaroo/services/crookMethodSolver"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuGenerator"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// two values 6 in the first box
+const invalidSudoku = "AAEDAwP/gAYGAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+const base64Sudoku = "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+
+func TestServer_Solve(t *testing.T) {
+	client := getTestClient(t, time.Minute)
+
+	testCases := []struct {
+		name           string
+		sudoku         *kangaroopb.Sudoku
+		expectedCode   codes.Code
+		expectedResult kangaroopb.Result
+	}{
+		{
+			name:           "Valid sudoku",
+			sudoku:         readTestSudoku(t, "../testConfigs/simple1.json"),
+			expectedCode:   codes.OK,
+			expectedResult: kangaroopb.Result_RESULT_SUCCESS,
+		},
+		{
+			name:           "Variants sudoku",
+			sudoku:         readTestSudoku(t, "../testConfigs/thermoArrow1.json"),
+			expectedCode:   codes.OK,
+			expectedResult: kangaroopb.Result_RESULT_SUCCESS,
+		},
+		{
+			name:         "Missing sudoku",
+			sudoku:       nil,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Box size out of range",
+			sudoku:       &kangaroopb.Sudoku{BoxSize: 300},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid sudoku",
+			sudoku:       decodeTestSudoku(t, invalidSudoku),
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, testCase := range testCases {
+		response, err := client.Solve(context.Background(), &kangaroopb.SolveRequest{Sudoku: testCase.sudoku})
+		if status.Code(err) != testCase.expectedCode {
+			t.Errorf("%s: Expected status code %s, got %s (%v)",
+				testCase.name, testCase.expectedCode, status.Code(err), err)
+			continue
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if response.GetResult() != testCase.expectedResult {
+			t.Errorf("%s: Expected result %s, got %s", testCase.name, testCase.expectedResult, response.GetResult())
+		}
+
+		for _, box := range response.GetSudoku().GetBoxes() {
+			for _, cell := range box.GetCells() {
+				if !box.GetDisabled() && cell.Value == nil {
+					t.Errorf("%s: Solved sudoku has an empty cell", testCase.name)
+				}
+			}
+		}
+	}
+}
+
+func TestServer_Validate(t *testing.T) {
+	client := getTestClient(t, time.Minute)
+
+	testCases := []struct {
+		name                   string
+		sudoku                 *kangaroopb.Sudoku
+		expectedValid          bool
+		expectedSolutionsCount int32
+	}{
+		{
+			name:                   "Valid sudoku",
+			sudoku:                 readTestSudoku(t, "../testConfigs/simple1.json"),
+			expectedValid:          true,
+			expectedSolutionsCount: 1,
+		},
+		{
+			name:                   "Multiple solutions",
+			sudoku:                 toSudokuMessage(models.NewEmptySudokuDTO(2, 2, 2)),
+			expectedValid:          true,
+			expectedSolutionsCount: 2,
+		},
+		{
+			name:          "Invalid sudoku",
+			sudoku:        decodeTestSudoku(t, invalidSudoku),
+			expectedValid: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		response, err := client.Validate(context.Background(), &kangaroopb.ValidateRequest{Sudoku: testCase.sudoku})
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		if response.GetValid() != testCase.expectedValid {
+			t.Errorf("%s: Expected valid %t, got %t", testCase.name, testCase.expectedValid, response.GetValid())
+		}
+
+		if response.GetSolutionsCount() != testCase.expectedSolutionsCount {
+			t.Errorf("%s: Expected %d solutions, got %d",
+				testCase.name, testCase.expectedSolutionsCount, response.GetSolutionsCount())
+		}
+
+		if !testCase.expectedValid && len(response.GetValidationErrors()) < 1 {
+			t.Errorf("%s: Expected validation errors", testCase.name)
+		}
+	}
+}
+
+func TestServer_EncodeDecode(t *testing.T) {
+	client := getTestClient(t, time.Minute)
+
+	decodeResponse, err := client.Decode(context.Background(), &kangaroopb.DecodeRequest{
+		Input: &kangaroopb.DecodeRequest_Base64{Base64: base64Sudoku},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected decode error %s", err)
+	}
+
+	encodeResponse, err := client.Encode(context.Background(), &kangaroopb.EncodeRequest{
+		Sudoku: decodeResponse.GetSudoku(),
+	})
+	if err != nil {
+		t.Fatalf("Unexpected encode error %s", err)
+	}
+
+	if encodeResponse.GetBase64() != base64Sudoku {
+		t.Errorf("Expected encoded sudoku '%s', got '%s'", base64Sudoku, encodeResponse.GetBase64())
+	}
+
+	decodeResponse, err = client.Decode(context.Background(), &kangaroopb.DecodeRequest{
+		Input: &kangaroopb.DecodeRequest_Data{Data: encodeResponse.GetData()},
+	})
+	if err != nil || decodeResponse.GetSudoku().GetBoxSize() != 3 {
+		t.Errorf("Expected decoded sudoku from binary data, got %v (%v)", decodeResponse, err)
+	}
+
+	invalidRequests := []*kangaroopb.DecodeRequest{
+		{},
+		{Input: &kangaroopb.DecodeRequest_Base64{Base64: "not base64"}},
+		{Input: &kangaroopb.DecodeRequest_Data{Data: []byte{1, 2, 3}}},
+	}
+
+	for index, request := range invalidRequests {
+		_, err = client.Decode(context.Background(), request)
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("Invalid request %d: Expected status code %s, got %s",
+				index, codes.InvalidArgument, status.Code(err))
+		}
+	}
+}
+
+func TestServer_EnumerateSolutions(t *testing.T) {
+	client := getTestClient(t, time.Minute)
+
+	testCases := []struct {
+		name                   string
+		sudoku                 *kangaroopb.Sudoku
+		limit                  int32
+		expectedCode           codes.Code
+		expectedSolutionsCount int
+	}{
+		{
+			name:                   "Unique solution",
+			sudoku:                 readTestSudoku(t, "../testConfigs/simple1.json"),
+			limit:                  0,
+			expectedCode:           codes.OK,
+			expectedSolutionsCount: 1,
+		},
+		{
+			name:                   "Limited solutions",
+			sudoku:                 toSudokuMessage(models.NewEmptySudokuDTO(2, 2, 2)),
+			limit:                  5,
+			expectedCode:           codes.OK,
+			expectedSolutionsCount: 5,
+		},
+		{
+			name:         "Negative limit",
+			sudoku:       readTestSudoku(t, "../testConfigs/simple1.json"),
+			limit:        -1,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "Invalid sudoku",
+			sudoku:       decodeTestSudoku(t, invalidSudoku),
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, testCase := range testCases {
+		stream, err := client.EnumerateSolutions(context.Background(), &kangaroopb.EnumerateSolutionsRequest{
+			Sudoku: testCase.sudoku,
+			Limit:  testCase.limit,
+		})
+		if err != nil {
+			t.Fatalf("%s: Failed to open the stream: %s", testCase.name, err)
+		}
+
+		solutionsCount := 0
+		for {
+			response, err := stream.Recv()
+			if err == io.EOF {
+				err = nil
+			}
+
+			if err != nil || response == nil {
+				if status.Code(err) != testCase.expectedCode {
+					t.Errorf("%s: Expected status code %s, got %s (%v)",
+						testCase.name, testCase.expectedCode, status.Code(err), err)
+				}
+
+				break
+			}
+
+			solutionsCount++
+			if response.GetNumber() != int32(solutionsCount) || response.GetSolution() == nil {
+				t.Errorf("%s: Invalid solution %d: %v", testCase.name, solutionsCount, response)
+			}
+		}
+
+		if solutionsCount != testCase.expectedSolutionsCount {
+			t.Errorf("%s: Expected %d solutions, got %d",
+				testCase.name, testCase.expectedSolutionsCount, solutionsCount)
+		}
+	}
+}
+
+func TestServer_Timeout(t *testing.T) {
+	client := getTestClient(t, time.Millisecond)
+
+	stream, err := client.EnumerateSolutions(context.Background(), &kangaroopb.EnumerateSolutionsRequest{
+		Sudoku: toSudokuMessage(models.NewEmptySudokuDTO(5, 5, 5)),
+	})
+	if err != nil {
+		t.Fatalf("Failed to open the stream: %s", err)
+	}
+
+	for {
+		_, err = stream.Recv()
+		if err != nil {
+			break
+		}
+	}
+
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected status code %s, got %s (%v)", codes.DeadlineExceeded, status.Code(err), err)
+	}
+}
+
+func TestServer_SolveTimeout(t *testing.T) {
+	solver := &testHelpers.TestSolver{WaitForContext: true, Stopped: make(chan struct{}, 1)}
+	client := getTestClientWithSolver(t, 10*time.Millisecond, solver)
+
+	_, err := client.Solve(context.Background(), &kangaroopb.SolveRequest{
+		Sudoku: readTestSudoku(t, "../testConfigs/simple1.json"),
+	})
+
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Expected status code %s, got %s (%v)", codes.DeadlineExceeded, status.Code(err), err)
+	}
+
+	select {
+	case <-solver.Stopped:
+	case <-time.After(time.Second):
+		t.Error("Solver is still working after the request timed out")
+	}
+}
+
+// getTestClient starts the server on in-process listener and returns client connected to it
+func getTestClient(t *testing.T, timeout time.Duration) kangaroopb.SudokuServiceClient {
+	settings := testHelpers.GetTestSettings()
+	return getTestClientWithSolver(t, timeout, crook.GetNewSudokuSolver(settings, logger.NewDiscardLogger()))
+}
+
+// getTestClientWithSolver starts the server using given solver and returns client connected to it
+func getTestClientWithSolver(t *testing.T, timeout time.Duration,
+	solver library.Solver) kangaroopb.SudokuServiceClient {
+	settings := testHelpers.GetTestSettings()
+	sudokuInitializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
+	serviceCollection := &services.ServiceCollection{
+		SudokuInit:    sudokuInitializer,
+		Solver:        solver,
+		SudokuEncoder: binarySudokuManager.GetNewBinarySudokuManager(settings),
+		Generator:     sudokuGenerator.GetNewSudokuGenerator(settings, sudokuInitializer),
+	}
+
+	sudokuLibrary, err := library.New(library.WithSettings(settings), library.WithSudokuInit(sudokuInitializer),
+		library.WithSolver(solver), library.WithEncoder(serviceCollection.SudokuEncoder),
+		library.WithMaximumConcurrentSolves(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := NewServer(settings, serviceCollection, sudokuLibrary, serverCommon.Options{
+		MaximumRequestSize: 1024 * 1024,
+		RequestTimeout:     timeout,
+	}).GrpcServer()
+
+	listener := bufconn.Listen(1024 * 1024)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	connection, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, address string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { connection.Close() })
+	return kangaroopb.NewSudokuServiceClient(connection)
+}
+
+func readTestSudoku(t *testing.T, path string) *kangaroopb.Sudoku {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudokuDto := &models.SudokuDTO{}
+	err = json.Unmarshal(data, sudokuDto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return toSudokuMessage(sudokuDto)
+}
+
+func decodeTestSudoku(t *testing.T, base64Data string) *kangaroopb.Sudoku {
+	sudokuDto, err := binarySudokuManager.GetNewBinarySudokuManager(
+		testHelpers.GetTestSettings()).ReadFromBase64(base64Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return toSudokuMessage(sudokuDto)
+}
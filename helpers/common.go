@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"fmt"
+	"log/slog"
 	"os"
 	"path/filepath"
 
@@ -48,13 +49,28 @@ func GetCellCoordinatesString(sudoku *models.Sudoku, box *models.SudokuBox, cell
 	return GetCoordinatesString(rowNumber, columnNumber, withParentheses)
 }
 
+// GetCellLogAttr returns log attribute with user friendly cell coordinates
+func GetCellLogAttr(sudoku *models.Sudoku, box *models.SudokuBox, cell *models.SudokuCell) slog.Attr {
+	return slog.Group("cell",
+		slog.Int("row", GetCellNumber(sudoku.BoxSize, box.IndexRow, cell.IndexRowInBox)),
+		slog.Int("column", GetCellNumber(sudoku.BoxSize, box.IndexColumn, cell.IndexColumnInBox)))
+}
+
 // GetCellNumber returns user friendly cell number
-func GetCellNumber(boxSize, boxIndex, cellIndex int8) int8 {
-	return boxIndex*boxSize + cellIndex + 1
+func GetCellNumber(boxSize, boxIndex, cellIndex int8) int {
+	return GetAbsoluteCellIndex(boxSize, boxIndex, cellIndex) + 1
+}
+
+// GetAbsoluteCellIndex returns zero based index of a cell row (or column) in the
+// context of entire sudoku. It is calculated as int, because in case of big
+// sudokus it does not fit int8 range.
+func GetAbsoluteCellIndex(boxSize, boxIndex, cellIndex int8) int {
+	return int(boxIndex)*int(boxSize) + int(cellIndex)
 }
 
-// GetCoordinatesString provides formatted coordinates string
-func GetCoordinatesString(rowNumber int8, columnNumber int8, withParentheses bool) string {
+// GetCoordinatesString provides formatted coordinates string. Numbers can be
+// either box/cell indexes (int8) or absolute cell numbers (int).
+func GetCoordinatesString[T int8 | int](rowNumber T, columnNumber T, withParentheses bool) string {
 	if withParentheses {
 		return fmt.Sprintf("(row: %d, column: %d)", rowNumber, columnNumber)
 	}
@@ -0,0 +1,151 @@
+package library
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Format is a format of sudoku data read by Parse
+type Format int
+
+const (
+	// FormatAuto detects format of the data: JSON, base64 or text (in this order)
+	FormatAuto Format = iota
+	// FormatJSON is the format of sudoku files of the CLI
+	FormatJSON
+	// FormatBase64 is standard or URL-safe base64 representation of binary data format
+	FormatBase64
+	// FormatText is a grid of values or a compact string of values of classic sudoku,
+	// or a sudoku drawing as saved to TXT files
+	FormatText
+)
+
+// Encoding specifies representation of binary data format
+type Encoding = models.BinaryEncoding
+
+const (
+	EncodingBase64    Encoding = models.StdBase64Encoding
+	EncodingBase64URL Encoding = models.URLBase64Encoding
+	EncodingRaw       Encoding = models.RawBinaryEncoding
+)
+
+// Parse reads sudoku from JSON, base64 encoded binary data or text. Format of
+// the data is detected, unless it is specified with WithFormat option.
+// Text format is described in textSudokuParser.ParseSudoku.
+func (library *Library) Parse(data []byte, options ...ParseOption) (*Sudoku, error) {
+	parseOptions := &parseOptions{format: FormatAuto}
+	for _, option := range options {
+		option(parseOptions)
+	}
+
+	text := strings.TrimSpace(string(data))
+	if len(text) < 1 {
+		return nil, models.WithKind(ErrInvalidInput, errors.New("sudoku data is empty"))
+	}
+
+	switch parseOptions.format {
+	case FormatJSON:
+		return parseJson([]byte(text))
+	case FormatBase64:
+		return library.parseBase64(text)
+	case FormatText:
+		return library.textParser.ParseSudoku(text, parseOptions.alphabet)
+	case FormatAuto:
+		if text[0] == '{' {
+			return parseJson([]byte(text))
+		}
+
+		sudoku, err := library.parseBase64(text)
+		if err == nil {
+			return sudoku, nil
+		}
+
+		return library.textParser.ParseSudoku(text, parseOptions.alphabet)
+	default:
+		return nil, models.WithKind(ErrInvalidInput, fmt.Errorf("unknown sudoku data format %d", parseOptions.format))
+	}
+}
+
+// Encode converts the sudoku to binary data format with provided encoding
+func (library *Library) Encode(sudoku *Sudoku, encoding Encoding) ([]byte, error) {
+	var data []byte
+	var err error
+
+	switch encoding {
+	case EncodingBase64:
+		var encoded string
+		encoded, err = library.encoder.ToBase64(sudoku)
+		data = []byte(encoded)
+	case EncodingBase64URL:
+		var encoded string
+		encoded, err = library.encoder.ToBase64URL(sudoku)
+		data = []byte(encoded)
+	case EncodingRaw:
+		data, err = library.encoder.ToBytes(sudoku)
+	default:
+		err = fmt.Errorf("encoding '%s' is not supported", encoding)
+	}
+
+	if err != nil {
+		return nil, models.WithKind(ErrInvalidInput, err)
+	}
+
+	return data, nil
+}
+
+// Decode reads the sudoku from binary data format with provided encoding
+func (library *Library) Decode(data []byte, encoding Encoding) (*Sudoku, error) {
+	var sudoku *Sudoku
+	var err error
+
+	switch encoding {
+	case EncodingBase64:
+		sudoku, err = library.encoder.ReadFromBase64(string(data))
+	case EncodingBase64URL:
+		sudoku, err = library.encoder.ReadFromBase64URL(string(data))
+	case EncodingRaw:
+		sudoku, err = library.encoder.ReadFromBytes(data)
+	default:
+		err = fmt.Errorf("encoding '%s' is not supported", encoding)
+	}
+
+	if err != nil {
+		return nil, models.WithKind(ErrInvalidInput, err)
+	}
+
+	sudoku.AssignSymbols()
+	return sudoku, nil
+}
+
+// parseJson reads sudoku from JSON data
+func parseJson(data []byte) (*Sudoku, error) {
+	sudoku := &Sudoku{}
+	err := json.Unmarshal(data, sudoku)
+	if err == nil {
+		err = sudoku.ResolveSymbols()
+	}
+
+	if err != nil {
+		return nil, models.WithKind(ErrInvalidInput, fmt.Errorf("failed to parse sudoku JSON data: %s", err))
+	}
+
+	return sudoku, nil
+}
+
+// parseBase64 reads sudoku from standard or URL-safe base64 encoded binary data
+func (library *Library) parseBase64(text string) (*Sudoku, error) {
+	sudoku, err := library.Decode([]byte(text), EncodingBase64)
+	if err != nil {
+		sudoku, err = library.Decode([]byte(text), EncodingBase64URL)
+	}
+
+	if err != nil {
+		return nil, models.WithKind(ErrInvalidInput, fmt.Errorf("failed to parse sudoku binary data: %s", err))
+	}
+
+	return sudoku, nil
+}
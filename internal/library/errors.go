@@ -0,0 +1,56 @@
+package library
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Kinds of errors returned by the library
+var (
+	// ErrInvalidInput is returned for data that can not be parsed or decoded
+	ErrInvalidInput = models.ErrInvalidInput
+	// ErrInvalidConfiguration is returned for sudokus violating sudoku rules
+	ErrInvalidConfiguration = models.ErrInvalidConfiguration
+	// ErrUnsolvable is returned for sudokus without a solution
+	ErrUnsolvable = models.ErrUnsolvable
+	// ErrMultipleSolutions is returned for sudokus with more than one solution,
+	// when unique solution is required
+	ErrMultipleSolutions = errors.New("multiple sudoku solutions")
+	// ErrSolverFailure is returned when the solver fails to solve the sudoku
+	ErrSolverFailure = models.ErrSolverFailure
+)
+
+// ValidationError lists violations of sudoku rules. It matches ErrInvalidConfiguration.
+type ValidationError struct {
+	Errors []error
+}
+
+func (err *ValidationError) Error() string {
+	return fmt.Sprintf("invalid sudoku: %s", errors.Join(err.Errors...))
+}
+
+func (err *ValidationError) Unwrap() []error {
+	return append([]error{ErrInvalidConfiguration}, err.Errors...)
+}
+
+// SolutionError describes failed sudoku solution. It matches ErrUnsolvable,
+// ErrMultipleSolutions or ErrSolverFailure, depending on the reason of the failure.
+type SolutionError struct {
+	Kind   error
+	Result models.SudokuResultType
+	Errors []error
+}
+
+func (err *SolutionError) Error() string {
+	if len(err.Errors) < 1 {
+		return err.Kind.Error()
+	}
+
+	return fmt.Sprintf("%s: %s", err.Kind, errors.Join(err.Errors...))
+}
+
+func (err *SolutionError) Unwrap() []error {
+	return append([]error{err.Kind}, err.Errors...)
+}
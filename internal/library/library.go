@@ -0,0 +1,110 @@
+package library
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	crook "github.com/Michu8258/kangaroo/services/crookMethodSolver"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuGenerator"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
+)
+
+// Sudoku is a sudoku puzzle, the same as stored in JSON files of the CLI
+type Sudoku = models.SudokuDTO
+
+// Solver solves initialized sudoku in place. Returns false if the sudoku was not
+// solved, result of the solution is stored in the sudoku. The solver should stop
+// as soon as possible when the context is done.
+type Solver interface {
+	Solve(ctx context.Context, sudoku *models.Sudoku) (bool, []error)
+}
+
+// Library provides sudoku operations with its own settings and services. It is
+// the implementation of public kangaroo package, which does not expose settings,
+// services and initialized sudoku objects used by the CLI and the servers.
+// It is safe for concurrent use if its services are.
+type Library struct {
+	settings        *models.Settings
+	settingsChanges []func(settings *models.Settings) error
+	debugOutput     io.Writer
+	logger          *slog.Logger
+	sudokuInit      sudokuInit.ISudokuInit
+	solver          Solver
+	encoder         binarySudokuManager.IBinarySudokuManager
+	generator       sudokuGenerator.ISudokuGenerator
+	textParser      textSudokuParser.ITextSudokuParser
+	// maximumConcurrentSolves limits solutions started with AcquireSolve, 0 means no limit
+	maximumConcurrentSolves int
+	solves                  chan struct{}
+}
+
+// DefaultSettings returns settings used by instances created without WithSettings option
+func DefaultSettings() *models.Settings {
+	return &models.Settings{
+		MinimumLayoutSizeInclusive:       2,
+		MaximumLayoutSizeInclusive:       8,
+		DefaultLayoutSize:                3,
+		MinimumBoxSizeInclusive:          2,
+		MaximumBoxSizeInclusive:          models.SupportedMaximumBoxSize,
+		DefaultBoxSize:                   3,
+		SudokuPrintoutValuePaddingLength: 1,
+		UseDebugPrints:                   false,
+		SilentConsolePrints:              false,
+		SudokuBinaryEncoderVersion:       1,
+		OutputFormat:                     models.TextOutputFormat,
+		LogLevel:                         "warn",
+		LogFormat:                        models.TextLogFormat,
+	}
+}
+
+// New creates Library instance configured with provided options. Services
+// not provided with options are created with default implementations.
+// Returns an error if any of the options is invalid.
+func New(options ...Option) (*Library, error) {
+	library := &Library{
+		settings: DefaultSettings(),
+	}
+
+	for _, option := range options {
+		option(library)
+	}
+
+	for _, changeSettings := range library.settingsChanges {
+		err := changeSettings(library.settings)
+		if err != nil {
+			return nil, models.WithKind(ErrInvalidInput, err)
+		}
+	}
+
+	if library.logger == nil && library.debugOutput != nil {
+		library.logger = logger.New(library.settings, library.debugOutput)
+	} else if library.logger == nil {
+		library.logger = logger.NewDiscardLogger()
+	}
+
+	if library.sudokuInit == nil {
+		library.sudokuInit = sudokuInit.GetNewSudokuInit(library.settings, library.logger)
+	}
+
+	if library.solver == nil {
+		library.solver = crook.GetNewSudokuSolver(library.settings, library.logger)
+	}
+
+	if library.encoder == nil {
+		library.encoder = binarySudokuManager.GetNewBinarySudokuManager(library.settings)
+	}
+
+	if library.maximumConcurrentSolves > 0 {
+		library.solves = make(chan struct{}, library.maximumConcurrentSolves)
+	}
+
+	library.generator = sudokuGenerator.GetNewSudokuGenerator(library.settings, library.sudokuInit)
+	library.textParser = textSudokuParser.GetNewTextSudokuParser(library.settings)
+
+	return library, nil
+}
@@ -0,0 +1,349 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+// default instance used by the tests
+var defaultLibrary, _ = New()
+
+const simpleSudokuText = ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"
+const simpleSudokuSolution = "265984317389271564417653298948527631523816479176439852832795146691348725754162983"
+const base64Sudoku = "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+
+func TestParse(t *testing.T) {
+	jsonSudoku, err := os.ReadFile("../../testConfigs/simple1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name            string
+		data            string
+		options         []ParseOption
+		expectedBoxSize int8
+		expectedError   error
+	}{
+		{
+			name:            "JSON",
+			data:            string(jsonSudoku),
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "Base64",
+			data:            base64Sudoku + "\n",
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "Compact text",
+			data:            simpleSudokuText,
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "Text grid with alphabet",
+			data:            "A . . .\n. . C .\n. D . .\n. . . B",
+			options:         []ParseOption{WithAlphabet("A-D")},
+			expectedBoxSize: 2,
+		},
+		{
+			name:          "Base64 as JSON",
+			data:          base64Sudoku,
+			options:       []ParseOption{WithFormat(FormatJSON)},
+			expectedError: ErrInvalidInput,
+		},
+		{
+			name:          "Text as base64",
+			data:          simpleSudokuText,
+			options:       []ParseOption{WithFormat(FormatBase64)},
+			expectedError: ErrInvalidInput,
+		},
+		{
+			name:          "Empty data",
+			data:          " \n",
+			expectedError: ErrInvalidInput,
+		},
+		{
+			name:          "Not a sudoku",
+			data:          "hello",
+			expectedError: ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudoku, err := defaultLibrary.Parse([]byte(testCase.data), testCase.options...)
+		if !errors.Is(err, testCase.expectedError) || (err != nil) != (testCase.expectedError != nil) {
+			t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedError, err)
+			continue
+		}
+
+		if err == nil && sudoku.BoxSize != testCase.expectedBoxSize {
+			t.Errorf("%s: Expected box size %d, got %d", testCase.name, testCase.expectedBoxSize, sudoku.BoxSize)
+		}
+	}
+}
+
+func TestSolve(t *testing.T) {
+	sudoku, err := defaultLibrary.Parse([]byte(simpleSudokuText))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	solution, err := defaultLibrary.Solve(context.Background(), sudoku, RequireUniqueSolution())
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+
+	expectedSolution, _ := defaultLibrary.Parse([]byte(simpleSudokuSolution))
+	for boxIndex, box := range expectedSolution.Boxes {
+		for cellIndex, cell := range box.Cells {
+			solvedCell := solution.Boxes[boxIndex].Cells[cellIndex]
+			if solvedCell.Value == nil || *solvedCell.Value != *cell.Value {
+				t.Fatalf("Solution does not match expected solution")
+			}
+		}
+	}
+
+	if sudoku.Boxes[0].Cells[0].Value != nil {
+		t.Errorf("Solved sudoku was changed")
+	}
+}
+
+func TestSolve_Errors(t *testing.T) {
+	emptySudoku := models.NewEmptySudokuDTO(2, 2, 2)
+	invalidSudoku, _ := defaultLibrary.Parse([]byte("11.." + strings.Repeat(".", 12)))
+	cancelledContext, cancel := context.WithCancel(context.Background())
+	cancel()
+	timeoutContext, cancelTimeout := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancelTimeout()
+
+	testCases := []struct {
+		name          string
+		library       *Library
+		ctx           context.Context
+		sudoku        *Sudoku
+		options       []SolveOption
+		expectedError error
+	}{
+		{
+			name:          "Invalid sudoku",
+			library:       defaultLibrary,
+			ctx:           context.Background(),
+			sudoku:        invalidSudoku,
+			expectedError: ErrInvalidConfiguration,
+		},
+		{
+			name:          "Nil sudoku",
+			library:       defaultLibrary,
+			ctx:           context.Background(),
+			sudoku:        nil,
+			expectedError: ErrInvalidInput,
+		},
+		{
+			name:          "Multiple solutions",
+			library:       defaultLibrary,
+			ctx:           context.Background(),
+			sudoku:        emptySudoku,
+			options:       []SolveOption{RequireUniqueSolution()},
+			expectedError: ErrMultipleSolutions,
+		},
+		{
+			name:          "Unsolvable sudoku",
+			library:       getTestLibrary(t, &testHelpers.TestSolver{Unsolvable: true}),
+			ctx:           context.Background(),
+			sudoku:        emptySudoku,
+			expectedError: ErrUnsolvable,
+		},
+		{
+			name:          "Solver failure",
+			library:       getTestLibrary(t, &testHelpers.TestSolver{Errors: []error{errors.New("test error")}}),
+			ctx:           context.Background(),
+			sudoku:        emptySudoku,
+			expectedError: ErrSolverFailure,
+		},
+		{
+			name:          "Cancelled context",
+			library:       getTestLibrary(t, &testHelpers.TestSolver{Result: true}),
+			ctx:           cancelledContext,
+			sudoku:        emptySudoku,
+			options:       []SolveOption{RequireUniqueSolution()},
+			expectedError: context.Canceled,
+		},
+		{
+			name:          "Context done during solution",
+			library:       getTestLibrary(t, &testHelpers.TestSolver{WaitForContext: true}),
+			ctx:           timeoutContext,
+			sudoku:        emptySudoku,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.library.Solve(testCase.ctx, testCase.sudoku, testCase.options...)
+		if !errors.Is(err, testCase.expectedError) {
+			t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedError, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	validSudoku, _ := defaultLibrary.Parse([]byte(simpleSudokuText))
+	invalidSudoku, _ := defaultLibrary.Parse([]byte("11.." + strings.Repeat(".", 12)))
+
+	err := defaultLibrary.Validate(validSudoku)
+	if err != nil {
+		t.Errorf("Expected valid sudoku, got %s", err)
+	}
+
+	err = defaultLibrary.Validate(invalidSudoku)
+	validationError := &ValidationError{}
+	if !errors.As(err, &validationError) || len(validationError.Errors) < 1 {
+		t.Errorf("Expected validation error, got %v", err)
+	}
+}
+
+func TestCountSolutions(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		sudoku                 string
+		expectedSolutionsCount int
+	}{
+		{name: "Unique solution", sudoku: simpleSudokuText, expectedSolutionsCount: 1},
+		{name: "Multiple solutions", sudoku: strings.Repeat(".", 16), expectedSolutionsCount: 2},
+		{name: "No solution", sudoku: "12.....3..4.....", expectedSolutionsCount: 0},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto, err := defaultLibrary.Parse([]byte(testCase.sudoku))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sudoku, err := defaultLibrary.Initialize(sudokuDto)
+		if err != nil {
+			t.Fatalf("%s: Unexpected initialization error %s", testCase.name, err)
+		}
+
+		solutionsCount, err := defaultLibrary.CountSolutions(context.Background(), sudoku)
+		if err != nil || solutionsCount != testCase.expectedSolutionsCount {
+			t.Errorf("%s: Expected %d solutions, got %d (%v)",
+				testCase.name, testCase.expectedSolutionsCount, solutionsCount, err)
+		}
+	}
+}
+
+func TestAcquireSolve(t *testing.T) {
+	library, err := New(WithMaximumConcurrentSolves(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	release, err := library.AcquireSolve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	timeoutContext, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err = library.AcquireSolve(timeoutContext)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded error above the limit, got %v", err)
+	}
+
+	release()
+	release, err = library.AcquireSolve(context.Background())
+	if err != nil {
+		t.Errorf("Expected released slot to be available, got %s", err)
+	} else {
+		release()
+	}
+
+	for range 3 {
+		_, err = defaultLibrary.AcquireSolve(context.Background())
+		if err != nil {
+			t.Errorf("Expected no limit of default instance, got %s", err)
+		}
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	puzzle, solution, err := defaultLibrary.Generate(context.Background(), WithBoxSize(2), WithLayout(2, 2), WithSeed(7))
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+
+	if puzzle.BoxSize != 2 || len(solution.Boxes) != 4 {
+		t.Errorf("Generated sudoku has invalid size")
+	}
+
+	_, err = defaultLibrary.Solve(context.Background(), puzzle, RequireUniqueSolution())
+	if err != nil {
+		t.Errorf("Expected solvable puzzle with unique solution, got %s", err)
+	}
+
+	_, _, err = defaultLibrary.Generate(context.Background(), WithBoxSize(3), WithLayout(2, 2))
+	if !errors.Is(err, ErrInvalidConfiguration) {
+		t.Errorf("Expected invalid configuration error, got %v", err)
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	encodings := []Encoding{EncodingBase64, EncodingBase64URL, EncodingRaw}
+
+	sudoku, err := defaultLibrary.Decode([]byte(base64Sudoku), EncodingBase64)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, encoding := range encodings {
+		data, err := defaultLibrary.Encode(sudoku, encoding)
+		if err != nil {
+			t.Errorf("%s: Unexpected encode error %s", encoding, err)
+			continue
+		}
+
+		decodedSudoku, err := defaultLibrary.Decode(data, encoding)
+		if err != nil || decodedSudoku.BoxSize != sudoku.BoxSize {
+			t.Errorf("%s: Failed to decode encoded sudoku (%v)", encoding, err)
+		}
+	}
+
+	_, err = defaultLibrary.Encode(sudoku, "hex")
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected invalid input error for unknown encoding, got %v", err)
+	}
+
+	_, err = defaultLibrary.Decode([]byte{1, 2, 3}, EncodingRaw)
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected invalid input error for invalid data, got %v", err)
+	}
+}
+
+func TestNew_InvalidOptions(t *testing.T) {
+	_, err := New(WithMaximumBoxSize(100))
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected invalid input error, got %v", err)
+	}
+
+	library, err := New(WithSettings(testHelpers.GetTestSettings()), WithMaximumLayoutSize(3))
+	if err != nil || library.settings.MaximumLayoutSizeInclusive != 3 {
+		t.Errorf("Expected maximum layout size changed, got %v", err)
+	}
+}
+
+func getTestLibrary(t *testing.T, solver Solver) *Library {
+	library, err := New(WithSolver(solver))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return library
+}
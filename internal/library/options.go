@@ -0,0 +1,151 @@
+package library
+
+import (
+	"io"
+	"log/slog"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+)
+
+// Option configures Library instance created with New
+type Option func(library *Library)
+
+// WithSettings makes the instance use provided settings object. Later changes
+// of the settings are visible to the instance.
+func WithSettings(settings *models.Settings) Option {
+	return func(library *Library) {
+		library.settings = settings
+	}
+}
+
+// WithMaximumBoxSize changes maximum accepted box size (up to models.SupportedMaximumBoxSize)
+func WithMaximumBoxSize(size int) Option {
+	return func(library *Library) {
+		library.settingsChanges = append(library.settingsChanges, func(settings *models.Settings) error {
+			return settings.SetMaximumBoxSize(size)
+		})
+	}
+}
+
+// WithMaximumLayoutSize changes maximum accepted layout width and height
+// (up to models.SupportedMaximumLayoutSize)
+func WithMaximumLayoutSize(size int) Option {
+	return func(library *Library) {
+		library.settingsChanges = append(library.settingsChanges, func(settings *models.Settings) error {
+			return settings.SetMaximumLayoutSize(size)
+		})
+	}
+}
+
+// WithDebugOutput makes the solver and sudoku initialization write their logs
+// to provided writer, with log level and format of the settings
+func WithDebugOutput(writer io.Writer) Option {
+	return func(library *Library) {
+		library.debugOutput = writer
+	}
+}
+
+// WithLogger makes the solver and sudoku initialization write their logs with
+// provided structured logger. It takes precedence over WithDebugOutput option.
+func WithLogger(logger *slog.Logger) Option {
+	return func(library *Library) {
+		library.logger = logger
+	}
+}
+
+// WithSolver replaces default solver (Crook's algorithm) with custom implementation
+func WithSolver(solver Solver) Option {
+	return func(library *Library) {
+		library.solver = solver
+	}
+}
+
+// WithSudokuInit replaces default sudoku initialization and validation service
+func WithSudokuInit(sudokuInit sudokuInit.ISudokuInit) Option {
+	return func(library *Library) {
+		library.sudokuInit = sudokuInit
+	}
+}
+
+// WithEncoder replaces default sudoku binary data encoder and decoder
+func WithEncoder(encoder binarySudokuManager.IBinarySudokuManager) Option {
+	return func(library *Library) {
+		library.encoder = encoder
+	}
+}
+
+// WithMaximumConcurrentSolves limits amount of solutions which can be in progress
+// at the same time, when they are started with AcquireSolve
+func WithMaximumConcurrentSolves(count int) Option {
+	return func(library *Library) {
+		library.maximumConcurrentSolves = count
+	}
+}
+
+// ParseOption configures Parse function
+type ParseOption func(options *parseOptions)
+
+type parseOptions struct {
+	format   Format
+	alphabet string
+}
+
+// WithFormat disables format detection and parses the data in provided format
+func WithFormat(format Format) ParseOption {
+	return func(options *parseOptions) {
+		options.format = format
+	}
+}
+
+// WithAlphabet specifies symbols used to present values in text format, e.g. 1-9A-G
+func WithAlphabet(alphabet string) ParseOption {
+	return func(options *parseOptions) {
+		options.alphabet = alphabet
+	}
+}
+
+// SolveOption configures Solve function
+type SolveOption func(options *solveOptions)
+
+type solveOptions struct {
+	requireUniqueSolution bool
+}
+
+// RequireUniqueSolution makes Solve fail with ErrMultipleSolutions if the sudoku
+// has more than one solution
+func RequireUniqueSolution() SolveOption {
+	return func(options *solveOptions) {
+		options.requireUniqueSolution = true
+	}
+}
+
+// GenerateOption configures Generate function
+type GenerateOption func(options *generateOptions)
+
+type generateOptions struct {
+	request models.GenerateSudokuRequest
+}
+
+// WithBoxSize sets box size of generated sudoku
+func WithBoxSize(boxSize int8) GenerateOption {
+	return func(options *generateOptions) {
+		options.request.BoxSize = boxSize
+	}
+}
+
+// WithLayout sets layout width and height (amount of boxes) of generated sudoku
+func WithLayout(width int8, height int8) GenerateOption {
+	return func(options *generateOptions) {
+		options.request.LayoutWidth = width
+		options.request.LayoutHeight = height
+	}
+}
+
+// WithSeed sets seed of the generator, the same seed results in the same sudoku
+func WithSeed(seed int64) GenerateOption {
+	return func(options *generateOptions) {
+		options.request.Seed = seed
+	}
+}
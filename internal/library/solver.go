@@ -0,0 +1,184 @@
+package library
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// maximum amount of solutions searched to verify uniqueness of the solution
+const solutionsCountLimit = 2
+
+// Validate checks sudoku configuration and values against sudoku rules.
+// Returns *ValidationError if the sudoku violates any rule.
+func (library *Library) Validate(sudoku *Sudoku) error {
+	_, err := library.Initialize(sudoku)
+	return err
+}
+
+// Initialize converts sudoku DTO to initialized sudoku object, validating it against
+// sudoku rules. Returns *ValidationError if the sudoku violates any rule - initialized
+// sudoku is returned together with the error if it can be printed (e.g. values of
+// the sudoku violate the rules, but its configuration is valid).
+func (library *Library) Initialize(sudokuDto *Sudoku) (*models.Sudoku, error) {
+	if sudokuDto == nil {
+		return nil, models.WithKind(ErrInvalidInput, errors.New("sudoku is nil"))
+	}
+
+	sudoku := sudokuDto.ToSudoku()
+	printable, errs := library.sudokuInit.InitializeSudoku(sudoku)
+	if len(errs) >= 1 {
+		if !printable {
+			sudoku = nil
+		}
+
+		return sudoku, &ValidationError{Errors: errs}
+	}
+
+	return sudoku, nil
+}
+
+// Solve solves the sudoku and returns solved copy of it. The solver is stopped
+// when the context is done and the context error is returned.
+// Returns *ValidationError for invalid sudokus and *SolutionError if the sudoku
+// could not be solved.
+func (library *Library) Solve(ctx context.Context, sudokuDto *Sudoku, options ...SolveOption) (*Sudoku, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	sudoku, err := library.Initialize(sudokuDto)
+	if err != nil {
+		return nil, err
+	}
+
+	err = library.SolveInitialized(ctx, sudoku, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return sudoku.ToSudokuDto(), nil
+}
+
+// SolveInitialized solves sudoku returned by Initialize in place, so the sudoku keeps
+// information about input values. Errors are the same as errors of Solve.
+func (library *Library) SolveInitialized(ctx context.Context, sudoku *models.Sudoku,
+	options ...SolveOption) error {
+
+	solveOptions := &solveOptions{}
+	for _, option := range options {
+		option(solveOptions)
+	}
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if solveOptions.requireUniqueSolution {
+		solutionsCount, err := library.CountSolutions(ctx, sudoku)
+		if err != nil {
+			return err
+		}
+
+		if solutionsCount > 1 {
+			return &SolutionError{Kind: ErrMultipleSolutions, Result: models.Unspecified}
+		}
+	}
+
+	solved, errs := library.solver.Solve(ctx, sudoku)
+	if !solved {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		kind := ErrSolverFailure
+		if sudoku.Result == models.UnsolvableSudoku {
+			kind = ErrUnsolvable
+		}
+
+		return &SolutionError{Kind: kind, Result: sudoku.Result, Errors: errs}
+	}
+
+	// with debug prints enabled, errors reported by successful solution are not ignored
+	if library.settings.UseDebugPrints && len(errs) >= 1 {
+		return &SolutionError{Kind: ErrSolverFailure, Result: sudoku.Result, Errors: errs}
+	}
+
+	return nil
+}
+
+// CountSolutions counts solutions of sudoku returned by Initialize, up to 2 - which
+// means that the sudoku has more than one solution. The search is stopped when
+// the context is done and the context error is returned.
+func (library *Library) CountSolutions(ctx context.Context, sudoku *models.Sudoku) (int, error) {
+	return library.FindSolutions(ctx, sudoku, solutionsCountLimit, func(*Sudoku) bool { return true })
+}
+
+// FindSolutions searches solutions of sudoku returned by Initialize, up to the limit.
+// Every solution is passed to onSolution function, the search is stopped when it
+// returns false. Returns amount of found solutions, or the context error if the
+// context is done before the search is finished.
+func (library *Library) FindSolutions(ctx context.Context, sudoku *models.Sudoku, limit int,
+	onSolution func(solution *Sudoku) bool) (int, error) {
+
+	solutionsCount, err := library.generator.FindSolutions(ctx, sudoku, limit, onSolution)
+	if err != nil {
+		return solutionsCount, getContextError(ctx, models.WithKind(ErrSolverFailure, err))
+	}
+
+	return solutionsCount, nil
+}
+
+// AcquireSolve waits until amount of solutions in progress is below the limit set with
+// WithMaximumConcurrentSolves option. Returns function releasing taken slot, or the
+// context error if the context is done first.
+func (library *Library) AcquireSolve(ctx context.Context) (func(), error) {
+	if library.solves == nil {
+		return func() {}, ctx.Err()
+	}
+
+	select {
+	case library.solves <- struct{}{}:
+		return func() { <-library.solves }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Generate generates sudoku puzzle with unique solution. Default box size and
+// layout of the settings are used if they are not provided with options, and
+// current time is used as a seed if it is not provided.
+func (library *Library) Generate(ctx context.Context, options ...GenerateOption) (
+	puzzle *Sudoku, solution *Sudoku, err error) {
+
+	generateOptions := &generateOptions{
+		request: models.GenerateSudokuRequest{
+			BoxSize:      library.settings.DefaultBoxSize,
+			LayoutWidth:  library.settings.DefaultLayoutSize,
+			LayoutHeight: library.settings.DefaultLayoutSize,
+			Seed:         time.Now().UnixNano(),
+		},
+	}
+
+	for _, option := range options {
+		option(generateOptions)
+	}
+
+	puzzle, solution, err = library.generator.Generate(ctx, &generateOptions.request)
+	if err != nil {
+		return nil, nil, getContextError(ctx, err)
+	}
+
+	return puzzle, solution, nil
+}
+
+// getContextError returns error of the context if it is done, otherwise provided error
+func getContextError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	return err
+}
@@ -0,0 +1,50 @@
+// Package serverCommon holds options and error handling shared by HTTP and gRPC servers
+package serverCommon
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Michu8258/kangaroo/models"
+	"google.golang.org/grpc/codes"
+)
+
+// Options holds limits of requests handled by the server. Amount of requests solving
+// sudokus at the same time is limited by the library used by the server.
+type Options struct {
+	MaximumRequestSize int64
+	RequestTimeout     time.Duration
+}
+
+// ErrorKindStatus holds HTTP and gRPC status codes of responses with errors of the kind
+type ErrorKindStatus struct {
+	Kind           error
+	HttpStatusCode int
+	GrpcCode       codes.Code
+}
+
+// status codes of responses with errors of particular kinds
+var errorKindsStatuses = []ErrorKindStatus{
+	{Kind: models.ErrInvalidInput, HttpStatusCode: http.StatusBadRequest, GrpcCode: codes.InvalidArgument},
+	{Kind: models.ErrInvalidConfiguration, HttpStatusCode: http.StatusUnprocessableEntity, GrpcCode: codes.FailedPrecondition},
+	{Kind: models.ErrUnsolvable, HttpStatusCode: http.StatusUnprocessableEntity, GrpcCode: codes.FailedPrecondition},
+	{Kind: models.ErrSolverFailure, HttpStatusCode: http.StatusInternalServerError, GrpcCode: codes.Internal},
+	{Kind: models.ErrIO, HttpStatusCode: http.StatusInternalServerError, GrpcCode: codes.Internal},
+}
+
+// GetErrorKindStatus returns status codes matching kind of the error, errors
+// of unknown kinds are reported as internal server errors
+func GetErrorKindStatus(err error) ErrorKindStatus {
+	for _, kindStatus := range errorKindsStatuses {
+		if errors.Is(err, kindStatus.Kind) {
+			return kindStatus
+		}
+	}
+
+	return ErrorKindStatus{
+		Kind:           err,
+		HttpStatusCode: http.StatusInternalServerError,
+		GrpcCode:       codes.Internal,
+	}
+}
@@ -0,0 +1,47 @@
+package serverCommon
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetErrorKindStatus(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		err                    error
+		expectedHttpStatusCode int
+		expectedGrpcCode       codes.Code
+	}{
+		{
+			name:                   "Invalid input",
+			err:                    models.WithKind(models.ErrInvalidInput, errors.New("test error")),
+			expectedHttpStatusCode: http.StatusBadRequest,
+			expectedGrpcCode:       codes.InvalidArgument,
+		},
+		{
+			name:                   "Wrapped unsolvable",
+			err:                    fmt.Errorf("wrapped: %w", models.WithKind(models.ErrUnsolvable, errors.New("test error"))),
+			expectedHttpStatusCode: http.StatusUnprocessableEntity,
+			expectedGrpcCode:       codes.FailedPrecondition,
+		},
+		{
+			name:                   "Unknown kind",
+			err:                    errors.New("test error"),
+			expectedHttpStatusCode: http.StatusInternalServerError,
+			expectedGrpcCode:       codes.Internal,
+		},
+	}
+
+	for _, testCase := range testCases {
+		status := GetErrorKindStatus(testCase.err)
+		if status.HttpStatusCode != testCase.expectedHttpStatusCode || status.GrpcCode != testCase.expectedGrpcCode {
+			t.Errorf("%s: Expected statuses %d and %s, got %d and %s", testCase.name,
+				testCase.expectedHttpStatusCode, testCase.expectedGrpcCode, status.HttpStatusCode, status.GrpcCode)
+		}
+	}
+}
@@ -1,25 +1,36 @@
 package main
 
 import (
+	"errors"
+	"fmt"
 	"log"
 	"os"
 
 	"github.com/Michu8258/kangaroo/commands"
+	"github.com/Michu8258/kangaroo/internal/library"
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/urfave/cli/v2"
 )
 
 func main() {
-	run(os.Args)
+	os.Exit(run(os.Args))
 }
 
-func run(arguments []string) {
+// run runs the cli app and returns process exit code
+func run(arguments []string) int {
 	settings := createSettings()
 
+	// logs are written to standard error, so standard output stays clean
+	logOutput := logger.NewOutput(settings, os.Stderr)
+	defer logOutput.Close()
+
 	commandConfig := &commands.CommandContext{
 		Settings:          settings,
-		ServiceCollection: services.Build(settings),
+		ServiceCollection: services.Build(settings, logOutput),
+		Stdin:             os.Stdin,
+		Stdout:            os.Stdout,
 	}
 
 	app := &cli.App{
@@ -27,43 +38,107 @@ func run(arguments []string) {
 		Usage:          "sudoku puzzle solution",
 		Version:        "0.0.1",
 		DefaultCommand: "help",
+		// errors are printed by commands, exit code is returned from run
+		ExitErrHandler: func(context *cli.Context, err error) {},
 		Authors: []*cli.Author{
 			{
 				Name:  "The author",
 				Email: "the.author@example.com",
 			},
 		},
+		// settings from configuration file and environment variables are loaded
+		// before actions of global options, so the options take precedence
+		Before: func(context *cli.Context) error {
+			return commandConfig.LoadSettings(context.String("config"))
+		},
 		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:        "config",
+				DefaultText: "$XDG_CONFIG_HOME/kangaroo/config.yaml",
+				Usage:       "Path to YAML configuration file with settings",
+			},
 			&cli.BoolFlag{
-				Name:        "silent",
-				Value:       false,
-				Usage:       "Supresses any standard output printing (prompts for inputs will still be printed)",
-				Destination: &commandConfig.Settings.SilentConsolePrints,
+				Name:  "silent",
+				Value: false,
+				Usage: "Supresses any standard output printing (prompts for inputs will still be printed)",
+				Action: func(context *cli.Context, silent bool) error {
+					settings.SilentConsolePrints = silent
+					return nil
+				},
+			},
+			&cli.StringFlag{
+				Name:  "output",
+				Value: settings.OutputFormat,
+				Usage: "Output format: text or json (single JSON document with the result, prompts are printed to stderr)",
+				Action: func(context *cli.Context, format string) error {
+					return settings.SetOutputFormat(format)
+				},
+			},
+			&cli.IntFlag{
+				Name:  "max-box-size",
+				Value: int(settings.MaximumBoxSizeInclusive),
+				Usage: fmt.Sprintf("Maximum accepted sudoku box size (up to %d)", models.SupportedMaximumBoxSize),
+				Action: func(context *cli.Context, size int) error {
+					return settings.SetMaximumBoxSize(size)
+				},
+			},
+			&cli.IntFlag{
+				Name:  "max-layout-size",
+				Value: int(settings.MaximumLayoutSizeInclusive),
+				Usage: fmt.Sprintf("Maximum accepted sudoku layout width and height (up to %d)", models.SupportedMaximumLayoutSize),
+				Action: func(context *cli.Context, size int) error {
+					return settings.SetMaximumLayoutSize(size)
+				},
+			},
+			&cli.StringFlag{
+				Name:  "log-level",
+				Value: settings.LogLevel,
+				Usage: "Minimum level of logged records: debug, info, warn or error",
+				Action: func(context *cli.Context, level string) error {
+					return settings.SetLogLevel(level)
+				},
+			},
+			&cli.StringFlag{
+				Name:  "log-format",
+				Value: settings.LogFormat,
+				Usage: "Format of logged records: text or json",
+				Action: func(context *cli.Context, format string) error {
+					return settings.SetLogFormat(format)
+				},
+			},
+			&cli.StringFlag{
+				Name:        "log-file",
+				DefaultText: "standard error",
+				Usage:       "Path to file where logs are appended",
+				Action: func(context *cli.Context, path string) error {
+					settings.LogFile = path
+					return nil
+				},
 			},
 		},
 		Commands: []*cli.Command{
 			commandConfig.CreateCommand(),
 			commandConfig.SolveCommand(),
+			commandConfig.ConvertCommand(),
+			commandConfig.EditCommand(),
+			commandConfig.BookletCommand(),
+			commandConfig.PlayCommand(),
 			commandConfig.ExecuteCommand(),
+			commandConfig.ServeCommand(),
+			commandConfig.ConfigCommand(),
 		},
 	}
 
-	if err := app.Run(arguments); err != nil {
-		log.Fatal(err)
+	err := app.Run(arguments)
+	exitCoder := cli.ExitCoder(nil)
+	if err != nil && !errors.As(err, &exitCoder) {
+		log.Print(err)
 	}
+
+	return commands.GetExitCode(err)
 }
 
+// createSettings returns default settings of the library, changed later by global options
 func createSettings() *models.Settings {
-	return &models.Settings{
-		MinimumLayoutSizeInclusive:       2,
-		MaximumLayoutSizeInclusive:       5,
-		DefaultLayoutSize:                3,
-		MinimumBoxSizeInclusive:          2,
-		MaximumBoxSizeInclusive:          5,
-		DefaultBoxSize:                   3,
-		SudokuPrintoutValuePaddingLength: 1,
-		UseDebugPrints:                   false,
-		SilentConsolePrints:              false,
-		SudokuBinaryEncoderVersion:       1,
-	}
+	return library.DefaultSettings()
 }
@@ -11,3 +11,37 @@ func TestMain(t *testing.T) {
 
 	run([]string{""})
 }
+
+func TestCreateSettings_MaximumSizes(t *testing.T) {
+	testCases := []struct {
+		boxSize       int
+		layoutSize    int
+		expectedError bool
+	}{
+		{boxSize: 8, layoutSize: 16, expectedError: false},
+		{boxSize: 2, layoutSize: 2, expectedError: false},
+		{boxSize: 9, layoutSize: 8, expectedError: true},
+		{boxSize: 1, layoutSize: 8, expectedError: true},
+		{boxSize: 8, layoutSize: 17, expectedError: true},
+		{boxSize: 8, layoutSize: 1, expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		settings := createSettings()
+
+		boxSizeErr := settings.SetMaximumBoxSize(testCase.boxSize)
+		layoutSizeErr := settings.SetMaximumLayoutSize(testCase.layoutSize)
+		hasError := boxSizeErr != nil || layoutSizeErr != nil
+
+		if hasError != testCase.expectedError {
+			t.Errorf("Box size %d, layout size %d - expected error: %t, got: %t.",
+				testCase.boxSize, testCase.layoutSize, testCase.expectedError, hasError)
+		}
+
+		if !hasError && (settings.DefaultBoxSize > settings.MaximumBoxSizeInclusive ||
+			settings.DefaultLayoutSize > settings.MaximumLayoutSizeInclusive) {
+			t.Errorf("Box size %d, layout size %d - default sizes exceed maximum sizes.",
+				testCase.boxSize, testCase.layoutSize)
+		}
+	}
+}
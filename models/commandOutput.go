@@ -0,0 +1,30 @@
+package models
+
+// CommandOutputDTO is a machine-readable result of a command, printed
+// as single JSON document when JSON output format is selected
+type CommandOutputDTO struct {
+	Command          string            `json:"command"`
+	Result           SudokuResultType  `json:"result"`
+	Sudoku           *SudokuDTO        `json:"sudoku,omitempty"`
+	EncodedSolution  string            `json:"encodedSolution,omitempty"`
+	BatchResults     []*BatchResultDTO `json:"batchResults,omitempty"`
+	ValidationErrors []string          `json:"validationErrors"`
+	Errors           []string          `json:"errors"`
+	SavedFiles       []string          `json:"savedFiles"`
+	LostInformation  []string          `json:"lostInformation,omitempty"`
+	Timing           CommandTimingDTO  `json:"timing"`
+}
+
+// BatchResultDTO is a result of single sudoku of exec command batch, it
+// contains either encoded solution or error type and message
+type BatchResultDTO struct {
+	Line     int    `json:"line"`
+	Solution string `json:"solution,omitempty"`
+	Error    string `json:"error,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+type CommandTimingDTO struct {
+	TotalMilliseconds    float64 `json:"totalMs"`
+	SolutionMilliseconds float64 `json:"solutionMs"`
+}
@@ -1,9 +1,12 @@
 package models
 
+import "time"
+
 type SudokuConfigRequest struct {
 	BoxSize      *int8
 	LayoutWidth  *int8
 	LayoutHeight *int8
+	Alphabet     *string
 	Overwrite    bool
 }
 
@@ -13,10 +16,97 @@ func (r *SudokuConfigRequest) AsConfigRequest() *SudokuConfigRequest {
 
 type SolveCommandRequest struct {
 	SudokuConfigRequest
-	InputJsonFile *string
-	OutputFile    *string
+	InputFile  *string
+	OutputFile *string
+	// Edit opens sudoku read from the input file in the editor before solving
+	Edit bool
+	// Timeout limits duration of the solution, 0 means no limit
+	Timeout time.Duration
 }
 
 type CreateCommandRequest struct {
 	SudokuConfigRequest
+	Candidates bool
+	// EmbedSolution solves the sudoku and embeds the solution in HTML pages
+	EmbedSolution bool
+}
+
+// ConvertCommandRequest describes conversion of sudoku file to a different
+// format. Empty format names mean that formats are resolved from the files.
+type ConvertCommandRequest struct {
+	InputFile  string
+	OutputFile string
+	FromFormat string
+	ToFormat   string
+	Alphabet   string
+	Overwrite  bool
+}
+
+// EditCommandRequest describes changes of sudoku file made in the editor.
+// Empty output file means that the input file is overwritten.
+type EditCommandRequest struct {
+	InputFile  string
+	OutputFile string
+	Alphabet   string
+	Overwrite  bool
+}
+
+// BookletCommandRequest describes a PDF booklet of puzzles read from the input
+// file or all files of the input directory
+type BookletCommandRequest struct {
+	InputPath      string
+	OutputFile     string
+	Title          string
+	PerPage        int
+	SolutionsAtEnd bool
+	Alphabet       string
+	Overwrite      bool
+}
+
+// PlayCommandRequest describes a game started from the puzzle input file or
+// resumed from the saved game file. Empty save file means default location.
+type PlayCommandRequest struct {
+	InputFile  string
+	ResumeFile string
+	SaveFile   string
+	Alphabet   string
+	Overwrite  bool
+}
+
+// BinaryEncoding specifies how sudoku binary data is represented in exec command
+// input and output
+type BinaryEncoding string
+
+const (
+	StdBase64Encoding BinaryEncoding = "std"
+	URLBase64Encoding BinaryEncoding = "url"
+	RawBinaryEncoding BinaryEncoding = "raw"
+)
+
+type ExecuteCommandRequest struct {
+	// Input is sudoku data passed as an argument, nil means standard input
+	Input    *string
+	Encoding BinaryEncoding
+	Batch    bool
+	Workers  int
+	// Timeout limits duration of solution of single sudoku, 0 means no limit
+	Timeout time.Duration
+}
+
+// GenerateSudokuRequest describes a sudoku puzzle to generate. Sudoku is generated
+// with all boxes enabled, the same seed always results in the same puzzle.
+type GenerateSudokuRequest struct {
+	BoxSize      int8
+	LayoutWidth  int8
+	LayoutHeight int8
+	Seed         int64
+}
+
+type ServeCommandRequest struct {
+	Address            string
+	MaximumRequestSize int64
+	RequestTimeout     time.Duration
+	Grpc               bool
+	// MaximumConcurrentSolves limits amount of requests solving sudokus at the same time
+	MaximumConcurrentSolves int
 }
@@ -0,0 +1,35 @@
+package models
+
+import "errors"
+
+// Kinds of errors of commands execution. Every kind has its own process exit code.
+var (
+	ErrInvalidInput         = errors.New("invalid input")
+	ErrInvalidConfiguration = errors.New("invalid sudoku configuration")
+	ErrUnsolvable           = errors.New("unsolvable sudoku")
+	ErrSolverFailure        = errors.New("sudoku solver failure")
+	ErrIO                   = errors.New("input/output failure")
+)
+
+type kindError struct {
+	kind error
+	err  error
+}
+
+// WithKind marks the error with provided kind (one of errors above), so it can be
+// checked with errors.Is. Message of the error is not changed.
+func WithKind(kind error, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return &kindError{kind: kind, err: err}
+}
+
+func (err *kindError) Error() string {
+	return err.err.Error()
+}
+
+func (err *kindError) Unwrap() []error {
+	return []error{err.kind, err.err}
+}
@@ -0,0 +1,33 @@
+package models
+
+// ServerResponseDTO is a response of HTTP server endpoints. It uses the same
+// result types and errors lists as JSON output of commands.
+type ServerResponseDTO struct {
+	Result           SudokuResultType `json:"result"`
+	Sudoku           *SudokuDTO       `json:"sudoku,omitempty"`
+	Solution         *SudokuDTO       `json:"solution,omitempty"`
+	EncodedSudoku    string           `json:"encodedSudoku,omitempty"`
+	Valid            *bool            `json:"valid,omitempty"`
+	SolutionsCount   *int             `json:"solutionsCount,omitempty"`
+	Hint             *SudokuHintDTO   `json:"hint,omitempty"`
+	Seed             *int64           `json:"seed,omitempty"`
+	ValidationErrors []string         `json:"validationErrors"`
+	Errors           []string         `json:"errors"`
+}
+
+// SudokuHintDTO is a value of single empty cell of a sudoku, with absolute
+// (in the context of entire sudoku) zero based row and column indexes
+type SudokuHintDTO struct {
+	Row    int    `json:"row"`
+	Column int    `json:"column"`
+	Value  int    `json:"value"`
+	Symbol string `json:"symbol,omitempty"`
+}
+
+// GenerateSudokuRequestDTO is a request body of sudoku generation, sizes
+// not provided are replaced with default sizes from settings
+type GenerateSudokuRequestDTO struct {
+	BoxSize int8            `json:"boxSize"`
+	Layout  SudokuLayoutDTO `json:"layout"`
+	Seed    *int64          `json:"seed,omitempty"`
+}
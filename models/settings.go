@@ -1,5 +1,37 @@
 package models
 
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// Highest box size and layout size the application can handle. Box and layout
+// indexes are stored as int8 and cell values have to fit into a single byte of
+// binary representation, so configured maximums cannot exceed those limits.
+const (
+	SupportedMaximumBoxSize    int8 = 8
+	SupportedMaximumLayoutSize int8 = 16
+)
+
+// Formats of log records
+const (
+	TextLogFormat = "text"
+	JSONLogFormat = "json"
+)
+
+// Versions of sudoku binary representation the encoder can write
+const (
+	MinimumBinaryEncoderVersion uint16 = 1
+	MaximumBinaryEncoderVersion uint16 = 2
+)
+
+// Formats of commands output
+const (
+	TextOutputFormat = "text"
+	JSONOutputFormat = "json"
+)
+
 type Settings struct {
 	MinimumLayoutSizeInclusive       int8
 	MaximumLayoutSizeInclusive       int8
@@ -11,4 +43,181 @@ type Settings struct {
 	UseDebugPrints                   bool
 	SilentConsolePrints              bool
 	SudokuBinaryEncoderVersion       uint16
+	OutputFormat                     string
+	LogLevel                         string
+	LogFormat                        string
+	LogFile                          string
+	RenderCandidates                 bool
+	RenderSolution                   bool
+}
+
+// SetMaximumBoxSize changes maximum accepted box size. Returns an error if the
+// size is lower than minimum box size or higher than supported maximum.
+func (settings *Settings) SetMaximumBoxSize(size int) error {
+	if size < int(settings.MinimumBoxSizeInclusive) || size > int(SupportedMaximumBoxSize) {
+		return fmt.Errorf("maximum box size has a value of %d, but it is expected to be between %d and %d inclusively",
+			size, settings.MinimumBoxSizeInclusive, SupportedMaximumBoxSize)
+	}
+
+	settings.MaximumBoxSizeInclusive = int8(size)
+	if settings.DefaultBoxSize > settings.MaximumBoxSizeInclusive {
+		settings.DefaultBoxSize = settings.MaximumBoxSizeInclusive
+	}
+
+	return nil
+}
+
+// SetMaximumLayoutSize changes maximum accepted layout width and height. Returns an error
+// if the size is lower than minimum layout size or higher than supported maximum.
+func (settings *Settings) SetMaximumLayoutSize(size int) error {
+	if size < int(settings.MinimumLayoutSizeInclusive) || size > int(SupportedMaximumLayoutSize) {
+		return fmt.Errorf("maximum layout size has a value of %d, but it is expected to be between %d and %d inclusively",
+			size, settings.MinimumLayoutSizeInclusive, SupportedMaximumLayoutSize)
+	}
+
+	settings.MaximumLayoutSizeInclusive = int8(size)
+	if settings.DefaultLayoutSize > settings.MaximumLayoutSizeInclusive {
+		settings.DefaultLayoutSize = settings.MaximumLayoutSizeInclusive
+	}
+
+	return nil
+}
+
+// SetOutputFormat changes format of commands output. JSON output format suppresses
+// standard terminal prints (see IsConsoleSilent), as the result is printed as single
+// JSON document.
+func (settings *Settings) SetOutputFormat(format string) error {
+	if format != TextOutputFormat && format != JSONOutputFormat {
+		return fmt.Errorf("output format '%s' is not supported, expected '%s' or '%s'",
+			format, TextOutputFormat, JSONOutputFormat)
+	}
+
+	settings.OutputFormat = format
+	return nil
+}
+
+// IsConsoleSilent reports if standard terminal prints are suppressed, either with
+// silent setting or by JSON output format. Silent setting is kept unchanged by the
+// output format, so switching back to text format restores the prints.
+func (settings *Settings) IsConsoleSilent() bool {
+	return settings.SilentConsolePrints || settings.OutputFormat == JSONOutputFormat
+}
+
+// SetLogLevel changes minimum level of logged records (debug, info, warn or error)
+func (settings *Settings) SetLogLevel(level string) error {
+	if _, err := parseLogLevel(level); err != nil {
+		return err
+	}
+
+	settings.LogLevel = level
+	return nil
+}
+
+// SetLogFormat changes format of log records
+func (settings *Settings) SetLogFormat(format string) error {
+	if format != TextLogFormat && format != JSONLogFormat {
+		return fmt.Errorf("log format '%s' is not supported, expected '%s' or '%s'",
+			format, TextLogFormat, JSONLogFormat)
+	}
+
+	settings.LogFormat = format
+	return nil
+}
+
+// GetLogLevel returns minimum level of logged records. Debug prints lower
+// the level to debug, and warn level is used if no level is specified.
+func (settings *Settings) GetLogLevel() slog.Level {
+	if settings.UseDebugPrints {
+		return slog.LevelDebug
+	}
+
+	level, err := parseLogLevel(settings.LogLevel)
+	if err != nil {
+		return slog.LevelWarn
+	}
+
+	return level
+}
+
+// Validate checks if the settings values are consistent. Returns all found
+// problems joined into a single error.
+func (settings *Settings) Validate() error {
+	errs := []error{}
+
+	if settings.MinimumBoxSizeInclusive < 2 ||
+		settings.MinimumBoxSizeInclusive > settings.MaximumBoxSizeInclusive {
+		errs = append(errs, fmt.Errorf("minimum box size has a value of %d, but it is expected to be between 2 and maximum box size %d inclusively",
+			settings.MinimumBoxSizeInclusive, settings.MaximumBoxSizeInclusive))
+	}
+
+	if settings.MaximumBoxSizeInclusive > SupportedMaximumBoxSize {
+		errs = append(errs, fmt.Errorf("maximum box size has a value of %d, but it can not be higher than %d",
+			settings.MaximumBoxSizeInclusive, SupportedMaximumBoxSize))
+	}
+
+	if settings.DefaultBoxSize < settings.MinimumBoxSizeInclusive ||
+		settings.DefaultBoxSize > settings.MaximumBoxSizeInclusive {
+		errs = append(errs, fmt.Errorf("default box size has a value of %d, but it is expected to be between %d and %d inclusively",
+			settings.DefaultBoxSize, settings.MinimumBoxSizeInclusive, settings.MaximumBoxSizeInclusive))
+	}
+
+	if settings.MinimumLayoutSizeInclusive < 1 ||
+		settings.MinimumLayoutSizeInclusive > settings.MaximumLayoutSizeInclusive {
+		errs = append(errs, fmt.Errorf("minimum layout size has a value of %d, but it is expected to be between 1 and maximum layout size %d inclusively",
+			settings.MinimumLayoutSizeInclusive, settings.MaximumLayoutSizeInclusive))
+	}
+
+	if settings.MaximumLayoutSizeInclusive > SupportedMaximumLayoutSize {
+		errs = append(errs, fmt.Errorf("maximum layout size has a value of %d, but it can not be higher than %d",
+			settings.MaximumLayoutSizeInclusive, SupportedMaximumLayoutSize))
+	}
+
+	if settings.DefaultLayoutSize < settings.MinimumLayoutSizeInclusive ||
+		settings.DefaultLayoutSize > settings.MaximumLayoutSizeInclusive {
+		errs = append(errs, fmt.Errorf("default layout size has a value of %d, but it is expected to be between %d and %d inclusively",
+			settings.DefaultLayoutSize, settings.MinimumLayoutSizeInclusive, settings.MaximumLayoutSizeInclusive))
+	}
+
+	if settings.SudokuPrintoutValuePaddingLength < 0 {
+		errs = append(errs, fmt.Errorf("value padding has a value of %d, but it can not be negative",
+			settings.SudokuPrintoutValuePaddingLength))
+	}
+
+	if settings.SudokuBinaryEncoderVersion < MinimumBinaryEncoderVersion ||
+		settings.SudokuBinaryEncoderVersion > MaximumBinaryEncoderVersion {
+		errs = append(errs, fmt.Errorf("binary encoder version %d is not supported, expected version between %d and %d",
+			settings.SudokuBinaryEncoderVersion, MinimumBinaryEncoderVersion, MaximumBinaryEncoderVersion))
+	}
+
+	if settings.OutputFormat != TextOutputFormat && settings.OutputFormat != JSONOutputFormat {
+		errs = append(errs, fmt.Errorf("output format '%s' is not supported, expected '%s' or '%s'",
+			settings.OutputFormat, TextOutputFormat, JSONOutputFormat))
+	}
+
+	if _, err := parseLogLevel(settings.LogLevel); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(settings.LogFormat) > 0 && settings.LogFormat != TextLogFormat &&
+		settings.LogFormat != JSONLogFormat {
+		errs = append(errs, fmt.Errorf("log format '%s' is not supported, expected '%s' or '%s'",
+			settings.LogFormat, TextLogFormat, JSONLogFormat))
+	}
+
+	return errors.Join(errs...)
+}
+
+// parseLogLevel converts name of the log level to slog level. Empty
+// name is parsed as warn level.
+func parseLogLevel(level string) (slog.Level, error) {
+	if len(level) < 1 {
+		return slog.LevelWarn, nil
+	}
+
+	var parsedLevel slog.Level
+	if err := parsedLevel.UnmarshalText([]byte(level)); err != nil {
+		return slog.LevelWarn, fmt.Errorf("log level '%s' is not supported, expected debug, info, warn or error", level)
+	}
+
+	return parsedLevel, nil
 }
@@ -0,0 +1,111 @@
+package models
+
+// SubSudokuArea holds zero based indexes of top left box of a sub-sudoku -
+// a square of BoxSize x BoxSize enabled boxes. Every row and column of
+// the sub-sudoku has to contain different values.
+type SubSudokuArea struct {
+	TopBoxRowIndex     int8
+	LeftBoxColumnIndex int8
+}
+
+// FindSubSudokuAreas returns all sub-sudokus of the layout, ordered by the row
+// and then by the column of top left box. isBoxEnabled reports if the box with
+// provided indexes exists and is not disabled.
+func FindSubSudokuAreas(boxSize int8, layout SudokuLayout,
+	isBoxEnabled func(rowIndex, columnIndex int8) bool) []SubSudokuArea {
+
+	areas := []SubSudokuArea{}
+	if boxSize < 1 {
+		return areas
+	}
+
+	isSubSudoku := func(topRowIndex, leftColumnIndex int8) bool {
+		for rowIndex := topRowIndex; rowIndex < topRowIndex+boxSize; rowIndex++ {
+			for columnIndex := leftColumnIndex; columnIndex < leftColumnIndex+boxSize; columnIndex++ {
+				if !isBoxEnabled(rowIndex, columnIndex) {
+					return false
+				}
+			}
+		}
+
+		return true
+	}
+
+	for topRowIndex := int8(0); topRowIndex+boxSize <= layout.Height; topRowIndex++ {
+		for leftColumnIndex := int8(0); leftColumnIndex+boxSize <= layout.Width; leftColumnIndex++ {
+			if isSubSudoku(topRowIndex, leftColumnIndex) {
+				areas = append(areas, SubSudokuArea{
+					TopBoxRowIndex:     topRowIndex,
+					LeftBoxColumnIndex: leftColumnIndex,
+				})
+			}
+		}
+	}
+
+	return areas
+}
+
+// GetSubSudokuAreas returns all sub-sudokus formed by enabled boxes of the sudoku
+func (sudoku *Sudoku) GetSubSudokuAreas() []SubSudokuArea {
+	enabledBoxes := map[[2]int8]bool{}
+	for _, box := range sudoku.Boxes {
+		enabledBoxes[[2]int8{box.IndexRow, box.IndexColumn}] = !box.Disabled
+	}
+
+	return FindSubSudokuAreas(sudoku.BoxSize, sudoku.Layout, func(rowIndex, columnIndex int8) bool {
+		return enabledBoxes[[2]int8{rowIndex, columnIndex}]
+	})
+}
+
+// GetSubSudokuAreas returns all sub-sudokus formed by enabled boxes of the sudoku
+func (sudokuDto *SudokuDTO) GetSubSudokuAreas() []SubSudokuArea {
+	enabledBoxes := map[[2]int8]bool{}
+	for _, box := range sudokuDto.Boxes {
+		if box != nil {
+			enabledBoxes[[2]int8{box.IndexRow, box.IndexColumn}] = !box.Disabled
+		}
+	}
+
+	return FindSubSudokuAreas(sudokuDto.BoxSize, SudokuLayout{
+		Width:  sudokuDto.Layout.Width,
+		Height: sudokuDto.Layout.Height,
+	}, func(rowIndex, columnIndex int8) bool {
+		return enabledBoxes[[2]int8{rowIndex, columnIndex}]
+	})
+}
+
+// Rows returns absolute positions of cells of every row of the sub-sudoku
+func (area SubSudokuArea) Rows(boxSize int8) [][]SudokuCellPosition {
+	return area.getLines(boxSize, func(line, index int) SudokuCellPosition {
+		return SudokuCellPosition{Row: line, Column: index}
+	})
+}
+
+// Columns returns absolute positions of cells of every column of the sub-sudoku
+func (area SubSudokuArea) Columns(boxSize int8) [][]SudokuCellPosition {
+	return area.getLines(boxSize, func(line, index int) SudokuCellPosition {
+		return SudokuCellPosition{Row: index, Column: line}
+	})
+}
+
+// getLines builds lines of the sub-sudoku, positionProvider returns position
+// relative to top left cell of the sub-sudoku of the cell with provided index
+// in the line with provided index
+func (area SubSudokuArea) getLines(boxSize int8,
+	positionProvider func(line, index int) SudokuCellPosition) [][]SudokuCellPosition {
+
+	size := int(boxSize) * int(boxSize)
+	top := int(area.TopBoxRowIndex) * int(boxSize)
+	left := int(area.LeftBoxColumnIndex) * int(boxSize)
+
+	lines := make([][]SudokuCellPosition, size)
+	for line := range lines {
+		lines[line] = make([]SudokuCellPosition, size)
+		for index := range lines[line] {
+			position := positionProvider(line, index)
+			lines[line][index] = SudokuCellPosition{Row: top + position.Row, Column: left + position.Column}
+		}
+	}
+
+	return lines
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"fmt"
+
 	guid "github.com/nu7hatch/gouuid"
 )
 
@@ -62,11 +64,15 @@ type SudokuLayout struct {
 }
 
 type Sudoku struct {
-	BoxSize    int8
-	Layout     SudokuLayout
-	Boxes      GenericSlice[*SudokuBox]
-	SubSudokus GenericSlice[*SubSudoku]
-	Result     SudokuResultType
+	BoxSize      int8
+	Layout       SudokuLayout
+	Alphabet     string
+	Boxes        GenericSlice[*SudokuBox]
+	SubSudokus   GenericSlice[*SubSudoku]
+	Thermometers GenericSlice[*SudokuThermometer]
+	Arrows       GenericSlice[*SudokuArrow]
+	Comparisons  GenericSlice[*SudokuComparison]
+	Result       SudokuResultType
 }
 
 type SudokuValueGuess struct {
@@ -86,6 +92,41 @@ const (
 	UnsolvableSudoku    SudokuResultType = 4
 )
 
+var sudokuResultTypeNames = map[SudokuResultType]string{
+	Unspecified:         "unspecified",
+	SuccessfullSolution: "success",
+	Failure:             "failure",
+	InvalidGuess:        "invalidGuess",
+	UnsolvableSudoku:    "unsolvable",
+}
+
+// String returns a name of the result type
+func (resultType SudokuResultType) String() string {
+	name, ok := sudokuResultTypeNames[resultType]
+	if !ok {
+		return fmt.Sprintf("unknown(%d)", int8(resultType))
+	}
+
+	return name
+}
+
+// MarshalText makes result type serialized by its name, e.g. in JSON output
+func (resultType SudokuResultType) MarshalText() ([]byte, error) {
+	return []byte(resultType.String()), nil
+}
+
+// SudokuDifficulty describes how hard it is to solve the sudoku by hand
+type SudokuDifficulty string
+
+const (
+	// EasyDifficulty sudoku can be solved with hidden singles only
+	EasyDifficulty SudokuDifficulty = "easy"
+	// MediumDifficulty sudoku can be solved with hidden and naked singles
+	MediumDifficulty SudokuDifficulty = "medium"
+	// HardDifficulty sudoku requires more advanced techniques or guessing
+	HardDifficulty SudokuDifficulty = "hard"
+)
+
 // ToSudoku converts internal sudoku object to DTO object.
 // Suitable for serialization to json
 func (sudoku *Sudoku) ToSudokuDto() *SudokuDTO {
@@ -95,7 +136,8 @@ func (sudoku *Sudoku) ToSudokuDto() *SudokuDTO {
 			Height: sudoku.Layout.Height,
 			Width:  sudoku.Layout.Width,
 		},
-		Boxes: GenericSlice[*SudokuBoxDTO]{},
+		Alphabet: sudoku.Alphabet,
+		Boxes:    GenericSlice[*SudokuBoxDTO]{},
 	}
 
 	for _, sudokuBox := range sudoku.Boxes {
@@ -117,5 +159,50 @@ func (sudoku *Sudoku) ToSudokuDto() *SudokuDTO {
 		sudokuDto.Boxes = append(sudokuDto.Boxes, sudokuBoxDto)
 	}
 
+	for _, thermometer := range sudoku.Thermometers {
+		sudokuDto.Thermometers = append(sudokuDto.Thermometers, &SudokuThermometerDTO{
+			Cells: toCellPositionDtos(thermometer.Path),
+		})
+	}
+
+	for _, arrow := range sudoku.Arrows {
+		sudokuDto.Arrows = append(sudokuDto.Arrows, &SudokuArrowDTO{
+			Circle: SudokuCellPositionDTO(arrow.CirclePosition),
+			Cells:  toCellPositionDtos(arrow.Path),
+		})
+	}
+
+	for _, comparison := range sudoku.Comparisons {
+		sudokuDto.Comparisons = append(sudokuDto.Comparisons, &SudokuComparisonDTO{
+			Greater: SudokuCellPositionDTO(comparison.GreaterPosition),
+			Lesser:  SudokuCellPositionDTO(comparison.LesserPosition),
+		})
+	}
+
+	sudokuDto.AssignSymbols()
+
 	return sudokuDto
 }
+
+// toCellPositionDtos converts internally used cell positions to DTOs
+func toCellPositionDtos(positions []SudokuCellPosition) GenericSlice[*SudokuCellPositionDTO] {
+	positionDtos := GenericSlice[*SudokuCellPositionDTO]{}
+	for _, position := range positions {
+		positionDto := SudokuCellPositionDTO(position)
+		positionDtos = append(positionDtos, &positionDto)
+	}
+
+	return positionDtos
+}
+
+// UnmarshalText reads result type serialized by its name
+func (resultType *SudokuResultType) UnmarshalText(text []byte) error {
+	for value, name := range sudokuResultTypeNames {
+		if name == string(text) {
+			*resultType = value
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown sudoku result type '%s'", string(text))
+}
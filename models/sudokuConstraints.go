@@ -0,0 +1,159 @@
+package models
+
+// SudokuCellPosition holds absolute (in the context of entire sudoku)
+// zero based row and column indexes of a cell
+type SudokuCellPosition struct {
+	Row    int
+	Column int
+}
+
+// SudokuThermometer represents a thermometer constraint - values of the cells
+// strictly increase along the path, starting from the bulb (first cell)
+type SudokuThermometer struct {
+	Path         []SudokuCellPosition
+	Cells        GenericSlice[*SudokuCell]
+	ViolatesRule bool
+}
+
+// SudokuArrow represents an arrow constraint - value of the circle cell
+// equals the sum of values of the cells on the arrow path
+type SudokuArrow struct {
+	CirclePosition SudokuCellPosition
+	Path           []SudokuCellPosition
+	Circle         *SudokuCell
+	Cells          GenericSlice[*SudokuCell]
+	ViolatesRule   bool
+}
+
+// SudokuComparison represents an inequality sign between two orthogonally
+// adjacent cells of the same box - value of the greater cell is higher
+// than value of the lesser cell
+type SudokuComparison struct {
+	GreaterPosition SudokuCellPosition
+	LesserPosition  SudokuCellPosition
+	Greater         *SudokuCell
+	Lesser          *SudokuCell
+	ViolatesRule    bool
+}
+
+// IsAdjacentTo checks if the other position touches this one
+// orthogonally or diagonally
+func (position SudokuCellPosition) IsAdjacentTo(other SudokuCellPosition) bool {
+	rowDistance := position.Row - other.Row
+	columnDistance := position.Column - other.Column
+
+	if position == other {
+		return false
+	}
+
+	return rowDistance >= -1 && rowDistance <= 1 && columnDistance >= -1 && columnDistance <= 1
+}
+
+// IsOrthogonallyAdjacentTo checks if the other position touches this one
+// horizontally or vertically
+func (position SudokuCellPosition) IsOrthogonallyAdjacentTo(other SudokuCellPosition) bool {
+	if !position.IsAdjacentTo(other) {
+		return false
+	}
+
+	return position.Row == other.Row || position.Column == other.Column
+}
+
+// HasRuleViolation checks if already assigned values on the thermometer
+// break the rule. Empty cells are respected - two filled cells have to be
+// separated by a value difference that leaves space for cells in between.
+func (thermometer *SudokuThermometer) HasRuleViolation() bool {
+	previousIndex := -1
+	previousValue := 0
+
+	for cellIndex, cell := range thermometer.Cells {
+		if cell.Value == nil {
+			continue
+		}
+
+		if previousIndex >= 0 && *cell.Value-previousValue < cellIndex-previousIndex {
+			return true
+		}
+
+		previousIndex = cellIndex
+		previousValue = *cell.Value
+	}
+
+	return false
+}
+
+// HasRuleViolation checks if already assigned values on the arrow break the
+// rule. Sum of filled path cells (and lowest possible value for empty ones)
+// cannot exceed the circle value and completely filled arrow must sum up
+// exactly to the circle value.
+func (arrow *SudokuArrow) HasRuleViolation() bool {
+	if arrow.Circle == nil || arrow.Circle.Value == nil {
+		return false
+	}
+
+	sum := 0
+	allCellsHaveValues := true
+	for _, cell := range arrow.Cells {
+		if cell.Value == nil {
+			allCellsHaveValues = false
+			sum += 1
+			continue
+		}
+
+		sum += *cell.Value
+	}
+
+	if allCellsHaveValues {
+		return sum != *arrow.Circle.Value
+	}
+
+	return sum > *arrow.Circle.Value
+}
+
+// HasRuleViolation checks if values of both compared cells are assigned
+// and the greater cell value is not higher than the lesser cell value
+func (comparison *SudokuComparison) HasRuleViolation() bool {
+	if comparison.Greater == nil || comparison.Lesser == nil ||
+		comparison.Greater.Value == nil || comparison.Lesser.Value == nil {
+		return false
+	}
+
+	return *comparison.Greater.Value <= *comparison.Lesser.Value
+}
+
+// FindComparison returns comparison defined between cells with provided
+// positions (regardless of the order) or nil if there is no such comparison
+func (sudoku *Sudoku) FindComparison(first SudokuCellPosition, second SudokuCellPosition) *SudokuComparison {
+	return sudoku.Comparisons.FirstOrDefault(nil, func(comparison *SudokuComparison) bool {
+		return (comparison.GreaterPosition == first && comparison.LesserPosition == second) ||
+			(comparison.GreaterPosition == second && comparison.LesserPosition == first)
+	})
+}
+
+// GetCellByPosition locates the box and the cell with provided absolute
+// position. Returns nil pointers if there is no such box or cell.
+func (sudoku *Sudoku) GetCellByPosition(position SudokuCellPosition) (*SudokuBox, *SudokuCell) {
+	if position.Row < 0 || position.Column < 0 || sudoku.BoxSize < 1 {
+		return nil, nil
+	}
+
+	boxSize := int(sudoku.BoxSize)
+	boxRowIndex := int8(position.Row / boxSize)
+	boxColumnIndex := int8(position.Column / boxSize)
+	cellRowIndex := int8(position.Row % boxSize)
+	cellColumnIndex := int8(position.Column % boxSize)
+
+	box := sudoku.Boxes.FirstOrDefault(nil, func(b *SudokuBox) bool {
+		return b.IndexRow == boxRowIndex && b.IndexColumn == boxColumnIndex
+	})
+
+	if box == nil {
+		return nil, nil
+	}
+
+	cell := box.Cells.FirstOrDefault(nil, func(c *SudokuCell) bool {
+		return c.IndexRowInBox == cellRowIndex && c.IndexColumnInBox == cellColumnIndex
+	})
+
+	return box, cell
+}
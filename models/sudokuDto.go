@@ -1,13 +1,16 @@
 package models
 
 import (
+	"fmt"
+
 	guid "github.com/nu7hatch/gouuid"
 )
 
 type SudokuCellDTO struct {
-	Value            *int `json:"value"`
-	IndexRowInBox    int8 `json:"indexRowInBox"`
-	IndexColumnInBox int8 `json:"indexColumnInBox"`
+	Value            *int   `json:"value"`
+	Symbol           string `json:"symbol,omitempty"`
+	IndexRowInBox    int8   `json:"indexRowInBox"`
+	IndexColumnInBox int8   `json:"indexColumnInBox"`
 }
 
 type SudokuBoxDTO struct {
@@ -22,10 +25,77 @@ type SudokuLayoutDTO struct {
 	Height int8 `json:"height"`
 }
 
+type SudokuCellPositionDTO struct {
+	Row    int `json:"row"`
+	Column int `json:"column"`
+}
+
+type SudokuThermometerDTO struct {
+	Cells GenericSlice[*SudokuCellPositionDTO] `json:"cells"`
+}
+
+type SudokuArrowDTO struct {
+	Circle SudokuCellPositionDTO                `json:"circle"`
+	Cells  GenericSlice[*SudokuCellPositionDTO] `json:"cells"`
+}
+
+type SudokuComparisonDTO struct {
+	Greater SudokuCellPositionDTO `json:"greater"`
+	Lesser  SudokuCellPositionDTO `json:"lesser"`
+}
+
 type SudokuDTO struct {
-	BoxSize int8                        `json:"boxSize"`
-	Layout  SudokuLayoutDTO             `json:"layout"`
-	Boxes   GenericSlice[*SudokuBoxDTO] `json:"boxes"`
+	BoxSize      int8                                `json:"boxSize"`
+	Layout       SudokuLayoutDTO                     `json:"layout"`
+	Alphabet     string                              `json:"alphabet,omitempty"`
+	Boxes        GenericSlice[*SudokuBoxDTO]         `json:"boxes"`
+	Thermometers GenericSlice[*SudokuThermometerDTO] `json:"thermometers,omitempty"`
+	Arrows       GenericSlice[*SudokuArrowDTO]       `json:"arrows,omitempty"`
+	Comparisons  GenericSlice[*SudokuComparisonDTO]  `json:"comparisons,omitempty"`
+}
+
+// NewEmptySudokuDTO builds sudoku DTO object with all boxes enabled
+// and no values assigned
+func NewEmptySudokuDTO(boxSize int8, layoutWidth int8, layoutHeight int8) *SudokuDTO {
+	sudokuDto := &SudokuDTO{
+		BoxSize: boxSize,
+		Layout: SudokuLayoutDTO{
+			Width:  layoutWidth,
+			Height: layoutHeight,
+		},
+		Boxes: GenericSlice[*SudokuBoxDTO]{},
+	}
+
+	var bowRowIndex int8 = 0
+	var boxColumnIndex int8 = 0
+
+	for bowRowIndex = 0; bowRowIndex < sudokuDto.Layout.Height; bowRowIndex++ {
+		for boxColumnIndex = 0; boxColumnIndex < sudokuDto.Layout.Width; boxColumnIndex++ {
+			sudokuBox := &SudokuBoxDTO{
+				Disabled:    false,
+				IndexRow:    bowRowIndex,
+				IndexColumn: boxColumnIndex,
+				Cells:       GenericSlice[*SudokuCellDTO]{},
+			}
+
+			var cellRowIndex int8 = 0
+			var cellColumnIndex int8 = 0
+
+			for cellRowIndex = 0; cellRowIndex < sudokuDto.BoxSize; cellRowIndex++ {
+				for cellColumnIndex = 0; cellColumnIndex < sudokuDto.BoxSize; cellColumnIndex++ {
+					sudokuBox.Cells = append(sudokuBox.Cells, &SudokuCellDTO{
+						Value:            nil,
+						IndexRowInBox:    cellRowIndex,
+						IndexColumnInBox: cellColumnIndex,
+					})
+				}
+			}
+
+			sudokuDto.Boxes = append(sudokuDto.Boxes, sudokuBox)
+		}
+	}
+
+	return sudokuDto
 }
 
 // ToSudoku converts raw sudoku DTO object to internally managed object
@@ -37,9 +107,13 @@ func (sudokuDto *SudokuDTO) ToSudoku() *Sudoku {
 			Height: sudokuDto.Layout.Height,
 			Width:  sudokuDto.Layout.Width,
 		},
-		Boxes:      GenericSlice[*SudokuBox]{},
-		SubSudokus: []*SubSudoku{},
-		Result:     Unspecified,
+		Alphabet:     sudokuDto.Alphabet,
+		Boxes:        GenericSlice[*SudokuBox]{},
+		SubSudokus:   []*SubSudoku{},
+		Thermometers: GenericSlice[*SudokuThermometer]{},
+		Arrows:       GenericSlice[*SudokuArrow]{},
+		Comparisons:  GenericSlice[*SudokuComparison]{},
+		Result:       Unspecified,
 	}
 
 	for _, sudokuBoxDto := range sudokuDto.Boxes {
@@ -70,5 +144,119 @@ func (sudokuDto *SudokuDTO) ToSudoku() *Sudoku {
 		sudoku.Boxes = append(sudoku.Boxes, sudokuBox)
 	}
 
+	for _, thermometerDto := range sudokuDto.Thermometers {
+		sudoku.Thermometers = append(sudoku.Thermometers, &SudokuThermometer{
+			Path:         toCellPositions(thermometerDto.Cells),
+			Cells:        GenericSlice[*SudokuCell]{},
+			ViolatesRule: false,
+		})
+	}
+
+	for _, arrowDto := range sudokuDto.Arrows {
+		sudoku.Arrows = append(sudoku.Arrows, &SudokuArrow{
+			CirclePosition: SudokuCellPosition(arrowDto.Circle),
+			Path:           toCellPositions(arrowDto.Cells),
+			Circle:         nil,
+			Cells:          GenericSlice[*SudokuCell]{},
+			ViolatesRule:   false,
+		})
+	}
+
+	for _, comparisonDto := range sudokuDto.Comparisons {
+		sudoku.Comparisons = append(sudoku.Comparisons, &SudokuComparison{
+			GreaterPosition: SudokuCellPosition(comparisonDto.Greater),
+			LesserPosition:  SudokuCellPosition(comparisonDto.Lesser),
+			Greater:         nil,
+			Lesser:          nil,
+			ViolatesRule:    false,
+		})
+	}
+
 	return sudoku
 }
+
+// ResolveSymbols assigns values to cells that have only a symbol specified
+// (according to the symbol alphabet of the sudoku). Returns an error if
+// a symbol is not a part of the alphabet or it does not match the value.
+func (sudokuDto *SudokuDTO) ResolveSymbols() error {
+	var alphabet *SymbolAlphabet
+
+	if len(sudokuDto.Alphabet) > 0 {
+		parsedAlphabet, err := ParseSymbolAlphabet(sudokuDto.Alphabet, sudokuDto.BoxSize)
+		if err != nil {
+			return err
+		}
+
+		alphabet = parsedAlphabet
+	}
+
+	for _, box := range sudokuDto.Boxes {
+		for _, cell := range box.Cells {
+			if len(cell.Symbol) < 1 {
+				continue
+			}
+
+			symbol := []rune(cell.Symbol)
+			value, ok := alphabet.GetValue(symbol[0])
+			if len(symbol) != 1 || !ok {
+				return fmt.Errorf("symbol '%s' is not a part of the sudoku alphabet '%s'",
+					cell.Symbol, sudokuDto.Alphabet)
+			}
+
+			if cell.Value != nil && *cell.Value != value {
+				return fmt.Errorf("symbol '%s' does not represent value %d", cell.Symbol, *cell.Value)
+			}
+
+			cell.Value = &value
+		}
+	}
+
+	return nil
+}
+
+// AssignSymbols sets symbol of every cell with a value according to the symbol
+// alphabet of the sudoku. Symbols are cleared if there is no valid alphabet.
+func (sudokuDto *SudokuDTO) AssignSymbols() {
+	alphabet, err := ParseSymbolAlphabet(sudokuDto.Alphabet, sudokuDto.BoxSize)
+	if len(sudokuDto.Alphabet) < 1 || err != nil {
+		alphabet = nil
+	}
+
+	for _, box := range sudokuDto.Boxes {
+		for _, cell := range box.Cells {
+			cell.Symbol = ""
+			if alphabet != nil && cell.Value != nil {
+				cell.Symbol = alphabet.GetSymbol(*cell.Value)
+			}
+		}
+	}
+}
+
+// FindComparison returns comparison defined between cells with provided
+// positions (regardless of the order) or nil if there is no such comparison
+func (sudokuDto *SudokuDTO) FindComparison(first SudokuCellPositionDTO,
+	second SudokuCellPositionDTO) *SudokuComparisonDTO {
+
+	return sudokuDto.Comparisons.FirstOrDefault(nil, func(comparison *SudokuComparisonDTO) bool {
+		return (comparison.Greater == first && comparison.Lesser == second) ||
+			(comparison.Greater == second && comparison.Lesser == first)
+	})
+}
+
+// SudokuFile describes sudokus read from a single file
+type SudokuFile struct {
+	Path    string
+	Sudokus []*SudokuDTO
+}
+
+// toCellPositions converts cell positions DTOs to internally used positions
+func toCellPositions(positionDtos GenericSlice[*SudokuCellPositionDTO]) []SudokuCellPosition {
+	positions := make([]SudokuCellPosition, 0, len(positionDtos))
+	for _, positionDto := range positionDtos {
+		if positionDto != nil {
+			positions = append(positions, SudokuCellPosition(*positionDto))
+		}
+	}
+
+	return positions
+}
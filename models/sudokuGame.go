@@ -0,0 +1,27 @@
+package models
+
+// SudokuGameDTO is a state of the sudoku game played in the terminal. Puzzle
+// contains only given values, values entered by the player are kept as entries,
+// so a resumed game knows which values are locked.
+type SudokuGameDTO struct {
+	Puzzle         *SudokuDTO                        `json:"puzzle"`
+	Entries        GenericSlice[*SudokuGameEntryDTO] `json:"entries"`
+	ElapsedSeconds int                               `json:"elapsedSeconds"`
+	Hints          int                               `json:"hints"`
+	Solved         bool                              `json:"solved"`
+}
+
+// SudokuGameEntryDTO is a value or pencil marks entered by the player
+// in the cell with provided absolute position
+type SudokuGameEntryDTO struct {
+	SudokuCellPositionDTO
+	Value       *int  `json:"value,omitempty"`
+	PencilMarks []int `json:"pencilMarks,omitempty"`
+}
+
+// SudokuGameActions are operations the game needs from outside of the terminal
+// user interface - solving the puzzle (for hints and checks) and saving the game
+type SudokuGameActions struct {
+	Solve func(puzzle *SudokuDTO) (*SudokuDTO, error)
+	Save  func(game *SudokuGameDTO) error
+}
@@ -0,0 +1,122 @@
+package models
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"unicode"
+)
+
+// SymbolAlphabet maps sudoku values to single character symbols. Value 1 is
+// represented by the first symbol, value 2 by the second one and so on.
+// Internally sudoku values are always integers, alphabet is used only for
+// presentation and input purposes.
+type SymbolAlphabet struct {
+	Specification string
+	Symbols       []rune
+}
+
+// ParseSymbolAlphabet builds symbol alphabet from specification string. The specification
+// is a sequence of single characters and ranges of characters, for example "1-9A-G"
+// (16 symbols), "A-Y" (25 symbols) or a custom list of characters like "!@#$%^&*(".
+// Alphabet must consist of exactly as many unique symbols as there are values for
+// provided box size. Whitespaces, '.', '_' and '-' cannot be used as symbols.
+func ParseSymbolAlphabet(specification string, boxSize int8) (*SymbolAlphabet, error) {
+	characters := []rune(specification)
+	symbols := []rune{}
+
+	for characterIndex := 0; characterIndex < len(characters); characterIndex++ {
+		character := characters[characterIndex]
+
+		if characterIndex+2 < len(characters) && characters[characterIndex+1] == '-' {
+			rangeEnd := characters[characterIndex+2]
+			if rangeEnd < character {
+				return nil, fmt.Errorf("invalid symbols range '%c-%c' in alphabet '%s'",
+					character, rangeEnd, specification)
+			}
+
+			for symbol := character; symbol <= rangeEnd; symbol++ {
+				symbols = append(symbols, symbol)
+			}
+
+			characterIndex += 2
+			continue
+		}
+
+		symbols = append(symbols, character)
+	}
+
+	for symbolIndex, symbol := range symbols {
+		if unicode.IsSpace(symbol) || !unicode.IsGraphic(symbol) || slices.Contains([]rune{'.', '_', '-'}, symbol) {
+			return nil, fmt.Errorf("character '%c' cannot be used as a symbol in alphabet '%s'",
+				symbol, specification)
+		}
+
+		if slices.Contains(symbols[:symbolIndex], symbol) {
+			return nil, fmt.Errorf("symbol '%c' appears more than once in alphabet '%s'",
+				symbol, specification)
+		}
+	}
+
+	expectedSymbolsCount := int(boxSize) * int(boxSize)
+	if len(symbols) != expectedSymbolsCount {
+		return nil, fmt.Errorf("alphabet '%s' has %d symbols, but box size %d requires %d symbols",
+			specification, len(symbols), boxSize, expectedSymbolsCount)
+	}
+
+	return &SymbolAlphabet{
+		Specification: specification,
+		Symbols:       symbols,
+	}, nil
+}
+
+// GetSymbol returns symbol representing provided value. In case of nil
+// alphabet (no alphabet) or value outside of alphabet, the value
+// is returned as decimal number.
+func (alphabet *SymbolAlphabet) GetSymbol(value int) string {
+	if alphabet == nil || value < 1 || value > len(alphabet.Symbols) {
+		return strconv.Itoa(value)
+	}
+
+	return string(alphabet.Symbols[value-1])
+}
+
+// GetValue returns value represented by provided symbol and flag
+// indicating if the symbol is a part of the alphabet
+func (alphabet *SymbolAlphabet) GetValue(symbol rune) (int, bool) {
+	if alphabet == nil {
+		return 0, false
+	}
+
+	symbolIndex := slices.Index(alphabet.Symbols, symbol)
+	if symbolIndex < 0 {
+		return 0, false
+	}
+
+	return symbolIndex + 1, true
+}
+
+// GetSymbolLength returns amount of characters required to present
+// any value up to provided maximum value
+func (alphabet *SymbolAlphabet) GetSymbolLength(maximumValue int) int {
+	if alphabet == nil {
+		return len(strconv.Itoa(maximumValue))
+	}
+
+	return 1
+}
+
+// GetSymbolAlphabet returns symbol alphabet of the sudoku. Returns nil
+// if sudoku has no alphabet or the alphabet is invalid.
+func (sudoku *Sudoku) GetSymbolAlphabet() *SymbolAlphabet {
+	if len(sudoku.Alphabet) < 1 {
+		return nil
+	}
+
+	alphabet, err := ParseSymbolAlphabet(sudoku.Alphabet, sudoku.BoxSize)
+	if err != nil {
+		return nil
+	}
+
+	return alphabet
+}
@@ -8,7 +8,8 @@ type styles struct {
 	SuccessStyle lipgloss.Style
 	ErrorStyle   lipgloss.Style
 	BorderStyle  lipgloss.Style
-	DebugStyle   lipgloss.Style
+	// SelectionStyle marks selected cells of the sudoku editor
+	SelectionStyle lipgloss.Style
 }
 
 var TerminalStyles = styles{
@@ -17,5 +18,6 @@ var TerminalStyles = styles{
 	SuccessStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#33ff33")),
 	ErrorStyle:   lipgloss.NewStyle().Foreground(lipgloss.Color("#ff3333")),
 	BorderStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("#444444")),
-	DebugStyle:   lipgloss.NewStyle().Foreground(lipgloss.Color("#ffd700")),
+	SelectionStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("#eeeeee")).
+		Background(lipgloss.Color("#3a3a7a")),
 }
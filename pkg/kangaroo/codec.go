@@ -0,0 +1,29 @@
+package kangaroo
+
+import (
+	"github.com/Michu8258/kangaroo/internal/library"
+)
+
+// Format is a format of sudoku data read by Parse
+type Format = library.Format
+
+const (
+	// FormatAuto detects format of the data: JSON, base64 or text (in this order)
+	FormatAuto = library.FormatAuto
+	// FormatJSON is the format of sudoku files of the CLI
+	FormatJSON = library.FormatJSON
+	// FormatBase64 is standard or URL-safe base64 representation of binary data format
+	FormatBase64 = library.FormatBase64
+	// FormatText is a grid of values or a compact string of values of classic sudoku,
+	// or a sudoku drawing as saved to TXT files
+	FormatText = library.FormatText
+)
+
+// Encoding specifies representation of binary data format
+type Encoding = library.Encoding
+
+const (
+	EncodingBase64    = library.EncodingBase64
+	EncodingBase64URL = library.EncodingBase64URL
+	EncodingRaw       = library.EncodingRaw
+)
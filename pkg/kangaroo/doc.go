@@ -0,0 +1,18 @@
+// Package kangaroo is an embeddable sudoku library. It parses, validates, solves,
+// generates, encodes and decodes sudoku puzzles (including sudokus with multiple
+// overlapping sub-sudokus and variant constraints) without any terminal side effects.
+//
+// Functions of the package use a default Kangaroo instance. Use New with options
+// to customize limits of accepted sudokus or logging of the library:
+//
+//	sudoku, err := kangaroo.Parse([]byte("53..7....6..195....98....6.8...6...34..8.3..17...2...6.6....28....419..5....8..79"))
+//	if err != nil {
+//		return err
+//	}
+//
+//	solution, err := kangaroo.Solve(ctx, sudoku)
+//
+// Errors returned by the package can be checked with errors.Is against ErrInvalidInput,
+// ErrInvalidConfiguration, ErrUnsolvable, ErrMultipleSolutions and ErrSolverFailure.
+// Details are available with errors.As and *ValidationError or *SolutionError.
+package kangaroo
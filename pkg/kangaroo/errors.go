@@ -0,0 +1,27 @@
+package kangaroo
+
+import (
+	"github.com/Michu8258/kangaroo/internal/library"
+)
+
+// Kinds of errors returned by the library
+var (
+	// ErrInvalidInput is returned for data that can not be parsed or decoded
+	ErrInvalidInput = library.ErrInvalidInput
+	// ErrInvalidConfiguration is returned for sudokus violating sudoku rules
+	ErrInvalidConfiguration = library.ErrInvalidConfiguration
+	// ErrUnsolvable is returned for sudokus without a solution
+	ErrUnsolvable = library.ErrUnsolvable
+	// ErrMultipleSolutions is returned for sudokus with more than one solution,
+	// when unique solution is required
+	ErrMultipleSolutions = library.ErrMultipleSolutions
+	// ErrSolverFailure is returned when the solver fails to solve the sudoku
+	ErrSolverFailure = library.ErrSolverFailure
+)
+
+// ValidationError lists violations of sudoku rules. It matches ErrInvalidConfiguration.
+type ValidationError = library.ValidationError
+
+// SolutionError describes failed sudoku solution. It matches ErrUnsolvable,
+// ErrMultipleSolutions or ErrSolverFailure, depending on the reason of the failure.
+type SolutionError = library.SolutionError
@@ -0,0 +1,90 @@
+package kangaroo_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Michu8258/kangaroo/pkg/kangaroo"
+)
+
+func Example() {
+	sudoku, err := kangaroo.Parse([]byte(
+		".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	solution, err := kangaroo.Solve(context.Background(), sudoku)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, cell := range solution.Boxes[0].Cells {
+		fmt.Print(*cell.Value)
+	}
+	fmt.Println()
+	// Output: 265389417
+}
+
+func ExampleNew() {
+	solver, err := kangaroo.New(kangaroo.WithMaximumBoxSize(4), kangaroo.WithMaximumLayoutSize(4))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	sudoku, err := solver.Parse([]byte("A . . .\n. . C .\n. D . .\n. . . B"), kangaroo.WithAlphabet("A-D"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	solution, err := solver.Solve(context.Background(), sudoku)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	for _, box := range solution.Boxes {
+		for _, cell := range box.Cells {
+			fmt.Print(cell.Symbol)
+		}
+	}
+	fmt.Println()
+	// Output: ACDBBDCABDCAACDB
+}
+
+func ExampleGenerate() {
+	puzzle, _, err := kangaroo.Generate(context.Background(),
+		kangaroo.WithBoxSize(2), kangaroo.WithLayout(2, 2), kangaroo.WithSeed(42))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	encoded, err := kangaroo.Encode(puzzle, kangaroo.EncodingBase64URL)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	decoded, err := kangaroo.Decode(encoded, kangaroo.EncodingBase64URL)
+	fmt.Println(decoded.BoxSize, decoded.Layout.Width, decoded.Layout.Height, err)
+	// Output: 2 2 2 <nil>
+}
+
+func ExampleValidationError() {
+	sudoku, err := kangaroo.Parse([]byte("11..............")) // two values 1 in the first row
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	err = kangaroo.Validate(sudoku)
+	validationError := &kangaroo.ValidationError{}
+	fmt.Println(errors.Is(err, kangaroo.ErrInvalidConfiguration), errors.As(err, &validationError))
+	// Output: true true
+}
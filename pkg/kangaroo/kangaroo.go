@@ -0,0 +1,183 @@
+package kangaroo
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Sudoku is a sudoku puzzle, the same as stored in JSON files of the CLI
+type Sudoku = models.SudokuDTO
+
+// Kangaroo provides sudoku operations with its own settings.
+// It is safe for concurrent use.
+type Kangaroo struct {
+	library *library.Library
+	options []library.Option
+}
+
+// Option configures Kangaroo instance created with New
+type Option func(kangaroo *Kangaroo)
+
+// ParseOption configures Parse function
+type ParseOption = library.ParseOption
+
+// SolveOption configures Solve function
+type SolveOption = library.SolveOption
+
+// GenerateOption configures Generate function
+type GenerateOption = library.GenerateOption
+
+// default instance used by functions of the package
+var defaultKangaroo, _ = New()
+
+// New creates Kangaroo instance configured with provided options.
+// Returns an error if any of the options is invalid.
+func New(options ...Option) (*Kangaroo, error) {
+	kangaroo := &Kangaroo{}
+	for _, option := range options {
+		option(kangaroo)
+	}
+
+	instance, err := library.New(kangaroo.options...)
+	if err != nil {
+		return nil, err
+	}
+
+	kangaroo.library = instance
+	kangaroo.options = nil
+	return kangaroo, nil
+}
+
+// WithMaximumBoxSize changes maximum accepted box size (up to models.SupportedMaximumBoxSize)
+func WithMaximumBoxSize(size int) Option {
+	return func(kangaroo *Kangaroo) {
+		kangaroo.options = append(kangaroo.options, library.WithMaximumBoxSize(size))
+	}
+}
+
+// WithMaximumLayoutSize changes maximum accepted layout width and height
+// (up to models.SupportedMaximumLayoutSize)
+func WithMaximumLayoutSize(size int) Option {
+	return func(kangaroo *Kangaroo) {
+		kangaroo.options = append(kangaroo.options, library.WithMaximumLayoutSize(size))
+	}
+}
+
+// WithDebugOutput makes the solver and sudoku initialization write their logs
+// to provided writer
+func WithDebugOutput(writer io.Writer) Option {
+	return func(kangaroo *Kangaroo) {
+		kangaroo.options = append(kangaroo.options, library.WithDebugOutput(writer))
+	}
+}
+
+// WithLogger makes the solver and sudoku initialization write their logs with
+// provided structured logger. It takes precedence over WithDebugOutput option.
+func WithLogger(logger *slog.Logger) Option {
+	return func(kangaroo *Kangaroo) {
+		kangaroo.options = append(kangaroo.options, library.WithLogger(logger))
+	}
+}
+
+// WithFormat disables format detection and parses the data in provided format
+func WithFormat(format Format) ParseOption {
+	return library.WithFormat(format)
+}
+
+// WithAlphabet specifies symbols used to present values in text format, e.g. 1-9A-G
+func WithAlphabet(alphabet string) ParseOption {
+	return library.WithAlphabet(alphabet)
+}
+
+// RequireUniqueSolution makes Solve fail with ErrMultipleSolutions if the sudoku
+// has more than one solution
+func RequireUniqueSolution() SolveOption {
+	return library.RequireUniqueSolution()
+}
+
+// WithBoxSize sets box size of generated sudoku
+func WithBoxSize(boxSize int8) GenerateOption {
+	return library.WithBoxSize(boxSize)
+}
+
+// WithLayout sets layout width and height (amount of boxes) of generated sudoku
+func WithLayout(width int8, height int8) GenerateOption {
+	return library.WithLayout(width, height)
+}
+
+// WithSeed sets seed of the generator, the same seed results in the same sudoku
+func WithSeed(seed int64) GenerateOption {
+	return library.WithSeed(seed)
+}
+
+// Parse reads sudoku from JSON, base64 encoded binary data or text. Format of
+// the data is detected, unless it is specified with WithFormat option.
+func (kangaroo *Kangaroo) Parse(data []byte, options ...ParseOption) (*Sudoku, error) {
+	return kangaroo.library.Parse(data, options...)
+}
+
+// Validate checks sudoku configuration and values against sudoku rules.
+// Returns *ValidationError if the sudoku violates any rule.
+func (kangaroo *Kangaroo) Validate(sudoku *Sudoku) error {
+	return kangaroo.library.Validate(sudoku)
+}
+
+// Solve solves the sudoku and returns solved copy of it. The solver is stopped
+// when the context is done and the context error is returned.
+// Returns *ValidationError for invalid sudokus and *SolutionError if the sudoku
+// could not be solved.
+func (kangaroo *Kangaroo) Solve(ctx context.Context, sudoku *Sudoku, options ...SolveOption) (*Sudoku, error) {
+	return kangaroo.library.Solve(ctx, sudoku, options...)
+}
+
+// Generate generates sudoku puzzle with unique solution. Box size 3 and layout
+// of 3x3 boxes are used if they are not provided with options, and current
+// time is used as a seed if it is not provided.
+func (kangaroo *Kangaroo) Generate(ctx context.Context, options ...GenerateOption) (
+	puzzle *Sudoku, solution *Sudoku, err error) {
+	return kangaroo.library.Generate(ctx, options...)
+}
+
+// Encode converts the sudoku to binary data format with provided encoding
+func (kangaroo *Kangaroo) Encode(sudoku *Sudoku, encoding Encoding) ([]byte, error) {
+	return kangaroo.library.Encode(sudoku, encoding)
+}
+
+// Decode reads the sudoku from binary data format with provided encoding
+func (kangaroo *Kangaroo) Decode(data []byte, encoding Encoding) (*Sudoku, error) {
+	return kangaroo.library.Decode(data, encoding)
+}
+
+// Parse reads sudoku from JSON, base64 encoded binary data or text with default instance
+func Parse(data []byte, options ...ParseOption) (*Sudoku, error) {
+	return defaultKangaroo.Parse(data, options...)
+}
+
+// Validate checks the sudoku against sudoku rules with default instance
+func Validate(sudoku *Sudoku) error {
+	return defaultKangaroo.Validate(sudoku)
+}
+
+// Solve solves the sudoku with default instance
+func Solve(ctx context.Context, sudoku *Sudoku, options ...SolveOption) (*Sudoku, error) {
+	return defaultKangaroo.Solve(ctx, sudoku, options...)
+}
+
+// Generate generates sudoku puzzle with unique solution with default instance
+func Generate(ctx context.Context, options ...GenerateOption) (puzzle *Sudoku, solution *Sudoku, err error) {
+	return defaultKangaroo.Generate(ctx, options...)
+}
+
+// Encode converts the sudoku to binary data format with default instance
+func Encode(sudoku *Sudoku, encoding Encoding) ([]byte, error) {
+	return defaultKangaroo.Encode(sudoku, encoding)
+}
+
+// Decode reads the sudoku from binary data format with default instance
+func Decode(data []byte, encoding Encoding) (*Sudoku, error) {
+	return defaultKangaroo.Decode(data, encoding)
+}
@@ -0,0 +1,42 @@
+package kangaroo
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNew_InvalidOptions(t *testing.T) {
+	_, err := New(WithMaximumBoxSize(100))
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected invalid input error, got %v", err)
+	}
+
+	_, err = New(WithMaximumLayoutSize(100))
+	if !errors.Is(err, ErrInvalidInput) {
+		t.Errorf("Expected invalid input error, got %v", err)
+	}
+}
+
+func TestKangaroo_Limits(t *testing.T) {
+	kangaroo, err := New(WithMaximumBoxSize(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudoku, err := Parse([]byte(".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = kangaroo.Solve(context.Background(), sudoku)
+	validationError := &ValidationError{}
+	if !errors.Is(err, ErrInvalidConfiguration) || !errors.As(err, &validationError) {
+		t.Errorf("Expected validation error of too big box size, got %v", err)
+	}
+
+	_, err = Solve(context.Background(), sudoku)
+	if err != nil {
+		t.Errorf("Expected sudoku solved with default instance, got %v", err)
+	}
+}
@@ -0,0 +1,328 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// handleHealth reports that the server is running
+func (server *Server) handleHealth(writer http.ResponseWriter, request *http.Request) {
+	writeResponse(writer, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// handleSolve solves sudoku provided as JSON DTO or base64 encoded binary data
+func (server *Server) handleSolve(writer http.ResponseWriter, request *http.Request) {
+	response := newResponse()
+
+	sudoku, ok := server.readSudoku(writer, request, response)
+	if !ok {
+		return
+	}
+
+	err := server.Library.SolveInitialized(request.Context(), sudoku)
+	response.Result = sudoku.Result
+	if err != nil {
+		writeError(writer, response, getSolutionError(err))
+		return
+	}
+
+	response.Sudoku = sudoku.ToSudokuDto()
+	encodedSudoku, err := server.Library.Encode(response.Sudoku, library.EncodingBase64)
+	if err == nil {
+		response.EncodedSudoku = string(encodedSudoku)
+	}
+
+	writeResponse(writer, http.StatusOK, response)
+}
+
+// handleValidate checks sudoku configuration and values against sudoku rules and
+// counts its solutions (up to 2, which means there are at least 2 solutions).
+// Valid means that the sudoku follows the rules, it can still have no solution.
+func (server *Server) handleValidate(writer http.ResponseWriter, request *http.Request) {
+	response := newResponse()
+
+	sudokuDto, err := server.readSudokuDto(request)
+	if err != nil {
+		writeError(writer, response, err)
+		return
+	}
+
+	valid := false
+	response.Valid = &valid
+
+	sudoku, err := server.Library.Initialize(sudokuDto)
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		response.ValidationErrors = toMessages(validationError.Errors)
+		writeResponse(writer, http.StatusOK, response)
+		return
+	}
+
+	if err != nil {
+		writeError(writer, response, err)
+		return
+	}
+
+	solutionsCount, err := server.Library.CountSolutions(request.Context(), sudoku)
+	if err != nil {
+		writeError(writer, response, err)
+		return
+	}
+
+	valid = true
+	response.SolutionsCount = &solutionsCount
+	writeResponse(writer, http.StatusOK, response)
+}
+
+// handleHint solves the sudoku and returns value of the empty cell with the lowest
+// amount of possible values
+func (server *Server) handleHint(writer http.ResponseWriter, request *http.Request) {
+	response := newResponse()
+
+	sudoku, ok := server.readSudoku(writer, request, response)
+	if !ok {
+		return
+	}
+
+	hintCell := findHintCell(sudoku)
+	if hintCell == nil {
+		writeError(writer, response, models.WithKind(models.ErrInvalidInput,
+			errors.New("sudoku has no empty cells")))
+		return
+	}
+
+	err := server.Library.SolveInitialized(request.Context(), sudoku)
+	response.Result = sudoku.Result
+	if err != nil {
+		writeError(writer, response, getSolutionError(err))
+		return
+	}
+
+	response.Hint = &models.SudokuHintDTO{
+		Row:    int(hintCell.Box.IndexRow)*int(sudoku.BoxSize) + int(hintCell.IndexRowInBox),
+		Column: int(hintCell.Box.IndexColumn)*int(sudoku.BoxSize) + int(hintCell.IndexColumnInBox),
+		Value:  *hintCell.Value,
+	}
+
+	if len(sudoku.Alphabet) > 0 {
+		response.Hint.Symbol = sudoku.GetSymbolAlphabet().GetSymbol(*hintCell.Value)
+	}
+
+	writeResponse(writer, http.StatusOK, response)
+}
+
+// handleGenerate generates sudoku puzzle with unique solution
+func (server *Server) handleGenerate(writer http.ResponseWriter, request *http.Request) {
+	response := newResponse()
+
+	body, err := server.readBody(request)
+	if err != nil {
+		writeError(writer, response, err)
+		return
+	}
+
+	requestDto := &models.GenerateSudokuRequestDTO{}
+	if len(bytes.TrimSpace(body)) > 0 {
+		err = json.Unmarshal(body, requestDto)
+		if err != nil {
+			writeError(writer, response, models.WithKind(models.ErrInvalidInput,
+				fmt.Errorf("failed to parse generation request: %s", err)))
+			return
+		}
+	}
+
+	generateRequest := server.buildGenerateRequest(requestDto)
+	puzzle, solution, err := server.ServiceCollection.Generator.Generate(request.Context(), generateRequest)
+	if err != nil {
+		writeError(writer, response, err)
+		return
+	}
+
+	response.Sudoku = puzzle
+	response.Solution = solution
+	response.Seed = &generateRequest.Seed
+	encodedSudoku, err := server.ServiceCollection.SudokuEncoder.ToBase64(puzzle)
+	if err == nil {
+		response.EncodedSudoku = encodedSudoku
+	}
+
+	writeResponse(writer, http.StatusOK, response)
+}
+
+// readSudoku reads sudoku from request body and initializes it with the library. Writes error
+// response and returns false if the sudoku is not valid.
+func (server *Server) readSudoku(writer http.ResponseWriter, request *http.Request,
+	response *models.ServerResponseDTO) (*models.Sudoku, bool) {
+
+	sudokuDto, err := server.readSudokuDto(request)
+	if err != nil {
+		writeError(writer, response, err)
+		return nil, false
+	}
+
+	sudoku, err := server.Library.Initialize(sudokuDto)
+	validationError := &library.ValidationError{}
+	if errors.As(err, &validationError) {
+		response.ValidationErrors = toMessages(validationError.Errors)
+		writeResponse(writer, http.StatusUnprocessableEntity, response)
+		return nil, false
+	}
+
+	if err != nil {
+		writeError(writer, response, err)
+		return nil, false
+	}
+
+	return sudoku, true
+}
+
+// readSudokuDto parses request body as sudoku JSON DTO if it starts with '{',
+// otherwise as base64 (standard or URL-safe) encoded binary data
+func (server *Server) readSudokuDto(request *http.Request) (*models.SudokuDTO, error) {
+	body, err := server.readBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	body = bytes.TrimSpace(body)
+	if len(body) < 1 {
+		return nil, models.WithKind(models.ErrInvalidInput, errors.New("request body is empty"))
+	}
+
+	if body[0] == '{' {
+		return server.Library.Parse(body, library.WithFormat(library.FormatJSON))
+	}
+
+	return server.Library.Parse(body, library.WithFormat(library.FormatBase64))
+}
+
+// readBody reads request body respecting maximum request size
+func (server *Server) readBody(request *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(http.MaxBytesReader(nil, request.Body, server.Options.MaximumRequestSize))
+	if err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput, err)
+	}
+
+	return body, nil
+}
+
+// buildGenerateRequest fills missing sizes of generation request with default sizes.
+// Current time is used as a seed if it is not provided.
+func (server *Server) buildGenerateRequest(requestDto *models.GenerateSudokuRequestDTO) *models.GenerateSudokuRequest {
+	generateRequest := &models.GenerateSudokuRequest{
+		BoxSize:      requestDto.BoxSize,
+		LayoutWidth:  requestDto.Layout.Width,
+		LayoutHeight: requestDto.Layout.Height,
+		Seed:         time.Now().UnixNano(),
+	}
+
+	if generateRequest.BoxSize == 0 {
+		generateRequest.BoxSize = server.Settings.DefaultBoxSize
+	}
+
+	if generateRequest.LayoutWidth == 0 {
+		generateRequest.LayoutWidth = server.Settings.DefaultLayoutSize
+	}
+
+	if generateRequest.LayoutHeight == 0 {
+		generateRequest.LayoutHeight = server.Settings.DefaultLayoutSize
+	}
+
+	if requestDto.Seed != nil {
+		generateRequest.Seed = *requestDto.Seed
+	}
+
+	return generateRequest
+}
+
+// getSolutionError returns an error of failed sudoku solution, described with errors
+// reported by the solver or the context error if the solution was stopped
+func getSolutionError(err error) error {
+	if errors.Is(err, models.ErrUnsolvable) {
+		return models.WithKind(models.ErrUnsolvable, errors.New("the sudoku has no solution"))
+	}
+
+	solutionError := &library.SolutionError{}
+	if errors.As(err, &solutionError) {
+		if len(solutionError.Errors) < 1 {
+			return models.WithKind(models.ErrSolverFailure, errors.New("failed to solve the sudoku"))
+		}
+
+		err = errors.Join(solutionError.Errors...)
+	}
+
+	return models.WithKind(models.ErrSolverFailure, fmt.Errorf("failed to solve the sudoku: %w", err))
+}
+
+// findHintCell returns the empty cell with the lowest amount of values not used
+// in its box and lines, or nil if there is no empty cell
+func findHintCell(sudoku *models.Sudoku) *models.SudokuCell {
+	var hintCell *models.SudokuCell
+	hintCellValuesCount := 0
+
+	for _, box := range sudoku.Boxes {
+		if box.Disabled {
+			continue
+		}
+
+		for _, cell := range box.Cells {
+			if cell.Value != nil {
+				continue
+			}
+
+			valuesCount := countPossibleValues(sudoku, cell)
+			if hintCell == nil || valuesCount < hintCellValuesCount {
+				hintCell = cell
+				hintCellValuesCount = valuesCount
+			}
+		}
+	}
+
+	return hintCell
+}
+
+// countPossibleValues counts values not used in the box and lines of the cell
+func countPossibleValues(sudoku *models.Sudoku, cell *models.SudokuCell) int {
+	maximumValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
+	usedValues := make([]bool, maximumValue+1)
+
+	markValues := func(cells []*models.SudokuCell) {
+		for _, otherCell := range cells {
+			if otherCell.Value != nil && *otherCell.Value >= 1 && *otherCell.Value <= maximumValue {
+				usedValues[*otherCell.Value] = true
+			}
+		}
+	}
+
+	markValues(cell.Box.Cells)
+	for _, line := range cell.MemberOfLines {
+		markValues(line.Cells)
+	}
+
+	count := 0
+	for value := 1; value <= maximumValue; value++ {
+		if !usedValues[value] {
+			count++
+		}
+	}
+
+	return count
+}
+
+// toMessages converts errors to their messages
+func toMessages(errs []error) []string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+
+	return messages
+}
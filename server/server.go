@@ -0,0 +1,105 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/internal/serverCommon"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+)
+
+type Server struct {
+	Settings          *models.Settings
+	ServiceCollection *services.ServiceCollection
+	Library           *library.Library
+	Options           serverCommon.Options
+}
+
+// body of the response sent when processing of a request exceeds the timeout
+const timeoutResponse = `{"result":"unspecified","validationErrors":[],"errors":["request processing timed out"]}`
+
+func NewServer(settings *models.Settings, serviceCollection *services.ServiceCollection,
+	sudokuLibrary *library.Library, options serverCommon.Options) *Server {
+	return &Server{
+		Settings:          settings,
+		ServiceCollection: serviceCollection,
+		Library:           sudokuLibrary,
+		Options:           options,
+	}
+}
+
+// Handler returns HTTP handler with all endpoints of the server. Context of
+// a request is cancelled when the request timeout is exceeded.
+func (server *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", server.handleHealth)
+	mux.HandleFunc("POST /solve", server.limitSolves(server.handleSolve))
+	mux.HandleFunc("POST /validate", server.limitSolves(server.handleValidate))
+	mux.HandleFunc("POST /hint", server.limitSolves(server.handleHint))
+	mux.HandleFunc("POST /generate", server.limitSolves(server.handleGenerate))
+
+	return jsonTimeoutHandler(mux, server.Options.RequestTimeout)
+}
+
+// jsonTimeoutHandler limits processing time of a request to the timeout, like
+// http.TimeoutHandler, but timeout response is sent with JSON content type.
+// Headers set by the handler replace the default content type.
+func jsonTimeoutHandler(handler http.Handler, timeout time.Duration) http.Handler {
+	timeoutHandler := http.TimeoutHandler(handler, timeout, timeoutResponse)
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		timeoutHandler.ServeHTTP(writer, request)
+	})
+}
+
+// limitSolves allows only amount of requests solving sudokus limited by the library to be
+// processed at the same time. Request waiting for its turn fails when its context is done.
+func (server *Server) limitSolves(handler http.HandlerFunc) http.HandlerFunc {
+	return func(writer http.ResponseWriter, request *http.Request) {
+		release, err := server.Library.AcquireSolve(request.Context())
+		if err != nil {
+			writeError(writer, newResponse(), models.WithKind(models.ErrSolverFailure, err))
+			return
+		}
+		defer release()
+
+		handler(writer, request)
+	}
+}
+
+// newResponse creates response object with empty errors lists
+func newResponse() *models.ServerResponseDTO {
+	return &models.ServerResponseDTO{
+		Result:           models.Unspecified,
+		ValidationErrors: []string{},
+		Errors:           []string{},
+	}
+}
+
+// writeResponse writes the response object as JSON with provided status code
+func writeResponse(writer http.ResponseWriter, statusCode int, response any) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	json.NewEncoder(writer).Encode(response)
+}
+
+// writeError adds the error to the response and writes it with status code
+// matching kind of the error
+func writeError(writer http.ResponseWriter, response *models.ServerResponseDTO, err error) {
+	response.Errors = append(response.Errors, err.Error())
+	writeResponse(writer, getStatusCode(err), response)
+}
+
+// getStatusCode returns HTTP status code for the error
+func getStatusCode(err error) int {
+	maxBytesError := &http.MaxBytesError{}
+	if errors.As(err, &maxBytesError) {
+		return http.StatusRequestEntityTooLarge
+	}
+
+	return serverCommon.GetErrorKindStatus(err).HttpStatusCode
+}
@@ -0,0 +1,351 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Michu8258/kangaroo/internal/library"
+	"github.com/Michu8258/kangaroo/internal/serverCommon"
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	crook "github.com/Michu8258/kangaroo/services/crookMethodSolver"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuGenerator"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestServer(t *testing.T) {
+	simpleSudoku := readTestFile(t, "../testConfigs/simple1.json")
+	// two values 6 in the first box
+	invalidSudoku := "AAEDAwP/gAYGAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+	// no value can be put in the third cell of the first row
+	unsolvableSudoku := readTestSudoku(t, "12.....3..4.....")
+	base64Sudoku := "AAEDAwP/gAYAAAABAAAABwAAAwIABQAAAAQAAAAHAAAAAQAAAAkAAAAEAAEIAAAAAAYABwUAAAAIAAAAAAAABgAIAAIAAAAAAAADAAUGAAAAAwACAAcAAA=="
+
+	testCases := []struct {
+		name               string
+		method             string
+		path               string
+		body               string
+		expectedStatusCode int
+		expectedContent    []string
+	}{
+		{
+			name:               "Health",
+			method:             http.MethodGet,
+			path:               "/health",
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"status":"ok"`},
+		},
+		{
+			name:               "Solve JSON sudoku",
+			method:             http.MethodPost,
+			path:               "/solve",
+			body:               simpleSudoku,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"result":"success"`, `"sudoku":`, `"encodedSudoku":`},
+		},
+		{
+			name:               "Solve base64 sudoku",
+			method:             http.MethodPost,
+			path:               "/solve",
+			body:               base64Sudoku + "\n",
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"result":"success"`},
+		},
+		{
+			name:               "Solve invalid data",
+			method:             http.MethodPost,
+			path:               "/solve",
+			body:               "{not a json",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedContent:    []string{`"result":"unspecified"`, "failed to parse sudoku JSON data"},
+		},
+		{
+			name:               "Solve empty body",
+			method:             http.MethodPost,
+			path:               "/solve",
+			expectedStatusCode: http.StatusBadRequest,
+			expectedContent:    []string{"request body is empty"},
+		},
+		{
+			name:               "Solve invalid sudoku",
+			method:             http.MethodPost,
+			path:               "/solve",
+			body:               invalidSudoku,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedContent:    []string{`"validationErrors":["`},
+		},
+		{
+			name:               "Request too large",
+			method:             http.MethodPost,
+			path:               "/solve",
+			body:               strings.Repeat(" ", 20000) + simpleSudoku,
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:               "Wrong method",
+			method:             http.MethodGet,
+			path:               "/solve",
+			expectedStatusCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:               "Validate valid sudoku",
+			method:             http.MethodPost,
+			path:               "/validate",
+			body:               simpleSudoku,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"valid":true`, `"solutionsCount":1`},
+		},
+		{
+			name:               "Validate invalid sudoku",
+			method:             http.MethodPost,
+			path:               "/validate",
+			body:               invalidSudoku,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"valid":false`, `"validationErrors":["`},
+		},
+		{
+			name:               "Validate unsolvable sudoku",
+			method:             http.MethodPost,
+			path:               "/validate",
+			body:               unsolvableSudoku,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"valid":true`, `"solutionsCount":0`, `"validationErrors":[]`},
+		},
+		{
+			name:               "Hint",
+			method:             http.MethodPost,
+			path:               "/hint",
+			body:               simpleSudoku,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"result":"success"`, `"hint":{"row":`},
+		},
+		{
+			name:               "Generate",
+			method:             http.MethodPost,
+			path:               "/generate",
+			body:               `{"boxSize":2,"layout":{"width":2,"height":2},"seed":5}`,
+			expectedStatusCode: http.StatusOK,
+			expectedContent:    []string{`"sudoku":`, `"solution":`, `"seed":5`},
+		},
+		{
+			name:               "Generate invalid layout",
+			method:             http.MethodPost,
+			path:               "/generate",
+			body:               `{"boxSize":3,"layout":{"width":2,"height":2}}`,
+			expectedStatusCode: http.StatusUnprocessableEntity,
+			expectedContent:    []string{"sub-sudoku"},
+		},
+	}
+
+	testServer := httptest.NewServer(getTestServer(time.Minute).Handler())
+	defer testServer.Close()
+
+	for _, testCase := range testCases {
+		request, err := http.NewRequest(testCase.method, testServer.URL+testCase.path,
+			strings.NewReader(testCase.body))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		response, err := http.DefaultClient.Do(request)
+		if err != nil {
+			t.Errorf("%s: Request failed: %s", testCase.name, err)
+			continue
+		}
+
+		body, _ := io.ReadAll(response.Body)
+		response.Body.Close()
+
+		if response.StatusCode != testCase.expectedStatusCode {
+			t.Errorf("%s: Expected status code %d, got %d (%s)",
+				testCase.name, testCase.expectedStatusCode, response.StatusCode, body)
+		}
+
+		contentType := response.Header.Get("Content-Type")
+		if len(testCase.expectedContent) > 0 && contentType != "application/json" {
+			t.Errorf("%s: Expected JSON content type, got '%s'", testCase.name, contentType)
+		}
+
+		for _, expectedContent := range testCase.expectedContent {
+			if !strings.Contains(string(body), expectedContent) {
+				t.Errorf("%s: Response '%s' does not contain '%s'", testCase.name, body, expectedContent)
+			}
+		}
+	}
+}
+
+func TestServer_Hint(t *testing.T) {
+	solution := &models.SudokuDTO{}
+	err := json.Unmarshal([]byte(readTestFile(t, "../testConfigs/simple1_solution.json")), solution)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/hint",
+		strings.NewReader(readTestFile(t, "../testConfigs/simple1.json")))
+	getTestServer(time.Minute).Handler().ServeHTTP(recorder, request)
+
+	response := &models.ServerResponseDTO{}
+	err = json.Unmarshal(recorder.Body.Bytes(), response)
+	if err != nil || response.Hint == nil {
+		t.Fatalf("Expected response with a hint, got '%s'", recorder.Body.String())
+	}
+
+	solutionSudoku := solution.ToSudoku()
+	_, cell := solutionSudoku.GetCellByPosition(models.SudokuCellPosition{
+		Row:    response.Hint.Row,
+		Column: response.Hint.Column,
+	})
+
+	if cell == nil || cell.Value == nil || *cell.Value != response.Hint.Value {
+		t.Errorf("Hint %+v does not match the solution", response.Hint)
+	}
+}
+
+func TestServer_Timeout(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/generate",
+		strings.NewReader(`{"boxSize":5,"layout":{"width":5,"height":5},"seed":1}`))
+	getTestServer(time.Millisecond).Handler().ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code %d, got %d", http.StatusServiceUnavailable, recorder.Code)
+	}
+
+	if contentType := recorder.Header().Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("Expected JSON content type of timeout response, got '%s'", contentType)
+	}
+
+	response := &models.ServerResponseDTO{}
+	err := json.Unmarshal(recorder.Body.Bytes(), response)
+	if err != nil || len(response.Errors) != 1 {
+		t.Errorf("Expected JSON timeout response, got '%s'", recorder.Body.String())
+	}
+}
+
+func TestServer_SolveTimeout(t *testing.T) {
+	solver := &testHelpers.TestSolver{WaitForContext: true, Stopped: make(chan struct{}, 1)}
+
+	testServer := httptest.NewServer(getTestServerWithSolver(10*time.Millisecond, solver).Handler())
+	defer testServer.Close()
+
+	response, err := http.Post(testServer.URL+"/solve", "application/json",
+		strings.NewReader(readTestFile(t, "../testConfigs/simple1.json")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response.Body.Close()
+
+	if response.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code %d, got %d", http.StatusServiceUnavailable, response.StatusCode)
+	}
+
+	if contentType := response.Header.Get("Content-Type"); contentType != "application/json" {
+		t.Errorf("Expected JSON content type of timeout response, got '%s'", contentType)
+	}
+
+	select {
+	case <-solver.Stopped:
+	case <-time.After(time.Second):
+		t.Error("Solver is still working after the request timed out")
+	}
+}
+
+func TestServer_ConcurrentSolvesLimit(t *testing.T) {
+	solver := &testHelpers.TestSolver{WaitForContext: true, Stopped: make(chan struct{}, 1)}
+	server := getTestServerWithSolver(10*time.Millisecond, solver)
+
+	// the only solve slot is taken, so the request times out before reaching the solver
+	release, err := server.Library.AcquireSolve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/solve",
+		strings.NewReader(readTestFile(t, "../testConfigs/simple1.json")))
+	server.Handler().ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("Expected status code %d, got %d", http.StatusServiceUnavailable, recorder.Code)
+	}
+
+	select {
+	case <-solver.Stopped:
+		t.Error("Solver was started above the limit of concurrent solves")
+	default:
+	}
+
+	release()
+	recorder = httptest.NewRecorder()
+	request = httptest.NewRequest(http.MethodPost, "/solve",
+		strings.NewReader(readTestFile(t, "../testConfigs/simple1.json")))
+	server.Handler().ServeHTTP(recorder, request)
+
+	select {
+	case <-solver.Stopped:
+	case <-time.After(time.Second):
+		t.Error("Solver was not started after the solve slot was released")
+	}
+}
+
+func getTestServer(timeout time.Duration) *Server {
+	settings := testHelpers.GetTestSettings()
+	return getTestServerWithSolver(timeout, crook.GetNewSudokuSolver(settings, logger.NewDiscardLogger()))
+}
+
+func getTestServerWithSolver(timeout time.Duration, solver library.Solver) *Server {
+	settings := testHelpers.GetTestSettings()
+	sudokuInitializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
+	serviceCollection := &services.ServiceCollection{
+		SudokuInit:    sudokuInitializer,
+		Solver:        solver,
+		SudokuEncoder: binarySudokuManager.GetNewBinarySudokuManager(settings),
+		Generator:     sudokuGenerator.GetNewSudokuGenerator(settings, sudokuInitializer),
+	}
+
+	sudokuLibrary, _ := library.New(library.WithSettings(settings), library.WithSudokuInit(sudokuInitializer),
+		library.WithSolver(solver), library.WithEncoder(serviceCollection.SudokuEncoder),
+		library.WithMaximumConcurrentSolves(1))
+
+	return NewServer(settings, serviceCollection, sudokuLibrary, serverCommon.Options{
+		MaximumRequestSize: 16 * 1024,
+		RequestTimeout:     timeout,
+	})
+}
+
+// readTestSudoku converts sudoku from text format to JSON request body
+func readTestSudoku(t *testing.T, text string) string {
+	sudokuDto, err := getTestServer(time.Minute).Library.Parse([]byte(text), library.WithFormat(library.FormatText))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(sudokuDto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
+
+func readTestFile(t *testing.T, path string) string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(data)
+}
@@ -8,8 +8,10 @@ type BinarySudokuManager struct {
 
 type IBinarySudokuManager interface {
 	ReadFromBase64(base64Data string) (*models.SudokuDTO, error)
+	ReadFromBase64URL(base64Data string) (*models.SudokuDTO, error)
 	ReadFromBytes(sudokuData []byte) (*models.SudokuDTO, error)
 	ToBase64(sudokuDto *models.SudokuDTO) (string, error)
+	ToBase64URL(sudokuDto *models.SudokuDTO) (string, error)
 	ToBytes(sudokuDto *models.SudokuDTO) ([]byte, error)
 }
 
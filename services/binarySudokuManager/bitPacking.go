@@ -0,0 +1,65 @@
+package binarySudokuManager
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// bitWriter appends values of fixed bits width to a bytes slice, most
+// significant bit first. Last byte is padded with zeros.
+type bitWriter struct {
+	data      []byte
+	bitsCount int
+}
+
+// bitReader reads values of fixed bits width from a bytes slice, most
+// significant bit first.
+type bitReader struct {
+	data     []byte
+	bitIndex int
+}
+
+// getValueBitsWidth calculates how many bits are needed to store any value
+// of a cell (including 0 for no value) of a sudoku with provided box size,
+// which is ceil(log2(boxSize*boxSize+1))
+func getValueBitsWidth(boxSize int8) int {
+	return bits.Len(uint(int(boxSize) * int(boxSize)))
+}
+
+// getPackedBytesCount calculates amount of bytes required to store provided
+// amount of values of provided bits width
+func getPackedBytesCount(valuesCount int, bitsWidth int) int {
+	return (valuesCount*bitsWidth + 7) / 8
+}
+
+// write appends the value using provided amount of bits
+func (writer *bitWriter) write(value uint, bitsWidth int) {
+	for bitIndex := bitsWidth - 1; bitIndex >= 0; bitIndex-- {
+		if writer.bitsCount%8 == 0 {
+			writer.data = append(writer.data, 0)
+		}
+
+		if value&(1<<bitIndex) > 0 {
+			writer.data[len(writer.data)-1] |= 1 << (7 - writer.bitsCount%8)
+		}
+
+		writer.bitsCount++
+	}
+}
+
+// read reads next value stored with provided amount of bits
+func (reader *bitReader) read(bitsWidth int) (uint, error) {
+	if reader.bitIndex+bitsWidth > len(reader.data)*8 {
+		return 0, errors.New("sudoku data does not have sufficient cells values information")
+	}
+
+	var value uint = 0
+	for i := 0; i < bitsWidth; i++ {
+		dataByte := reader.data[reader.bitIndex/8]
+		bit := (dataByte >> (7 - reader.bitIndex%8)) & 1
+		value = value<<1 | uint(bit)
+		reader.bitIndex++
+	}
+
+	return value, nil
+}
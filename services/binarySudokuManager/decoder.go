@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"math"
 
 	"github.com/Michu8258/kangaroo/models"
@@ -21,6 +22,17 @@ func (manager *BinarySudokuManager) ReadFromBase64(base64Data string) (*models.S
 	return manager.ReadFromBytes(sudokuDataBytes)
 }
 
+// ReadFromBase64URL reads sudoku DTO from URL-safe base64 representation
+// of sudoku data
+func (manager *BinarySudokuManager) ReadFromBase64URL(base64Data string) (*models.SudokuDTO, error) {
+	sudokuDataBytes, err := base64.URLEncoding.DecodeString(base64Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.ReadFromBytes(sudokuDataBytes)
+}
+
 // ReadFromBytes reads sudoku DTO from bytes of binary representation
 // of sudoku data
 func (manager *BinarySudokuManager) ReadFromBytes(sudokuData []byte) (*models.SudokuDTO, error) {
@@ -31,6 +43,7 @@ func (manager *BinarySudokuManager) ReadFromBytes(sudokuData []byte) (*models.Su
 
 	handlers := map[uint16]func(sudokuData []byte) (*models.SudokuDTO, error){
 		1: manager.ReadVersion1,
+		2: manager.ReadVersion2,
 	}
 
 	matchingHandler, ok := handlers[version]
@@ -70,19 +83,91 @@ func (manager *BinarySudokuManager) ReadVersion1(sudokuData []byte) (*models.Sud
 	return sudokuDto, nil
 }
 
+// ReadVersion2 implements binary data to sudoku DTO parsing for version 2 of binary
+// representation format. Checksum is verified before any other data is read.
+func (manager *BinarySudokuManager) ReadVersion2(sudokuData []byte) (*models.SudokuDTO, error) {
+	sudokuData, err := verifyChecksum(sudokuData)
+	if err != nil {
+		return nil, err
+	}
+
+	boxSize, err := getSudokuBoxSize(sudokuData)
+	if err != nil {
+		return nil, err
+	}
+
+	layout, err := getSudokuLayout(sudokuData)
+	if err != nil {
+		return nil, err
+	}
+
+	if boxSize < 1 || layout.Width < 1 || layout.Height < 1 {
+		return nil, fmt.Errorf("sudoku data contains invalid box size %d or layout %dx%d",
+			boxSize, layout.Width, layout.Height)
+	}
+
+	if len(sudokuData) < 6 {
+		return nil, errors.New("sudoku data does not contain variant flags information")
+	}
+	variantFlags := sudokuData[5]
+
+	enableState, enableStateDataBytesCount, err := getBoxesEnableStateData(sudokuData, 6, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	cellsDataStartIndex := 6 + enableStateDataBytesCount
+	enabledBoxesCount := 0
+	for _, isEnabled := range enableState {
+		if isEnabled {
+			enabledBoxesCount++
+		}
+	}
+
+	cellsDataBytesCount := getPackedBytesCount(
+		enabledBoxesCount*int(boxSize)*int(boxSize), getValueBitsWidth(boxSize))
+	if len(sudokuData) < cellsDataStartIndex+cellsDataBytesCount {
+		return nil, errors.New("sudoku data does not have sufficient cells values information")
+	}
+
+	boxes, err := getPackedBoxesData(&bitReader{
+		data: sudokuData[cellsDataStartIndex : cellsDataStartIndex+cellsDataBytesCount],
+	}, enableState, boxSize, layout)
+	if err != nil {
+		return nil, err
+	}
+
+	sudokuDto := &models.SudokuDTO{
+		BoxSize: boxSize,
+		Layout:  *layout,
+		Boxes:   boxes,
+	}
+
+	variantsReader := &variantsDataReader{
+		data:      sudokuData,
+		byteIndex: cellsDataStartIndex + cellsDataBytesCount,
+	}
+	err = variantsReader.readVariantsData(sudokuDto, variantFlags)
+	if err != nil {
+		return nil, err
+	}
+
+	return sudokuDto, nil
+}
+
 // getBoxesData reads complete ssudoku boxes data out of provided binary
 // representation. It includes enabled/disabled state handling and nil
 // values
 func getBoxesData(sudokuData []byte, boxSize int8, layout *models.SudokuLayoutDTO) (
 	[]*models.SudokuBoxDTO, error) {
 
-	enableState, enableStateDataBytesCount, err := getBoxesEnableStateData(sudokuData, layout)
+	enableState, enableStateDataBytesCount, err := getBoxesEnableStateData(sudokuData, 5, layout)
 	if err != nil {
 		return nil, err
 	}
 
 	sudokuBoxesDataStartIndex := 5 + enableStateDataBytesCount
-	oneBoxDataBytesCount := int(boxSize * boxSize)
+	oneBoxDataBytesCount := int(boxSize) * int(boxSize)
 
 	boxes := []*models.SudokuBoxDTO{}
 	for boxIndex, isEnabled := range enableState {
@@ -107,6 +192,51 @@ func getBoxesData(sudokuData []byte, boxSize int8, layout *models.SudokuLayoutDT
 	return boxes, nil
 }
 
+// getPackedBoxesData reads complete sudoku boxes data out of bit-packed cell values.
+// Disabled boxes get cells with no values and no data is read for them.
+func getPackedBoxesData(reader *bitReader, enableState []bool, boxSize int8,
+	layout *models.SudokuLayoutDTO) ([]*models.SudokuBoxDTO, error) {
+
+	cellsCount := int(boxSize) * int(boxSize)
+	bitsWidth := getValueBitsWidth(boxSize)
+
+	boxes := []*models.SudokuBoxDTO{}
+	for boxIndex, isEnabled := range enableState {
+		box := &models.SudokuBoxDTO{}
+		box.Disabled = !isEnabled
+		box.Cells = []*models.SudokuCellDTO{}
+		setBoxIndexes(box, layout, boxIndex)
+
+		for i := 0; i < cellsCount; i++ {
+			cell := &models.SudokuCellDTO{}
+			setCellIndexes(cell, boxSize, i)
+
+			if isEnabled {
+				packedValue, err := reader.read(bitsWidth)
+				if err != nil {
+					return nil, err
+				}
+
+				value := int(packedValue)
+				if value > cellsCount {
+					return nil, fmt.Errorf(
+						"sudoku data contains value %d that exceeds maximum value %d", value, cellsCount)
+				}
+
+				if value > 0 {
+					cell.Value = &value
+				}
+			}
+
+			box.Cells = append(box.Cells, cell)
+		}
+
+		boxes = append(boxes, box)
+	}
+
+	return boxes, nil
+}
+
 // assignCellValues assigns cells with values to the box according to bytes
 // in sudoku binary representation. It takes care of the case if box is
 // disabled - cells with no values will be added to the box and no attempt
@@ -114,7 +244,7 @@ func getBoxesData(sudokuData []byte, boxSize int8, layout *models.SudokuLayoutDT
 func assignCellValues(box *models.SudokuBoxDTO, boxSize int8, sudokuData []byte,
 	startByteIndex int, oneBoxDataBytesCount int) error {
 
-	cellsCount := int(boxSize * boxSize)
+	cellsCount := int(boxSize) * int(boxSize)
 
 	if box.Disabled {
 		for i := 0; i < cellsCount; i++ {
@@ -137,6 +267,10 @@ func assignCellValues(box *models.SudokuBoxDTO, boxSize int8, sudokuData []byte,
 
 		valueByteIndex := startByteIndex + i
 		value := int(sudokuData[valueByteIndex])
+		if value > cellsCount {
+			return fmt.Errorf(
+				"sudoku data contains value %d that exceeds maximum value %d", value, cellsCount)
+		}
 
 		if value > 0 {
 			cell.Value = &value
@@ -152,9 +286,10 @@ func assignCellValues(box *models.SudokuBoxDTO, boxSize int8, sudokuData []byte,
 // are enabled and which are not. It returns a slice where index on a flag is an index of a
 // box in the binary data, an int indicating how many bytes of binary data was used to encode
 // boxes enable/disable state and an error if occired
-func getBoxesEnableStateData(sudokuData []byte, layout *models.SudokuLayoutDTO) ([]bool, int, error) {
-	startingByteIndex := 5
-	boxesCount := int(layout.Height * layout.Height)
+func getBoxesEnableStateData(sudokuData []byte, startingByteIndex int,
+	layout *models.SudokuLayoutDTO) ([]bool, int, error) {
+
+	boxesCount := int(layout.Width) * int(layout.Height)
 	amountOfBytes := int(math.Ceil(float64(boxesCount) / 8))
 
 	if len(sudokuData) < startingByteIndex+amountOfBytes+1 {
@@ -178,8 +313,8 @@ func getBoxesEnableStateData(sudokuData []byte, layout *models.SudokuLayoutDTO)
 // setBoxIndexes calculates and assigns row and column index to the box,
 // based of its single dimension index
 func setBoxIndexes(box *models.SudokuBoxDTO, layout *models.SudokuLayoutDTO, boxIndex int) {
-	rowIndex := int8(math.Floor(float64(boxIndex) / float64(layout.Width)))
-	columnIndex := int8(boxIndex) - (rowIndex * layout.Width)
+	rowIndex := int8(boxIndex / int(layout.Width))
+	columnIndex := int8(boxIndex % int(layout.Width))
 
 	box.IndexRow = rowIndex
 	box.IndexColumn = columnIndex
@@ -188,8 +323,8 @@ func setBoxIndexes(box *models.SudokuBoxDTO, layout *models.SudokuLayoutDTO, box
 // setCellIndexes calculates and assigns row and column index to the cell,
 // based of its single dimension index
 func setCellIndexes(cell *models.SudokuCellDTO, boxSize int8, cellIndex int) {
-	rowIndex := int8(math.Floor(float64(cellIndex) / float64(boxSize)))
-	columnIndex := int8(cellIndex) - (rowIndex * boxSize)
+	rowIndex := int8(cellIndex / int(boxSize))
+	columnIndex := int8(cellIndex % int(boxSize))
 
 	cell.IndexRowInBox = rowIndex
 	cell.IndexColumnInBox = columnIndex
@@ -227,3 +362,20 @@ func getSudokuLayout(sudokuData []byte) (*models.SudokuLayoutDTO, error) {
 
 	return layout, nil
 }
+
+// verifyChecksum compares CRC32 checksum stored in last 4 bytes of binary representation
+// with checksum calculated for all preceding bytes. Returns the data without checksum.
+func verifyChecksum(sudokuData []byte) ([]byte, error) {
+	if len(sudokuData) < 6 {
+		return nil, errors.New("sudoku data does not contain checksum information")
+	}
+
+	dataLength := len(sudokuData) - 4
+	expectedChecksum := binary.BigEndian.Uint32(sudokuData[dataLength:])
+	if crc32.ChecksumIEEE(sudokuData[:dataLength]) != expectedChecksum {
+		return nil, errors.New(
+			"sudoku data checksum does not match - the data is corrupted or incomplete")
+	}
+
+	return sudokuData[:dataLength], nil
+}
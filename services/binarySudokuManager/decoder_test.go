@@ -1,8 +1,12 @@
 package binarySudokuManager
 
 import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
 	"testing"
 
+	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -76,6 +80,14 @@ var decodeErrorTestCases = []decodeErrorTestCase{
 			return correctData[:30]
 		},
 	},
+	{
+		name: "Value exceeds box size squared",
+		dataBytesInvalidator: func(correctData []byte) []byte {
+			invalidData := bytes.Clone(correctData)
+			invalidData[8] = 10
+			return invalidData
+		},
+	},
 }
 
 func TestReadFromBytes_Error(t *testing.T) {
@@ -130,3 +142,240 @@ func TestReadFromBase64_Success(t *testing.T) {
 		t.Error("ReadFromBase64 - invalid sudoku DTO data")
 	}
 }
+
+func TestReadFromBytes_BigSudokuRoundTrip(t *testing.T) {
+	for _, version := range []uint16{1, 2} {
+		settings := testHelpers.GetTestSettings()
+		settings.SudokuBinaryEncoderVersion = version
+		manager := GetNewBinarySudokuManager(settings)
+
+		// box size 8 gives values up to 64 and layout wider than it is high
+		sudokuDto := getBigTestSudokuDto()
+
+		dataBytes, err := manager.ToBytes(sudokuDto)
+		if err != nil {
+			t.Fatalf("Version %d - ToBytes - unexpected error: %s", version, err)
+		}
+
+		decodedDto, err := manager.ReadFromBytes(dataBytes)
+		if err != nil {
+			t.Fatalf("Version %d - ReadFromBytes - unexpected error: %s", version, err)
+		}
+
+		compareSudokuDtos(t, sudokuDto, decodedDto)
+	}
+}
+
+func TestReadFromBytes_Version2Variants(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	settings.SudokuBinaryEncoderVersion = 2
+	manager := GetNewBinarySudokuManager(settings)
+
+	sudokuDto := testHelpers.GetTestSudokuDto()
+	testValue := 9
+	sudokuDto.Boxes[4].Cells[4].Value = &testValue
+	sudokuDto.Alphabet = "A-Hł"
+	sudokuDto.Thermometers = models.GenericSlice[*models.SudokuThermometerDTO]{
+		{Cells: models.GenericSlice[*models.SudokuCellPositionDTO]{{Row: 0, Column: 0}, {Row: 1, Column: 1}}},
+	}
+	sudokuDto.Arrows = models.GenericSlice[*models.SudokuArrowDTO]{
+		{
+			Circle: models.SudokuCellPositionDTO{Row: 8, Column: 8},
+			Cells:  models.GenericSlice[*models.SudokuCellPositionDTO]{{Row: 8, Column: 7}},
+		},
+	}
+	sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{
+		{Greater: models.SudokuCellPositionDTO{Row: 4, Column: 4}, Lesser: models.SudokuCellPositionDTO{Row: 4, Column: 5}},
+	}
+
+	dataBytes, err := manager.ToBytes(sudokuDto)
+	if err != nil {
+		t.Fatalf("ToBytes - unexpected error: %s", err)
+	}
+
+	decodedDto, err := manager.ReadFromBytes(dataBytes)
+	if err != nil {
+		t.Fatalf("ReadFromBytes - unexpected error: %s", err)
+	}
+
+	compareSudokuDtos(t, sudokuDto, decodedDto)
+
+	if decodedDto.Alphabet != sudokuDto.Alphabet {
+		t.Errorf("Invalid alphabet. Expected '%s', got '%s'.", sudokuDto.Alphabet, decodedDto.Alphabet)
+	}
+
+	if len(decodedDto.Thermometers) != 1 || len(decodedDto.Thermometers[0].Cells) != 2 ||
+		*decodedDto.Thermometers[0].Cells[1] != *sudokuDto.Thermometers[0].Cells[1] {
+		t.Error("Invalid thermometers data.")
+	}
+
+	if len(decodedDto.Arrows) != 1 || decodedDto.Arrows[0].Circle != sudokuDto.Arrows[0].Circle ||
+		len(decodedDto.Arrows[0].Cells) != 1 || *decodedDto.Arrows[0].Cells[0] != *sudokuDto.Arrows[0].Cells[0] {
+		t.Error("Invalid arrows data.")
+	}
+
+	if len(decodedDto.Comparisons) != 1 || *decodedDto.Comparisons[0] != *sudokuDto.Comparisons[0] {
+		t.Error("Invalid comparisons data.")
+	}
+}
+
+func TestReadFromBytes_Version2Error(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	settings.SudokuBinaryEncoderVersion = 2
+	manager := GetNewBinarySudokuManager(settings)
+
+	sudokuDto := testHelpers.GetTestSudokuDto()
+	testValue := 1
+	sudokuDto.Boxes[0].Cells[0].Value = &testValue
+	correctData, err := manager.ToBytes(sudokuDto)
+	if err != nil {
+		t.Fatalf("ToBytes - unexpected error: %s", err)
+	}
+
+	// replaces checksum of the data with a correct one, so other validations can be tested
+	withChecksum := func(data []byte) []byte {
+		data = data[:len(data)-4]
+		return binary.BigEndian.AppendUint32(data, crc32.ChecksumIEEE(data))
+	}
+
+	testCases := []decodeErrorTestCase{
+		{
+			name: "No checksum",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				return []byte{0, 2, 3}
+			},
+		},
+		{
+			name: "Truncated data",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				return correctData[:len(correctData)-10]
+			},
+		},
+		{
+			name: "Corrupted data",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData[10] ^= 0x10
+				return correctData
+			},
+		},
+		{
+			name: "Invalid box size",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData[2] = 0
+				return withChecksum(correctData)
+			},
+		},
+		{
+			name: "Unknown variant flags",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData[5] = 128
+				return withChecksum(correctData)
+			},
+		},
+		{
+			name: "Missing variant data",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData[5] = 1
+				return withChecksum(correctData)
+			},
+		},
+		{
+			name: "Value out of range",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData[8] = 0xF0
+				return withChecksum(correctData)
+			},
+		},
+		{
+			name: "Not enough cells data",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				return withChecksum(correctData[:30])
+			},
+		},
+		{
+			name: "Unexpected data after cells",
+			dataBytesInvalidator: func(correctData []byte) []byte {
+				correctData = append(correctData[:len(correctData)-4], 0, 0, 0, 0, 0)
+				return withChecksum(correctData)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		input := testCase.dataBytesInvalidator(bytes.Clone(correctData))
+
+		_, err := manager.ReadFromBytes(input)
+
+		if err == nil {
+			t.Errorf("%s - ReadFromBytes - expected error, but none returned", testCase.name)
+		}
+	}
+}
+
+func getBigTestSudokuDto() *models.SudokuDTO {
+	var boxSize int8 = 8
+	layout := models.SudokuLayoutDTO{Width: 7, Height: 2}
+	sudokuDto := &models.SudokuDTO{BoxSize: boxSize, Layout: layout}
+
+	for boxIndex := 0; boxIndex < int(layout.Width)*int(layout.Height); boxIndex++ {
+		box := &models.SudokuBoxDTO{Disabled: boxIndex == 3}
+		setBoxIndexes(box, &layout, boxIndex)
+		for cellIndex := 0; cellIndex < int(boxSize)*int(boxSize); cellIndex++ {
+			cell := &models.SudokuCellDTO{}
+			setCellIndexes(cell, boxSize, cellIndex)
+			if !box.Disabled && cellIndex%3 == 0 {
+				value := cellIndex + 1
+				cell.Value = &value
+			}
+			box.Cells = append(box.Cells, cell)
+		}
+		sudokuDto.Boxes = append(sudokuDto.Boxes, box)
+	}
+
+	return sudokuDto
+}
+
+func compareSudokuDtos(t *testing.T, expected *models.SudokuDTO, actual *models.SudokuDTO) {
+	if actual.BoxSize != expected.BoxSize || actual.Layout != expected.Layout ||
+		len(actual.Boxes) != len(expected.Boxes) {
+		t.Fatal("ReadFromBytes - invalid sudoku DTO data")
+	}
+
+	for boxIndex, box := range expected.Boxes {
+		actualBox := actual.Boxes[boxIndex]
+		if actualBox.Disabled != box.Disabled || actualBox.IndexRow != box.IndexRow ||
+			actualBox.IndexColumn != box.IndexColumn {
+			t.Errorf("Invalid box data. Box index: %d.", boxIndex)
+		}
+
+		for cellIndex, cell := range box.Cells {
+			actualCell := actualBox.Cells[cellIndex]
+			if (cell.Value == nil) != (actualCell.Value == nil) ||
+				(cell.Value != nil && *cell.Value != *actualCell.Value) {
+				t.Errorf("Invalid cell value. Box index: %d, cell index: %d.", boxIndex, cellIndex)
+			}
+		}
+	}
+}
+
+func TestReadFromBase64URL_Success(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	settings.SudokuBinaryEncoderVersion = 2
+	manager := GetNewBinarySudokuManager(settings)
+
+	// '_' character is not a part of standard base64 alphabet
+	input := "AAIDAwMA_4BgABAAcAMgUABAAHAAEACQAEAYAABgdQAIAAAAYIAgAAADBWAAMCBwALiblxg="
+	sudokuDto, err := manager.ReadFromBase64URL(input)
+	if err != nil {
+		t.Fatalf("ReadFromBase64URL - unexpected error: %s", err)
+	}
+
+	output, err := manager.ToBase64URL(sudokuDto)
+	if err != nil {
+		t.Fatalf("ToBase64URL - unexpected error: %s", err)
+	}
+
+	if output != input {
+		t.Errorf("ToBase64URL - expected '%s', got '%s'", input, output)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/crc32"
 	"math"
 
 	"github.com/Michu8258/kangaroo/models"
@@ -21,6 +22,17 @@ func (manager *BinarySudokuManager) ToBase64(sudokuDto *models.SudokuDTO) (strin
 	return base64Str, nil
 }
 
+// ToBase64URL converts sudoku dto object to its URL-safe base64 string representation
+func (manager *BinarySudokuManager) ToBase64URL(sudokuDto *models.SudokuDTO) (string, error) {
+	dataBytes, err := manager.ToBytes(sudokuDto)
+	if err != nil {
+		return "", err
+	}
+
+	base64Str := base64.URLEncoding.EncodeToString(dataBytes)
+	return base64Str, nil
+}
+
 // ToBytes converts sudoku dto object to its binary data representation
 func (manager *BinarySudokuManager) ToBytes(sudokuDto *models.SudokuDTO) ([]byte, error) {
 	var version uint16 = manager.Settings.SudokuBinaryEncoderVersion
@@ -28,6 +40,7 @@ func (manager *BinarySudokuManager) ToBytes(sudokuDto *models.SudokuDTO) ([]byte
 
 	handlers := map[uint16]func(sudokuDto *models.SudokuDTO, result []byte) ([]byte, error){
 		1: manager.WriteVersion1,
+		2: manager.WriteVersion2,
 	}
 
 	matchingHandler, ok := handlers[version]
@@ -66,13 +79,53 @@ func (manager *BinarySudokuManager) WriteVersion1(sudokuDto *models.SudokuDTO, r
 	return result, nil
 }
 
+// WriteVersion2 implements logic for writing sudoku binary data for version 2. Cell values
+// are bit-packed, variants data is included when present and CRC32 checksum of all
+// preceding bytes is appended at the end.
+func (manager *BinarySudokuManager) WriteVersion2(sudokuDto *models.SudokuDTO, result []byte) ([]byte, error) {
+	//box size
+	result = append(result, byte(sudokuDto.BoxSize))
+
+	// layout width and height
+	result = append(result, byte(sudokuDto.Layout.Width), byte(sudokuDto.Layout.Height))
+
+	// variants present in the sudoku
+	variantFlags := getVariantFlags(sudokuDto)
+	result = append(result, variantFlags)
+
+	// enabed/disabled boxes data
+	enableStateBytes, err := buildEnableStateBytes(sudokuDto)
+	if err != nil {
+		return result, err
+	}
+	result = append(result, enableStateBytes...)
+
+	// bit-packed cells data
+	cellsBytes, err := buildPackedCellsData(sudokuDto)
+	if err != nil {
+		return result, err
+	}
+	result = append(result, cellsBytes...)
+
+	// variants data
+	result, err = appendVariantsData(sudokuDto, variantFlags, result)
+	if err != nil {
+		return result, err
+	}
+
+	// checksum
+	result = binary.BigEndian.AppendUint32(result, crc32.ChecksumIEEE(result))
+
+	return result, nil
+}
+
 // buildEnableStateBytes creates binary data holding information about
 // sudoku bixes enabled/disabled state
 func buildEnableStateBytes(sudokuDto *models.SudokuDTO) ([]byte, error) {
 	var rowIndex int8 = 0
 	var columnIndex int8 = 0
 
-	boxesCount := sudokuDto.Layout.Width * sudokuDto.Layout.Height
+	boxesCount := int(sudokuDto.Layout.Width) * int(sudokuDto.Layout.Height)
 	amountOfBytes := int(math.Ceil(float64(boxesCount) / 8))
 	result := make([]byte, amountOfBytes)
 
@@ -87,7 +140,7 @@ func buildEnableStateBytes(sudokuDto *models.SudokuDTO) ([]byte, error) {
 					"could not find box during enable/disable sudoku binary data construction")
 			}
 
-			boxIndex := rowIndex*sudokuDto.Layout.Width + columnIndex
+			boxIndex := int(rowIndex)*int(sudokuDto.Layout.Width) + int(columnIndex)
 			byteIndex := int(float64(boxIndex / 8))
 			bitIndex := boxIndex % 8
 			logicalOrOperationValue := byte(math.Pow(2, float64(7-bitIndex)))
@@ -103,10 +156,35 @@ func buildEnableStateBytes(sudokuDto *models.SudokuDTO) ([]byte, error) {
 
 // buildCellsData creates binary data holding information about cell values
 func buildCellsData(sudokuDto *models.SudokuDTO) ([]byte, error) {
+	result := []byte{}
+
+	values, err := collectCellValues(sudokuDto)
+	if err != nil {
+		return result, err
+	}
+
+	// one byte per value is enough for values of box size up to 8 (max 64),
+	// values greater than box size squared can not be decoded back
+	maximumValue := int(sudokuDto.BoxSize) * int(sudokuDto.BoxSize)
+	for _, value := range values {
+		if value < 0 || value > maximumValue || value > math.MaxUint8 {
+			return result, fmt.Errorf(
+				"value %d exceeds maximum value %d of sudoku binary data", value, maximumValue)
+		}
+
+		result = append(result, byte(value))
+	}
+
+	return result, nil
+}
+
+// collectCellValues creates a slice of values of all cells of enabled boxes, in order
+// required by binary representation. Cells with no value are represented with 0.
+func collectCellValues(sudokuDto *models.SudokuDTO) ([]int, error) {
 	var rowIndex int8 = 0
 	var columnIndex int8 = 0
 
-	result := []byte{}
+	result := []int{}
 
 	// iterate through boxes - we have to do it by searching by indexes because order of data
 	// is important in binary representation
@@ -116,8 +194,13 @@ func buildCellsData(sudokuDto *models.SudokuDTO) ([]byte, error) {
 				return box.IndexRow == rowIndex && box.IndexColumn == columnIndex
 			})
 
+			if sudokuBox == nil {
+				return result, errors.New(
+					"could not find box during values sudoku binary data construction")
+			}
+
 			if !sudokuBox.Disabled {
-				values := make([]byte, sudokuDto.BoxSize*sudokuDto.BoxSize)
+				values := make([]int, int(sudokuDto.BoxSize)*int(sudokuDto.BoxSize))
 				var cellRowIndex int8 = 0
 				var cellColumnIndex int8 = 0
 
@@ -139,7 +222,7 @@ func buildCellsData(sudokuDto *models.SudokuDTO) ([]byte, error) {
 							value = *sudokuCell.Value
 						}
 
-						values[cellRowIndex*sudokuDto.BoxSize+cellColumnIndex] = byte(value)
+						values[int(cellRowIndex)*int(sudokuDto.BoxSize)+int(cellColumnIndex)] = value
 					}
 				}
 
@@ -150,3 +233,27 @@ func buildCellsData(sudokuDto *models.SudokuDTO) ([]byte, error) {
 
 	return result, nil
 }
+
+// buildPackedCellsData creates binary data holding information about cell values, where
+// every value takes only as many bits as needed to store the highest value of the sudoku
+func buildPackedCellsData(sudokuDto *models.SudokuDTO) ([]byte, error) {
+	values, err := collectCellValues(sudokuDto)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	bitsWidth := getValueBitsWidth(sudokuDto.BoxSize)
+	maximumValue := int(sudokuDto.BoxSize) * int(sudokuDto.BoxSize)
+	writer := &bitWriter{}
+
+	for _, value := range values {
+		if value < 0 || value > maximumValue {
+			return []byte{}, fmt.Errorf(
+				"value %d does not fit in %d bits of sudoku binary data", value, bitsWidth)
+		}
+
+		writer.write(uint(value), bitsWidth)
+	}
+
+	return writer.data, nil
+}
@@ -32,6 +32,13 @@ var encodeErrorTestCases = []encodeErrorTestCase{
 			sudokuDto.Boxes[0].Cells = sudokuDto.Boxes[0].Cells[2:]
 		},
 	},
+	{
+		name: "Value exceeds box size squared",
+		sudokuDtoInvalidator: func(sudokuDto *models.SudokuDTO, settings *models.Settings) {
+			invalidValue := 10
+			sudokuDto.Boxes[0].Cells[1].Value = &invalidValue
+		},
+	},
 }
 
 func TestToBase64_Error(t *testing.T) {
@@ -115,3 +122,49 @@ func TestToBytes_Success(t *testing.T) {
 	}
 
 }
+
+func TestToBytes_Version2_Success(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	settings.SudokuBinaryEncoderVersion = 2
+	manager := GetNewBinarySudokuManager(settings)
+
+	// read root/documentation/binaryFormat.md to find out why
+	expectedBytesLength := 53
+	expectedConfigBytes := []byte{0, 2, 3, 3, 3, 0, 255, 128, 0x10}
+
+	sudoku := testHelpers.GetTestSudokuDto()
+	testValue := 1
+	sudoku.Boxes[0].Cells[0].Value = &testValue
+
+	dataBytes, err := manager.ToBytes(sudoku)
+	if err != nil {
+		t.Fatalf("ToBytes - unexpected error: %s", err)
+	}
+
+	if len(dataBytes) != expectedBytesLength {
+		t.Errorf("Invalid binary data length. Expected %d bytes, got %d.",
+			expectedBytesLength, len(dataBytes))
+	}
+
+	actualConfigBytes := dataBytes[:len(expectedConfigBytes)]
+	if !bytes.Equal(expectedConfigBytes, actualConfigBytes) {
+		t.Errorf("Invalid binary sudoku configuration. Expected %d, got %d.",
+			expectedConfigBytes, actualConfigBytes)
+	}
+}
+
+func TestToBytes_Version2_Error(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	settings.SudokuBinaryEncoderVersion = 2
+	manager := GetNewBinarySudokuManager(settings)
+
+	sudoku := testHelpers.GetTestSudokuDto()
+	testValue := 10
+	sudoku.Boxes[0].Cells[0].Value = &testValue
+
+	_, err := manager.ToBytes(sudoku)
+
+	if err == nil {
+		t.Error("ToBytes - expected error for value out of range, but none returned")
+	}
+}
@@ -0,0 +1,320 @@
+package binarySudokuManager
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Flags of sudoku variants that data is included in binary representation.
+// Every flag set in variants byte is followed by a data chunk of the variant.
+const (
+	variantFlagAlphabet byte = 1 << iota
+	variantFlagThermometers
+	variantFlagArrows
+	variantFlagComparisons
+)
+
+const allVariantFlags = variantFlagAlphabet | variantFlagThermometers |
+	variantFlagArrows | variantFlagComparisons
+
+// variantsDataReader reads variants data chunks from binary representation,
+// starting at provided byte index
+type variantsDataReader struct {
+	data      []byte
+	byteIndex int
+}
+
+// getVariantFlags calculates variant flags byte for provided sudoku
+func getVariantFlags(sudokuDto *models.SudokuDTO) byte {
+	var flags byte = 0
+
+	if len(sudokuDto.Alphabet) > 0 {
+		flags |= variantFlagAlphabet
+	}
+
+	if len(sudokuDto.Thermometers) > 0 {
+		flags |= variantFlagThermometers
+	}
+
+	if len(sudokuDto.Arrows) > 0 {
+		flags |= variantFlagArrows
+	}
+
+	if len(sudokuDto.Comparisons) > 0 {
+		flags |= variantFlagComparisons
+	}
+
+	return flags
+}
+
+// appendVariantsData appends data chunks of all variants indicated by flags
+func appendVariantsData(sudokuDto *models.SudokuDTO, flags byte, result []byte) ([]byte, error) {
+	var err error
+
+	if flags&variantFlagAlphabet > 0 {
+		result, err = appendUint16(result, len(sudokuDto.Alphabet), "alphabet length")
+		if err != nil {
+			return result, err
+		}
+		result = append(result, []byte(sudokuDto.Alphabet)...)
+	}
+
+	if flags&variantFlagThermometers > 0 {
+		thermometers := sudokuDto.Thermometers.Where(func(thermometer *models.SudokuThermometerDTO) bool {
+			return thermometer != nil
+		})
+
+		result, err = appendUint16(result, len(thermometers), "thermometers count")
+		if err != nil {
+			return result, err
+		}
+
+		for _, thermometer := range thermometers {
+			result, err = appendPositions(result, thermometer.Cells)
+			if err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if flags&variantFlagArrows > 0 {
+		arrows := sudokuDto.Arrows.Where(func(arrow *models.SudokuArrowDTO) bool {
+			return arrow != nil
+		})
+
+		result, err = appendUint16(result, len(arrows), "arrows count")
+		if err != nil {
+			return result, err
+		}
+
+		for _, arrow := range arrows {
+			result, err = appendPosition(result, arrow.Circle)
+			if err != nil {
+				return result, err
+			}
+
+			result, err = appendPositions(result, arrow.Cells)
+			if err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if flags&variantFlagComparisons > 0 {
+		comparisons := sudokuDto.Comparisons.Where(func(comparison *models.SudokuComparisonDTO) bool {
+			return comparison != nil
+		})
+
+		result, err = appendUint16(result, len(comparisons), "comparisons count")
+		if err != nil {
+			return result, err
+		}
+
+		for _, comparison := range comparisons {
+			result, err = appendPosition(result, comparison.Greater)
+			if err != nil {
+				return result, err
+			}
+
+			result, err = appendPosition(result, comparison.Lesser)
+			if err != nil {
+				return result, err
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// appendPositions appends amount of cell positions followed by the positions
+func appendPositions(result []byte, positions models.GenericSlice[*models.SudokuCellPositionDTO]) ([]byte, error) {
+	positions = positions.Where(func(position *models.SudokuCellPositionDTO) bool {
+		return position != nil
+	})
+
+	result, err := appendUint16(result, len(positions), "cells count")
+	if err != nil {
+		return result, err
+	}
+
+	for _, position := range positions {
+		result, err = appendPosition(result, *position)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// appendPosition appends cell position as row and column
+func appendPosition(result []byte, position models.SudokuCellPositionDTO) ([]byte, error) {
+	result, err := appendUint16(result, position.Row, "cell row")
+	if err != nil {
+		return result, err
+	}
+
+	return appendUint16(result, position.Column, "cell column")
+}
+
+// appendUint16 appends the value as two bytes, or returns an error
+// if the value does not fit in two bytes
+func appendUint16(result []byte, value int, valueName string) ([]byte, error) {
+	if value < 0 || value > math.MaxUint16 {
+		return result, fmt.Errorf(
+			"%s %d does not fit in two bytes of sudoku binary data", valueName, value)
+	}
+
+	return binary.BigEndian.AppendUint16(result, uint16(value)), nil
+}
+
+// readVariantsData reads data chunks of all variants indicated by flags and
+// assigns them to the sudoku DTO. Returns an error if variants data is not complete
+// or there is some unexpected data after variants data.
+func (reader *variantsDataReader) readVariantsData(sudokuDto *models.SudokuDTO, flags byte) error {
+	if flags&^allVariantFlags > 0 {
+		return fmt.Errorf("sudoku data contains unknown variant flags %08b", flags&^allVariantFlags)
+	}
+
+	if flags&variantFlagAlphabet > 0 {
+		length, err := reader.readUint16()
+		if err != nil {
+			return err
+		}
+
+		alphabet, err := reader.readBytes(length)
+		if err != nil {
+			return err
+		}
+		sudokuDto.Alphabet = string(alphabet)
+	}
+
+	if flags&variantFlagThermometers > 0 {
+		count, err := reader.readUint16()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			cells, err := reader.readPositions()
+			if err != nil {
+				return err
+			}
+
+			sudokuDto.Thermometers = append(sudokuDto.Thermometers, &models.SudokuThermometerDTO{
+				Cells: cells,
+			})
+		}
+	}
+
+	if flags&variantFlagArrows > 0 {
+		count, err := reader.readUint16()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			circle, err := reader.readPosition()
+			if err != nil {
+				return err
+			}
+
+			cells, err := reader.readPositions()
+			if err != nil {
+				return err
+			}
+
+			sudokuDto.Arrows = append(sudokuDto.Arrows, &models.SudokuArrowDTO{
+				Circle: circle,
+				Cells:  cells,
+			})
+		}
+	}
+
+	if flags&variantFlagComparisons > 0 {
+		count, err := reader.readUint16()
+		if err != nil {
+			return err
+		}
+
+		for i := 0; i < count; i++ {
+			greater, err := reader.readPosition()
+			if err != nil {
+				return err
+			}
+
+			lesser, err := reader.readPosition()
+			if err != nil {
+				return err
+			}
+
+			sudokuDto.Comparisons = append(sudokuDto.Comparisons, &models.SudokuComparisonDTO{
+				Greater: greater,
+				Lesser:  lesser,
+			})
+		}
+	}
+
+	if reader.byteIndex != len(reader.data) {
+		return errors.New("sudoku data contains unexpected bytes after variants information")
+	}
+
+	return nil
+}
+
+// readPositions reads amount of cell positions followed by the positions
+func (reader *variantsDataReader) readPositions() (models.GenericSlice[*models.SudokuCellPositionDTO], error) {
+	positions := models.GenericSlice[*models.SudokuCellPositionDTO]{}
+
+	count, err := reader.readUint16()
+	if err != nil {
+		return positions, err
+	}
+
+	for i := 0; i < count; i++ {
+		position, err := reader.readPosition()
+		if err != nil {
+			return positions, err
+		}
+
+		positions = append(positions, &position)
+	}
+
+	return positions, nil
+}
+
+// readPosition reads cell position as row and column
+func (reader *variantsDataReader) readPosition() (models.SudokuCellPositionDTO, error) {
+	row, err := reader.readUint16()
+	if err != nil {
+		return models.SudokuCellPositionDTO{}, err
+	}
+
+	column, err := reader.readUint16()
+	return models.SudokuCellPositionDTO{Row: row, Column: column}, err
+}
+
+// readUint16 reads next two bytes as a value
+func (reader *variantsDataReader) readUint16() (int, error) {
+	valueBytes, err := reader.readBytes(2)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(binary.BigEndian.Uint16(valueBytes)), nil
+}
+
+// readBytes reads next bytes of provided length
+func (reader *variantsDataReader) readBytes(length int) ([]byte, error) {
+	if reader.byteIndex+length > len(reader.data) {
+		return nil, errors.New("sudoku data does not contain complete variants information")
+	}
+
+	result := reader.data[reader.byteIndex : reader.byteIndex+length]
+	reader.byteIndex += length
+	return result, nil
+}
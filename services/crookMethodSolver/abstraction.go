@@ -1,22 +1,24 @@
 package crookMethodSolver
 
 import (
+	"context"
+	"log/slog"
+
 	"github.com/Michu8258/kangaroo/models"
-	"github.com/Michu8258/kangaroo/services/printer"
 )
 
 type CrookSolver struct {
-	Settings     *models.Settings
-	DebugPrinter printer.IPrinter
+	Settings *models.Settings
+	Logger   *slog.Logger
 }
 
 type ISudokuSolver interface {
-	Solve(sudoku *models.Sudoku) (result bool, errors []error)
+	Solve(ctx context.Context, sudoku *models.Sudoku) (result bool, errors []error)
 }
 
-func GetNewSudokuSolver(settings *models.Settings, debugPrinter printer.IPrinter) ISudokuSolver {
+func GetNewSudokuSolver(settings *models.Settings, logger *slog.Logger) ISudokuSolver {
 	return &CrookSolver{
-		Settings:     settings,
-		DebugPrinter: debugPrinter,
+		Settings: settings,
+		Logger:   logger,
 	}
 }
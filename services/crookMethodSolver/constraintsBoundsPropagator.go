@@ -0,0 +1,220 @@
+package crookMethodSolver
+
+import (
+	"math"
+
+	"github.com/Michu8258/kangaroo/helpers"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// applyConstraintsBounds narrows potential values of cells being part of additional
+// constraints (thermometers, arrows and comparisons) by computing minimum and maximum
+// value every cell can have. Propagation is repeated until potential values
+// stop changing. Returns true if any cell is left without a potential value
+// or if a cell with a value does not fit the bounds.
+func (solver *CrookSolver) applyConstraintsBounds(sudoku *models.Sudoku) bool {
+	if len(sudoku.Thermometers) < 1 && len(sudoku.Arrows) < 1 && len(sudoku.Comparisons) < 1 {
+		return false
+	}
+
+	for {
+		anyChange := false
+		anyConflict := false
+
+		for _, thermometer := range sudoku.Thermometers {
+			changed, conflict := solver.applyThermometerBounds(sudoku, thermometer)
+			anyChange = anyChange || changed
+			anyConflict = anyConflict || conflict
+		}
+
+		for _, arrow := range sudoku.Arrows {
+			changed, conflict := solver.applyArrowBounds(sudoku, arrow)
+			anyChange = anyChange || changed
+			anyConflict = anyConflict || conflict
+		}
+
+		for _, comparison := range sudoku.Comparisons {
+			changed, conflict := solver.applyComparisonBounds(sudoku, comparison)
+			anyChange = anyChange || changed
+			anyConflict = anyConflict || conflict
+		}
+
+		if anyConflict {
+			return true
+		}
+
+		if !anyChange {
+			return false
+		}
+	}
+}
+
+// applyThermometerBounds makes every cell on the thermometer greater than the lowest
+// possible value of the previous cell and lower than the highest possible value
+// of the next cell. Returns flags indicating change of potential values and conflict.
+func (solver *CrookSolver) applyThermometerBounds(sudoku *models.Sudoku,
+	thermometer *models.SudokuThermometer) (bool, bool) {
+
+	anyChange := false
+	cellsCount := len(thermometer.Cells)
+
+	lowerBound := 1
+	for _, cell := range thermometer.Cells {
+		changed, conflict := solver.restrictCellBounds(sudoku, cell, lowerBound, math.MaxInt)
+		if conflict {
+			return anyChange, true
+		}
+
+		anyChange = anyChange || changed
+		minimum, maximum := getCellBounds(cell)
+		if minimum > maximum {
+			return anyChange, true
+		}
+
+		lowerBound = minimum + 1
+	}
+
+	upperBound := math.MaxInt
+	for cellIndex := cellsCount - 1; cellIndex >= 0; cellIndex-- {
+		cell := thermometer.Cells[cellIndex]
+		changed, conflict := solver.restrictCellBounds(sudoku, cell, math.MinInt, upperBound)
+		if conflict {
+			return anyChange, true
+		}
+
+		anyChange = anyChange || changed
+		minimum, maximum := getCellBounds(cell)
+		if minimum > maximum {
+			return anyChange, true
+		}
+
+		upperBound = maximum - 1
+	}
+
+	return anyChange, false
+}
+
+// applyArrowBounds restricts the circle cell to the range of possible path sums and
+// every path cell to the range that still allows the sum to match the circle value.
+// Returns flags indicating change of potential values and conflict.
+func (solver *CrookSolver) applyArrowBounds(sudoku *models.Sudoku,
+	arrow *models.SudokuArrow) (bool, bool) {
+
+	if arrow.Circle == nil {
+		return false, false
+	}
+
+	pathMinimum, pathMaximum := 0, 0
+	for _, cell := range arrow.Cells {
+		minimum, maximum := getCellBounds(cell)
+		if minimum > maximum {
+			return false, true
+		}
+
+		pathMinimum += minimum
+		pathMaximum += maximum
+	}
+
+	anyChange, conflict := solver.restrictCellBounds(sudoku, arrow.Circle, pathMinimum, pathMaximum)
+	if conflict {
+		return anyChange, true
+	}
+
+	circleMinimum, circleMaximum := getCellBounds(arrow.Circle)
+	for _, cell := range arrow.Cells {
+		minimum, maximum := getCellBounds(cell)
+		otherCellsMinimum := pathMinimum - minimum
+		otherCellsMaximum := pathMaximum - maximum
+
+		changed, conflict := solver.restrictCellBounds(sudoku, cell,
+			circleMinimum-otherCellsMaximum, circleMaximum-otherCellsMinimum)
+		if conflict {
+			return anyChange, true
+		}
+
+		anyChange = anyChange || changed
+	}
+
+	return anyChange, false
+}
+
+// applyComparisonBounds makes the greater cell higher than the lowest possible value
+// of the lesser cell and the lesser cell lower than the highest possible value of
+// the greater cell. Returns flags indicating change of potential values and conflict.
+func (solver *CrookSolver) applyComparisonBounds(sudoku *models.Sudoku,
+	comparison *models.SudokuComparison) (bool, bool) {
+
+	if comparison.Greater == nil || comparison.Lesser == nil {
+		return false, false
+	}
+
+	lesserMinimum, lesserMaximum := getCellBounds(comparison.Lesser)
+	if lesserMinimum > lesserMaximum {
+		return false, true
+	}
+
+	anyChange, conflict := solver.restrictCellBounds(sudoku, comparison.Greater, lesserMinimum+1, math.MaxInt)
+	if conflict {
+		return anyChange, true
+	}
+
+	greaterMinimum, greaterMaximum := getCellBounds(comparison.Greater)
+	if greaterMinimum > greaterMaximum {
+		return anyChange, true
+	}
+
+	changed, conflict := solver.restrictCellBounds(sudoku, comparison.Lesser, math.MinInt, greaterMaximum-1)
+	return anyChange || changed, conflict
+}
+
+// restrictCellBounds removes potential values outside of provided range (inclusive).
+// In case of a cell with a value, the value is checked against the range. Returns
+// flags indicating change of potential values and conflict.
+func (solver *CrookSolver) restrictCellBounds(sudoku *models.Sudoku, cell *models.SudokuCell,
+	lowerBound int, upperBound int) (bool, bool) {
+
+	if cell.Value != nil {
+		return false, *cell.Value < lowerBound || *cell.Value > upperBound
+	}
+
+	if cell.PotentialValues == nil {
+		return false, false
+	}
+
+	restrictedPotentialValues := cell.PotentialValues.Where(func(potentialValue int) bool {
+		return potentialValue >= lowerBound && potentialValue <= upperBound
+	})
+
+	if len(restrictedPotentialValues) == len(*cell.PotentialValues) {
+		return false, false
+	}
+
+	solver.Logger.Debug("applied constraint bounds to potential values",
+		"lowerBound", lowerBound,
+		"upperBound", upperBound,
+		"potentialValues", *cell.PotentialValues,
+		helpers.GetCellLogAttr(sudoku, cell.Box, cell))
+
+	cell.PotentialValues = &restrictedPotentialValues
+	return true, len(restrictedPotentialValues) == 0
+}
+
+// getCellBounds returns minimum and maximum value the cell can have. For a cell
+// without any potential value minimum is greater than maximum.
+func getCellBounds(cell *models.SudokuCell) (int, int) {
+	if cell.Value != nil {
+		return *cell.Value, *cell.Value
+	}
+
+	if cell.PotentialValues == nil || len(*cell.PotentialValues) < 1 {
+		return math.MaxInt, math.MinInt
+	}
+
+	minimum, maximum := math.MaxInt, math.MinInt
+	for _, potentialValue := range *cell.PotentialValues {
+		minimum = min(minimum, potentialValue)
+		maximum = max(maximum, potentialValue)
+	}
+
+	return minimum, maximum
+}
@@ -46,9 +46,8 @@ func (solver *CrookSolver) restoreSnapshotFromGuessedValue(sudoku *models.Sudoku
 		return potVal != cellValueGuess.GuessedValue
 	})
 
-	solver.DebugPrinter.PrintDefault(fmt.Sprintf("Restored potential values snapshot. "+
-		"New potential values for the cell: %v", updatedPotentialValues))
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("restored potential values snapshot", "potentialValues", updatedPotentialValues,
+		helpers.GetCellLogAttr(sudoku, cellValueGuess.GuessedCell.Box, cellValueGuess.GuessedCell))
 
 	// we can assign it in guess object, because it holds reference to the actual cell
 	cellValueGuess.GuessedCell.PotentialValues = &updatedPotentialValues
@@ -72,8 +71,6 @@ func (solver *CrookSolver) designateSudokuGuess(sudoku *models.Sudoku) (
 		return false, nil, nil
 	}
 
-	solver.DebugPrinter.PrintDefault("Found cell suitable for guessing potential value of.")
-	solver.DebugPrinter.PrintNewLine()
 	potentialValuesSnapshot := solver.createPotentialValuesSnapshot(sudoku)
 
 	guess := &models.SudokuValueGuess{
@@ -83,11 +80,8 @@ func (solver *CrookSolver) designateSudokuGuess(sudoku *models.Sudoku) (
 		PotentialValuesSnapshot: potentialValuesSnapshot,
 	}
 
-	solver.DebugPrinter.PrintDefault(fmt.Sprintf("Created sudoku guess object. "+
-		"Cell %s, value selected as guess, %d.",
-		helpers.GetCellCoordinatesString(sudoku, cell.Box, cell, true),
-		guess.GuessedValue))
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("guessed cell value", "value", guess.GuessedValue,
+		"potentialValues", *cell.PotentialValues, helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 
 	return true, guess, nil
 }
@@ -107,17 +101,15 @@ func (solver *CrookSolver) findCellWithLowestPotentialValues(sudoku *models.Sudo
 
 				if subSudokuBoxCell.Value == nil && subSudokuBoxCell.PotentialValues != nil {
 					if len(*subSudokuBoxCell.PotentialValues) == 0 {
-						solver.DebugPrinter.PrintDefault("Found a cell with no potantial values " +
-							"during sudoku cell guess selection.")
-						solver.DebugPrinter.PrintNewLine()
+						solver.Logger.Debug("found cell with no potential values during guess selection",
+							helpers.GetCellLogAttr(sudoku, subSudokuBoxCell.Box, subSudokuBoxCell))
 
 						return nil, nil, nil
 					}
 
 					if len(*subSudokuBoxCell.PotentialValues) == 1 {
-						solver.DebugPrinter.PrintDefault("Found a cell with exactly one potantial value " +
-							"during sudoku cell guess selection.")
-						solver.DebugPrinter.PrintNewLine()
+						solver.Logger.Debug("found cell with single potential value during guess selection",
+							helpers.GetCellLogAttr(sudoku, subSudokuBoxCell.Box, subSudokuBoxCell))
 
 						return subSudokuBoxCell, &subSudokuBoxCell.Box.Id, nil
 					}
@@ -143,9 +135,7 @@ func (solver *CrookSolver) findCellWithLowestPotentialValues(sudoku *models.Sudo
 	}
 
 	if sudokuCell == nil {
-		solver.DebugPrinter.PrintDefault("Did not find any cell suitable for guessing - " +
-			"perhaps there is no cell with potential values.")
-		solver.DebugPrinter.PrintNewLine()
+		solver.Logger.Debug("no cell suitable for guessing found")
 	}
 
 	return sudokuCell, subSudokuId, nil
@@ -171,10 +161,7 @@ func (solver *CrookSolver) createPotentialValuesSnapshot(sudoku *models.Sudoku)
 		}
 	}
 
-	solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-		"Built snapshot for sudoku potantial values. Snapshot length: %v",
-		len(snapshot)))
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("created potential values snapshot", "cells", len(snapshot))
 
 	return snapshot
 }
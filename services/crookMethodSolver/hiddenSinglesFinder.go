@@ -0,0 +1,98 @@
+package crookMethodSolver
+
+import (
+	"github.com/Michu8258/kangaroo/helpers"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// assignHiddenSingles searches every box and line of every sub-sudoku for values that
+// can be placed in only one cell of the collection (the value is a potential value of
+// exactly one cell) and assigns those values. This is crucial for bigger sudokus where
+// cells usually have many potential values and rarely only one of them. Returns pair
+// of bools where FIRST indicates if at least one value was assigned, SECOND indicates
+// if there is a collection with a value that cannot be placed in any of its cells.
+func (solver *CrookSolver) assignHiddenSingles(sudoku *models.Sudoku) (bool, bool) {
+	anyValueAssigned := false
+	maximumValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
+
+	for _, subSudoku := range sudoku.SubSudokus {
+		collections := []models.GenericSlice[*models.SudokuCell]{}
+		for _, subSudokuBox := range subSudoku.Boxes {
+			collections = append(collections, subSudokuBox.Cells)
+		}
+
+		for _, subSudokuLine := range subSudoku.ChildLines {
+			collections = append(collections, subSudokuLine.Cells)
+		}
+
+		for _, collection := range collections {
+			valueAssigned, valueWithoutCell := solver.assignCollectionHiddenSingles(
+				sudoku, collection, maximumValue)
+
+			if valueWithoutCell {
+				return anyValueAssigned, true
+			}
+
+			anyValueAssigned = anyValueAssigned || valueAssigned
+		}
+	}
+
+	return anyValueAssigned, false
+}
+
+// assignCollectionHiddenSingles assigns values that can be placed only in one cell of
+// the cells collection (box, row or column). Returns pair of bools where FIRST indicates
+// if at least one value was assigned, SECOND indicates if there is a value that is neither
+// present in the collection nor is a potential value of any of collection cells.
+func (solver *CrookSolver) assignCollectionHiddenSingles(sudoku *models.Sudoku,
+	collection models.GenericSlice[*models.SudokuCell], maximumValue int) (bool, bool) {
+
+	valueAssigned := false
+	valuePlaced := make([]bool, maximumValue+1)
+	candidateCells := make([]*models.SudokuCell, maximumValue+1)
+	candidatesCount := make([]int, maximumValue+1)
+
+	for _, cell := range collection {
+		if cell.Value != nil {
+			if *cell.Value >= 1 && *cell.Value <= maximumValue {
+				valuePlaced[*cell.Value] = true
+			}
+			continue
+		}
+
+		if cell.PotentialValues == nil {
+			continue
+		}
+
+		for _, potentialValue := range *cell.PotentialValues {
+			candidatesCount[potentialValue]++
+			candidateCells[potentialValue] = cell
+		}
+	}
+
+	for value := 1; value <= maximumValue; value++ {
+		if valuePlaced[value] {
+			continue
+		}
+
+		if candidatesCount[value] == 0 {
+			solver.Logger.Debug("value can not be placed in any cell of the collection", "value", value)
+			return valueAssigned, true
+		}
+
+		cell := candidateCells[value]
+		if candidatesCount[value] > 1 || cell.Value != nil {
+			continue
+		}
+
+		assignedValue := value
+		cell.Value = &assignedValue
+		cell.PotentialValues = nil
+		valueAssigned = true
+
+		solver.Logger.Debug("assigned hidden single value", "value", assignedValue,
+			helpers.GetCellLogAttr(sudoku, cell.Box, cell))
+	}
+
+	return valueAssigned, false
+}
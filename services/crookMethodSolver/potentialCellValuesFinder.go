@@ -2,8 +2,6 @@ package crookMethodSolver
 
 import (
 	"errors"
-	"fmt"
-	"slices"
 
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
@@ -18,7 +16,7 @@ func (solver *CrookSolver) assignCellsPotentialValues(sudoku *models.Sudoku) (bo
 	errs := []error{}
 	anyPotentialValuesSliceIsEmpty := false
 	minimumValue := 1
-	maximumValue := int(sudoku.BoxSize * sudoku.BoxSize)
+	maximumValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
 
 	for _, subSudoku := range sudoku.SubSudokus {
 		for _, subSudokuBox := range subSudoku.Boxes {
@@ -71,10 +69,12 @@ func (solver *CrookSolver) assignCellsPotentialValues(sudoku *models.Sudoku) (bo
 
 	}
 
-	if solver.Settings.UseDebugPrints {
-		solver.printPotentialValues(sudoku, "POTENTIAL VALUES FINDER")
+	if !anyPotentialValuesSliceIsEmpty && len(errs) < 1 {
+		anyPotentialValuesSliceIsEmpty = solver.applyConstraintsBounds(sudoku)
 	}
 
+	solver.logPotentialValues(sudoku, "potential values finder")
+
 	return anyPotentialValuesSliceIsEmpty, errs
 }
 
@@ -95,32 +95,39 @@ func (solver *CrookSolver) findPotentialValuesForCell(sudoku *models.Sudoku, cel
 		}
 	}()
 
-	siblingCellsWithValues := cellsCollection.Where(func(c *models.SudokuCell) bool {
-		return c.Id != cell.Id && c.Value != nil
-	})
-
-	takenValues := make(models.GenericSlice[int], 0, len(siblingCellsWithValues))
-	for _, siblingCell := range siblingCellsWithValues {
-		takenValues = append(takenValues, *siblingCell.Value)
-	}
-
-	potentialValues := make(models.GenericSlice[int], 0, maximumCellValue+1-minimumCellValue-len(takenValues))
-	for i := minimumCellValue; i <= maximumCellValue; i++ {
-		if !slices.Contains(takenValues, i) {
-			potentialValues = append(potentialValues, i)
+	// values are marked in a flags slice (instead of searching slices) because
+	// in case of big sudokus this function is called very frequently
+	takenValues := make([]bool, maximumCellValue+1)
+	for _, siblingCell := range cellsCollection {
+		if siblingCell.Id != cell.Id && siblingCell.Value != nil &&
+			*siblingCell.Value >= minimumCellValue && *siblingCell.Value <= maximumCellValue {
+			takenValues[*siblingCell.Value] = true
 		}
 	}
 
 	if cell.PotentialValues == nil {
 		// this is first iteration for this cell
+		potentialValues := make(models.GenericSlice[int], 0, maximumCellValue+1-minimumCellValue)
+		for i := minimumCellValue; i <= maximumCellValue; i++ {
+			if !takenValues[i] {
+				potentialValues = append(potentialValues, i)
+			}
+		}
+
 		cell.PotentialValues = &potentialValues
 		solver.logNoPotentialValues(sudoku, cell)
 		return len(potentialValues) == 0, nil
 	}
 
 	// in case of another iteration for same cell, we need to merge potential values
-	// by taking a common items in both slices
-	intersection := cell.PotentialValues.Intersect(potentialValues)
+	// by taking only those that are not taken in current cells collection
+	intersection := make(models.GenericSlice[int], 0, len(*cell.PotentialValues))
+	for _, potentialValue := range *cell.PotentialValues {
+		if potentialValue < minimumCellValue || potentialValue > maximumCellValue || !takenValues[potentialValue] {
+			intersection = append(intersection, potentialValue)
+		}
+	}
+
 	cell.PotentialValues = &intersection
 	solver.logNoPotentialValues(sudoku, cell)
 
@@ -130,9 +137,7 @@ func (solver *CrookSolver) findPotentialValuesForCell(sudoku *models.Sudoku, cel
 // logNoPotentialValues log information about no potential values in
 func (solver *CrookSolver) logNoPotentialValues(sudoku *models.Sudoku, cell *models.SudokuCell) {
 	if cell.PotentialValues != nil && len(*cell.PotentialValues) == 0 {
-		solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-			"Found a cell %s with no potential values during assigning potential values.",
-			helpers.GetCellCoordinatesString(sudoku, cell.Box, cell, true)))
-		solver.DebugPrinter.PrintNewLine()
+		solver.Logger.Debug("found cell with no potential values",
+			helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 	}
 }
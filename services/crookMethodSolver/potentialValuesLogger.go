@@ -1,16 +1,20 @@
 package crookMethodSolver
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 
 	"github.com/Michu8258/kangaroo/models"
 )
 
-// printPotentialValues prints debug information to the console when called
-func (solver *CrookSolver) printPotentialValues(sudoku *models.Sudoku, title string) {
-	solver.DebugPrinter.PrintDefault(
-		fmt.Sprintf("==================== POTENTIAL VALUES | %s | ====================", title))
-	solver.DebugPrinter.PrintNewLine()
+// logPotentialValues logs values and potential values of all sudoku cells,
+// one record per sudoku row, if debug level is enabled
+func (solver *CrookSolver) logPotentialValues(sudoku *models.Sudoku, stage string) {
+	if !solver.Logger.Enabled(context.Background(), slog.LevelDebug) {
+		return
+	}
 
 	cellValuePrinter := func(v *int) string {
 		if v == nil {
@@ -35,6 +39,8 @@ func (solver *CrookSolver) printPotentialValues(sudoku *models.Sudoku, title str
 
 	for boxRowIndex = 0; boxRowIndex < sudoku.Layout.Height; boxRowIndex++ {
 		for cellRowIndex = 0; cellRowIndex < sudoku.BoxSize; cellRowIndex++ {
+			representations := []string{}
+
 			for boxColumnIndex = 0; boxColumnIndex < sudoku.Layout.Width; boxColumnIndex++ {
 				sudokuBox := sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBox) bool {
 					return box.IndexColumn == int8(boxColumnIndex) && box.IndexRow == boxRowIndex
@@ -45,27 +51,16 @@ func (solver *CrookSolver) printPotentialValues(sudoku *models.Sudoku, title str
 						return cell.IndexColumnInBox == int8(cellColumnIndex) && cell.IndexRowInBox == cellRowIndex
 					})
 
-					representation := fmt.Sprintf("%s %v",
+					representations = append(representations, fmt.Sprintf("%s %v",
 						cellValuePrinter(sudokuCell.Value),
-						potentialValuesPrinter(sudokuCell.PotentialValues))
-
-					if cellColumnIndex >= sudoku.BoxSize-1 {
-						solver.DebugPrinter.PrintDefault(fmt.Sprintf("%-25s", representation))
-					} else {
-						solver.DebugPrinter.PrintDefault(fmt.Sprintf("%-20s", representation))
-					}
+						potentialValuesPrinter(sudokuCell.PotentialValues)))
 				}
 			}
 
-			solver.DebugPrinter.PrintNewLine()
-		}
-
-		if boxRowIndex < sudoku.Layout.Height-1 {
-			solver.DebugPrinter.PrintNewLine()
+			solver.Logger.Debug("potential values",
+				"stage", stage,
+				"row", int(boxRowIndex)*int(sudoku.BoxSize)+int(cellRowIndex)+1,
+				"cells", strings.Join(representations, " | "))
 		}
 	}
-
-	solver.DebugPrinter.PrintDefault(
-		fmt.Sprintf("================== POTENTIAL VALUES END | %s | ====================", title))
-	solver.DebugPrinter.PrintNewLine()
 }
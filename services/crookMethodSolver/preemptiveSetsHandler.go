@@ -34,8 +34,7 @@ func (solver *CrookSolver) executePreemptiveSetsLogic(sudoku *models.Sudoku) (bo
 	anyPreemptiveSetHandled := false
 	anyCellWithEmptyPotentialValues := false
 
-	solver.DebugPrinter.PrintDefault("Starting preemptive sets logic execution.")
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("preemptive sets logic started")
 
 	for _, subSudoku := range sudoku.SubSudokus {
 		// for every box in the subsudoku we want to take care of preemptive sets
@@ -76,15 +75,11 @@ func (solver *CrookSolver) executePreemptiveSetsLogic(sudoku *models.Sudoku) (bo
 		}
 	}
 
-	solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-		"Finished preemptive sets logic execution. Any set processed: %v, "+
-			"any cell with zero potential values: %v.",
-		anyPreemptiveSetHandled, anyCellWithEmptyPotentialValues))
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("preemptive sets logic finished",
+		"anySetProcessed", anyPreemptiveSetHandled,
+		"anyCellWithNoPotentialValues", anyCellWithEmptyPotentialValues)
 
-	if solver.Settings.UseDebugPrints {
-		solver.printPotentialValues(sudoku, "PREEMPTIVE SETS HANDLER - FINISH")
-	}
+	solver.logPotentialValues(sudoku, "preemptive sets handler finish")
 
 	return anyPreemptiveSetHandled, anyCellWithEmptyPotentialValues, nil
 }
@@ -209,16 +204,12 @@ func (solver *CrookSolver) findShortestPreemptiveSet(sudoku *models.Sudoku,
 			Values:               *preemptiveSetCells[0].PotentialValues,
 		}
 
-		solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-			"Found the preemptive set in %s with values %v. Cell %s. Cells Total: %d, cells in set: %d. "+
-				"Collection type: '%s'.",
-			collectionType,
-			result.Values,
-			helpers.GetCellCoordinatesString(sudoku, result.CellsInSet[0].Box, result.CellsInSet[0], true),
-			len(result.WholeCollectionCells),
-			len(result.CellsInSet),
-			collectionType))
-		solver.DebugPrinter.PrintNewLine()
+		solver.Logger.Debug("found preemptive set",
+			"collection", collectionType,
+			"values", result.Values,
+			helpers.GetCellLogAttr(sudoku, result.CellsInSet[0].Box, result.CellsInSet[0]),
+			"collectionSize", len(result.WholeCollectionCells),
+			"setSize", len(result.CellsInSet))
 
 		return result
 	}
@@ -248,41 +239,31 @@ func (solver *CrookSolver) processPreemptiveSet(sudoku *models.Sudoku, preemptiv
 			// in case there is not change in potential values in the cell
 			// we may skip assignment
 			if cell.PotentialValues.EqualContent(truncatedPotentialValues) {
-				solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-					"Skipping replacement of potential values %v - no change in potential values. "+
-						"Cell %s.",
-					*cell.PotentialValues,
-					helpers.GetCellCoordinatesString(sudoku, cell.Box, cell, true)))
-				solver.DebugPrinter.PrintNewLine()
+				solver.Logger.Debug("potential values not changed by preemptive set",
+					"potentialValues", *cell.PotentialValues,
+					helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 
 				continue
 			}
 
 			if len(truncatedPotentialValues) < 1 {
 				anyCellWithEmptyPotentialValues = true
-				solver.DebugPrinter.PrintDefault("Removing potential values from sibling cell of preemptive " +
-					"cells leads to leaving no potential values for the cell.")
-				solver.DebugPrinter.PrintNewLine()
+				solver.Logger.Debug("preemptive set leaves no potential values for the cell",
+					helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 			}
 
-			solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-				"Replacing existing potential values %v, with truncated slice %v. Cell %s.",
-				*cell.PotentialValues,
-				truncatedPotentialValues,
-				helpers.GetCellCoordinatesString(sudoku, cell.Box, cell, true)))
-			solver.DebugPrinter.PrintNewLine()
+			solver.Logger.Debug("potential values truncated by preemptive set",
+				"potentialValues", *cell.PotentialValues,
+				"truncatedPotentialValues", truncatedPotentialValues,
+				helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 
 			cell.PotentialValues = &truncatedPotentialValues
 			appliedAnyPotentialValuesChange = true
-
-			solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-				"Potential values of cell after replacement: %v.", *cell.PotentialValues))
-			solver.DebugPrinter.PrintNewLine()
 		}
 	}
 
-	if appliedAnyPotentialValuesChange && solver.Settings.UseDebugPrints {
-		solver.printPotentialValues(sudoku, "PREEMPTIVE SETS HANDLER - PROCESSING UPDATE")
+	if appliedAnyPotentialValuesChange {
+		solver.logPotentialValues(sudoku, "preemptive sets handler update")
 	}
 
 	return anyCellWithEmptyPotentialValues, appliedAnyPotentialValuesChange
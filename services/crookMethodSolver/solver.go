@@ -1,6 +1,7 @@
 package crookMethodSolver
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -8,6 +9,7 @@ import (
 )
 
 type sudokuRecursionData struct {
+	Ctx            context.Context
 	Sudoku         *models.Sudoku
 	IsGuessing     bool
 	RecursionDepth int
@@ -21,16 +23,14 @@ type sudokuSolutionResult struct {
 // SolveWithCrookMethod tries to solve the sudoku puzzle by altering references which soduku model
 // (a parameter) is build with. Returns a boolean flag indicating if solution was found and is
 // correct, and slice of errors. Errors should not be printed to the user, they are actualy an
-// errors.
-func (solver *CrookSolver) Solve(sudoku *models.Sudoku) (result bool, errors []error) {
+// errors. The solution is stopped with failure and context error when the context is done.
+func (solver *CrookSolver) Solve(ctx context.Context, sudoku *models.Sudoku) (result bool, errors []error) {
 
 	startTime := time.Now()
 
 	defer func() {
-		duration := time.Since(startTime)
-		solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-			"CROOK's method solution duration: %v", duration))
-		solver.DebugPrinter.PrintNewLine()
+		solver.Logger.Debug("Crook's method solution finished",
+			"duration", time.Since(startTime), "result", sudoku.Result)
 
 		if err := recover(); err != nil {
 			result = false
@@ -40,6 +40,7 @@ func (solver *CrookSolver) Solve(sudoku *models.Sudoku) (result bool, errors []e
 	}()
 
 	solutionResult := solver.executeRecursiveSolution(sudokuRecursionData{
+		Ctx:            ctx,
 		Sudoku:         sudoku,
 		IsGuessing:     false,
 		RecursionDepth: 0,
@@ -52,18 +53,20 @@ func (solver *CrookSolver) Solve(sudoku *models.Sudoku) (result bool, errors []e
 
 // executeRecursiveSolution is the actual method that executes Sudoku puzzle solution with
 // Crook's algorithm.  It returns and object with collections of errors and result status
-// (successfull solution/failure/invalid guess/unsolvable sudoku)
+// (successfull solution/failure/invalid guess/unsolvable sudoku). Failure is returned
+// when the context of the solution is done.
 func (solver *CrookSolver) executeRecursiveSolution(recursionData sudokuRecursionData) sudokuSolutionResult {
-	defer func() {
-		solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-			"REACHED END OF THE CROOK'S RECURSIVE METHOD. RETUNING UNSOLVABLE SUDOKU - DEPTH: %v",
-			recursionData.RecursionDepth))
-		solver.DebugPrinter.PrintNewLine()
-	}()
+	logger := solver.Logger.With("depth", recursionData.RecursionDepth)
+	logger.Debug("recursive solution started", "guessing", recursionData.IsGuessing)
+	defer logger.Debug("recursive solution finished")
 
-	solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-		"RECURSIVE SOLUTION CROOK - DEPTH: %v", recursionData.RecursionDepth))
-	solver.DebugPrinter.PrintNewLine()
+	// every guess starts new recursion, so the solution is stopped between guesses
+	if err := recursionData.Ctx.Err(); err != nil {
+		return sudokuSolutionResult{
+			ResultType: models.Failure,
+			Errors:     []error{err},
+		}
+	}
 
 	// simple sudokus that can be hamdled with pure elimination logic
 	solved, shortCircuitResult, result := solver.executeSimpleAlgorithm(recursionData)
@@ -85,8 +88,7 @@ func (solver *CrookSolver) executeRecursiveSolution(recursionData sudokuRecursio
 		atLeastOneValueAssigned := setManagedSuccessfully && solver.assignCertainValues(recursionData.Sudoku)
 
 		if atLeastOneCellWithNoPotentialValues {
-			solver.DebugPrinter.PrintDefault("At least one cell with no potential value found.")
-			solver.DebugPrinter.PrintNewLine()
+			logger.Debug("found cell with no potential values")
 
 			var result models.SudokuResultType = models.InvalidGuess
 			if !recursionData.IsGuessing {
@@ -100,13 +102,13 @@ func (solver *CrookSolver) executeRecursiveSolution(recursionData sudokuRecursio
 		}
 
 		if !setManagedSuccessfully && !atLeastOneValueAssigned {
-			solver.DebugPrinter.PrintDefault("No preemptive set successfully processed (probably not found).")
-			solver.DebugPrinter.PrintNewLine()
+			logger.Debug("no preemptive set processed")
 			break
 		}
 
 		if atLeastOneValueAssigned {
 			return solver.executeRecursiveSolution(sudokuRecursionData{
+				Ctx:            recursionData.Ctx,
 				Sudoku:         recursionData.Sudoku,
 				IsGuessing:     recursionData.IsGuessing,
 				RecursionDepth: recursionData.RecursionDepth + 1,
@@ -164,6 +166,7 @@ func (solver *CrookSolver) executeRecursiveSolution(recursionData sudokuRecursio
 
 		solver.applySudokuValueGuess(cellValueGuess)
 		nestedIterationResult := solver.executeRecursiveSolution(sudokuRecursionData{
+			Ctx:            recursionData.Ctx,
 			Sudoku:         recursionData.Sudoku,
 			IsGuessing:     true,
 			RecursionDepth: recursionData.RecursionDepth + 1,
@@ -269,8 +272,18 @@ func (solver *CrookSolver) executeEliminationsLogic(sudoku *models.Sudoku) (bool
 			return false, true, []error{}
 		}
 
-		// try to assign certain values
+		// try to assign certain values, and if there are none, values
+		// that fit only one cell of a box, row or column
 		atLeastOneValueAssigned := solver.assignCertainValues(sudoku)
+		if !atLeastOneValueAssigned {
+			hiddenSingleAssigned, anyValueWithNoCell := solver.assignHiddenSingles(sudoku)
+			if anyValueWithNoCell {
+				return false, true, []error{}
+			}
+
+			atLeastOneValueAssigned = hiddenSingleAssigned
+		}
+
 		if atLeastOneValueAssigned {
 			assignmentsExhausted = false
 			allCellsFilled := solver.checkIfAllCellsHaveValues(sudoku)
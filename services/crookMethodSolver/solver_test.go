@@ -1,12 +1,18 @@
 package crookMethodSolver
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/services/sudokuInit"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
@@ -36,22 +42,30 @@ func TestSolve(t *testing.T) {
 			sourceFilePath:  "../../testConfigs/5x5boxes.json",
 			resultsFilePath: "../../testConfigs/5x5boxes_solution.json",
 		},
+		{
+			sourceFilePath:  "../../testConfigs/thermoArrow1.json",
+			resultsFilePath: "../../testConfigs/thermoArrow1_solution.json",
+		},
+		{
+			sourceFilePath:  "../../testConfigs/comparison1.json",
+			resultsFilePath: "../../testConfigs/comparison1_solution.json",
+		},
 	}
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
 		settings.UseDebugPrints = true
-		debugPrinter := testHelpers.NewTestPrinter()
+		logs := &bytes.Buffer{}
 
 		source := getSudoku(t, testCase.sourceFilePath)
 		expectedResult := getSudoku(t, testCase.resultsFilePath)
 
-		initializer := sudokuInit.GetNewSudokuInit(settings)
+		initializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
 		initializer.InitializeSudoku(source)
 
-		solver := GetNewSudokuSolver(settings, debugPrinter)
+		solver := GetNewSudokuSolver(settings, logger.New(settings, logs))
 
-		result, errors := solver.Solve(source)
+		result, errors := solver.Solve(context.Background(), source)
 
 		if !result {
 			t.Errorf("Failed to solve the sudoku in file '%s'.", testCase.sourceFilePath)
@@ -64,6 +78,13 @@ func TestSolve(t *testing.T) {
 			}
 		}
 
+		for _, expectedLog := range []string{"level=DEBUG", "depth=0", "cell.row=", "cell.column="} {
+			if !strings.Contains(logs.String(), expectedLog) {
+				t.Errorf("Logs of the solution of the sudoku in file '%s' do not contain '%s'.",
+					testCase.sourceFilePath, expectedLog)
+			}
+		}
+
 		areEqual := compareSudokus(t, expectedResult, source)
 		if !areEqual {
 			t.Errorf(
@@ -73,6 +94,67 @@ func TestSolve(t *testing.T) {
 	}
 }
 
+func TestSolve_BigSudoku(t *testing.T) {
+	sourceFilePath := "../../testConfigs/6x6boxSize.json"
+	resultsFilePath := "../../testConfigs/6x6boxSize_solution.json"
+
+	settings := testHelpers.GetTestSettings()
+	settings.MaximumBoxSizeInclusive = models.SupportedMaximumBoxSize
+	settings.MaximumLayoutSizeInclusive = models.SupportedMaximumLayoutSize
+
+	source := getSudoku(t, sourceFilePath)
+	expectedResult := getSudoku(t, resultsFilePath)
+
+	initializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
+	_, errs := initializer.InitializeSudoku(source)
+	if len(errs) > 0 {
+		t.Fatalf("Failed to initialize the sudoku in file '%s'.", sourceFilePath)
+	}
+
+	solver := GetNewSudokuSolver(settings, logger.NewDiscardLogger())
+
+	startTime := time.Now()
+	result, errors := solver.Solve(context.Background(), source)
+	duration := time.Since(startTime)
+
+	if !result || len(errors) > 0 {
+		t.Fatalf("Failed to solve the sudoku in file '%s', errors: %v.", sourceFilePath, errors)
+	}
+
+	if duration > 5*time.Second {
+		t.Errorf("Solution of the sudoku in file '%s' took too long: %v.", sourceFilePath, duration)
+	}
+
+	if !compareSudokus(t, expectedResult, source) {
+		t.Errorf(
+			"Sudoku has invalid solition. Source file: '%s', Expected result file: '%s'.",
+			sourceFilePath, resultsFilePath)
+	}
+}
+
+func TestSolve_CancelledContext(t *testing.T) {
+	sourceFilePath := "../../testConfigs/hard1.json"
+	settings := testHelpers.GetTestSettings()
+
+	source := getSudoku(t, sourceFilePath)
+	initializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
+	initializer.InitializeSudoku(source)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	solver := GetNewSudokuSolver(settings, logger.NewDiscardLogger())
+	result, errs := solver.Solve(ctx, source)
+
+	if result || source.Result != models.Failure {
+		t.Errorf("Expected failed solution of the sudoku in file '%s', got %s.", sourceFilePath, source.Result)
+	}
+
+	if len(errs) != 1 || !errors.Is(errs[0], context.Canceled) {
+		t.Errorf("Expected context cancellation error, got %v.", errs)
+	}
+}
+
 func compareSudokus(t *testing.T, expected *models.Sudoku, actual *models.Sudoku) bool {
 	for _, expectedBox := range expected.Boxes {
 		boxIndex := slices.Index(expected.Boxes, expectedBox)
@@ -45,16 +45,34 @@ func (solver *CrookSolver) validateSudokuRules(sudoku *models.Sudoku) (bool, err
 	} else if iterationError != nil {
 		return false, iterationError
 	} else {
-		return true, nil
+		return !solver.checkConstraintsViolation(sudoku), nil
 	}
 }
 
+// checkConstraintsViolation returns true if any of additional constraints
+// (thermometers, arrows, comparisons) is violated
+func (solver *CrookSolver) checkConstraintsViolation(sudoku *models.Sudoku) bool {
+	thermometerViolated := sudoku.Thermometers.Any(func(thermometer *models.SudokuThermometer) bool {
+		return thermometer.HasRuleViolation()
+	})
+
+	arrowViolated := sudoku.Arrows.Any(func(arrow *models.SudokuArrow) bool {
+		return arrow.HasRuleViolation()
+	})
+
+	comparisonViolated := sudoku.Comparisons.Any(func(comparison *models.SudokuComparison) bool {
+		return comparison.HasRuleViolation()
+	})
+
+	return thermometerViolated || arrowViolated || comparisonViolated
+}
+
 // checkRuleViolation returns true if the rule is violated (broken)
 func (solver *CrookSolver) checkRuleViolation(sudoku *models.Sudoku,
 	cells models.GenericSlice[*models.SudokuCell]) bool {
 
 	minValue := 1
-	maxValue := int(sudoku.BoxSize * sudoku.BoxSize)
+	maxValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
 
 	cellsWithValues := cells.Where(func(cell *models.SudokuCell) bool {
 		return cell.Value != nil
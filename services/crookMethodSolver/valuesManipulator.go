@@ -1,8 +1,6 @@
 package crookMethodSolver
 
 import (
-	"fmt"
-
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
 )
@@ -14,8 +12,7 @@ import (
 func (solver *CrookSolver) assignCertainValues(sudoku *models.Sudoku) bool {
 	valuesAssigned := 0
 
-	solver.DebugPrinter.PrintDefault("Starting certain values assignment - based of potential values.")
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("certain values assignment started")
 
 	for _, subSudoku := range sudoku.SubSudokus {
 		for _, subSudokuBox := range subSudoku.Boxes {
@@ -26,27 +23,14 @@ func (solver *CrookSolver) assignCertainValues(sudoku *models.Sudoku) bool {
 					subSudokuBoxCell.PotentialValues = nil
 					valuesAssigned += 1
 
-					solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-						"Assigned certain cell value: %v. Cell %s.",
-						*subSudokuBoxCell.Value,
-						helpers.GetCellCoordinatesString(sudoku, subSudokuBoxCell.Box, subSudokuBoxCell, true)))
-					solver.DebugPrinter.PrintNewLine()
+					solver.Logger.Debug("assigned certain value", "value", *subSudokuBoxCell.Value,
+						helpers.GetCellLogAttr(sudoku, subSudokuBoxCell.Box, subSudokuBoxCell))
 				}
 			}
 		}
 	}
 
-	if solver.Settings.UseDebugPrints {
-		if valuesAssigned >= 1 {
-			solver.DebugPrinter.PrintDefault(fmt.Sprintf(
-				"Certain values assignment finished - assigned values count: %v",
-				valuesAssigned))
-			solver.DebugPrinter.PrintNewLine()
-		} else {
-			solver.DebugPrinter.PrintDefault("Certain values assignment finished - no value assigned")
-			solver.DebugPrinter.PrintNewLine()
-		}
-	}
+	solver.Logger.Debug("certain values assignment finished", "assignedValues", valuesAssigned)
 
 	return valuesAssigned >= 1
 }
@@ -64,8 +48,7 @@ func (solver *CrookSolver) checkIfAllCellsHaveValues(sudoku *models.Sudoku) bool
 		}
 	}
 
-	solver.DebugPrinter.PrintDefault("It appears that the Sudoku has all cells filled with values.")
-	solver.DebugPrinter.PrintNewLine()
+	solver.Logger.Debug("all cells have values")
 
 	return true
 }
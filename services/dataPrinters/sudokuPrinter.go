@@ -3,13 +3,14 @@ package dataPrinters
 import (
 	"fmt"
 	"math"
-	"strconv"
 
+	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services/printer"
 )
 
 type sudokuPrintoutConfig struct {
+	Alphabet              *models.SymbolAlphabet
 	ValueCharactersLength int
 	MaxIndex              int
 	CharactersPerLine     int
@@ -37,7 +38,7 @@ func (dp *DataPrinter) PrintSudoku(sudoku *models.Sudoku, printer printer.IPrint
 		for cellRowIndex = 0; cellRowIndex < sudoku.BoxSize; cellRowIndex++ {
 			dp.printValuesLine(sudoku, printer, printoutConfig, int8(boxRowIndex), int8(cellRowIndex))
 			if cellRowIndex < sudoku.BoxSize-1 {
-				dp.printMidCellsLine(sudoku, printer, printoutConfig, boxRowIndex)
+				dp.printMidCellsLine(sudoku, printer, printoutConfig, boxRowIndex, cellRowIndex)
 			}
 		}
 
@@ -71,8 +72,9 @@ func (dp *DataPrinter) printBottomBorderLine(sudoku *models.Sudoku,
 }
 
 // printMidCellsLine prints line of a sudoku puzzle that appears between cells
+// (including comparison signs between vertically adjacent cells)
 func (dp *DataPrinter) printMidCellsLine(sudoku *models.Sudoku, printer printer.IPrinter,
-	printoutConfig sudokuPrintoutConfig, boxRowIndex int8) {
+	printoutConfig sudokuPrintoutConfig, boxRowIndex int8, cellRowIndex int8) {
 
 	printer.PrintBorder("║")
 
@@ -93,8 +95,22 @@ func (dp *DataPrinter) printMidCellsLine(sudoku *models.Sudoku, printer printer.
 			if boxColumnIndex > 0 {
 				printer.PrintBorder(middleSign)
 			}
+
+			upperCellPosition := models.SudokuCellPosition{
+				Row:    helpers.GetAbsoluteCellIndex(sudoku.BoxSize, boxRowIndex, cellRowIndex),
+				Column: helpers.GetAbsoluteCellIndex(sudoku.BoxSize, sudokuBoxIndex, boxColumnIndex),
+			}
+			lowerCellPosition := models.SudokuCellPosition{
+				Row:    upperCellPosition.Row + 1,
+				Column: upperCellPosition.Column,
+			}
+			signIndex := printoutConfig.Padding + (printoutConfig.ValueCharactersLength-1)/2
+
 			for characterIndex := 0; characterIndex < printoutConfig.ValueCharactersLength+printoutConfig.Padding*2; characterIndex++ {
-				printer.PrintBorder(middleSign)
+				if characterIndex != signIndex ||
+					!dp.printComparisonSign(sudoku, printer, upperCellPosition, lowerCellPosition, "v", "^") {
+					printer.PrintBorder(middleSign)
+				}
 			}
 		}
 
@@ -120,17 +136,24 @@ func (dp *DataPrinter) printValuesLine(sudoku *models.Sudoku, printer printer.IP
 
 		for cellColumnIndex := 0; cellColumnIndex < printoutConfig.BoxSize; cellColumnIndex++ {
 			if cellColumnIndex > 0 {
+				leftCellPosition := models.SudokuCellPosition{
+					Row:    helpers.GetAbsoluteCellIndex(sudoku.BoxSize, boxRowIndex, cellRowIndex),
+					Column: boxColumnIndex*printoutConfig.BoxSize + cellColumnIndex - 1,
+				}
+				rightCellPosition := models.SudokuCellPosition{
+					Row:    leftCellPosition.Row,
+					Column: leftCellPosition.Column + 1,
+				}
+
 				if sudokuBox.Disabled {
 					printer.PrintDefault(" ")
-				} else {
+				} else if !dp.printComparisonSign(sudoku, printer, leftCellPosition, rightCellPosition, ">", "<") {
 					printer.PrintBorder("│")
 				}
 			}
 
 			if sudokuBox.Disabled {
-				for characterIndex := 0; characterIndex < printoutConfig.ValueCharactersLength; characterIndex++ {
-					dp.printNoValuePlaceholder(printoutConfig, printer)
-				}
+				dp.printNoValuePlaceholder(printoutConfig, printer)
 			} else {
 				sudokuCell := sudokuBox.Cells.FirstOrDefault(nil, func(cell *models.SudokuCell) bool {
 					return cell.IndexColumnInBox == int8(cellColumnIndex) && cell.IndexRowInBox == cellRowIndex
@@ -155,6 +178,32 @@ func (dp *DataPrinter) printValuesLine(sudoku *models.Sudoku, printer printer.IP
 	printer.PrintNewLine()
 }
 
+// printComparisonSign prints inequality sign if there is a comparison defined
+// between cells with provided positions. Sign depends on which of the cells
+// is the greater one. Returns false if there is no comparison to print.
+func (dp *DataPrinter) printComparisonSign(sudoku *models.Sudoku, printer printer.IPrinter,
+	first models.SudokuCellPosition, second models.SudokuCellPosition,
+	firstGreaterSign string, firstLesserSign string) bool {
+
+	comparison := sudoku.FindComparison(first, second)
+	if comparison == nil {
+		return false
+	}
+
+	sign := firstLesserSign
+	if comparison.GreaterPosition == first {
+		sign = firstGreaterSign
+	}
+
+	if comparison.ViolatesRule {
+		printer.PrintError(sign)
+	} else {
+		printer.PrintPrimary(sign)
+	}
+
+	return true
+}
+
 // printSudokuValue prints out correctly formatter sudoku value
 func (dp *DataPrinter) printSudokuValue(sudokuCell *models.SudokuCell,
 	printoutConfig sudokuPrintoutConfig, printer printer.IPrinter) {
@@ -173,16 +222,9 @@ func (dp *DataPrinter) printSudokuValue(sudokuCell *models.SudokuCell,
 		printFunc = printer.PrintDefault
 	}
 
-	switch printoutConfig.ValueCharactersLength {
-	case 1:
-		printFunc(strconv.Itoa(*sudokuCell.Value))
-	case 2:
-		printFunc(fmt.Sprintf("%-2s", strconv.Itoa(*sudokuCell.Value)))
-	case 3:
-		printFunc(fmt.Sprintf("%-3s", strconv.Itoa(*sudokuCell.Value)))
-	default:
-		printFunc("x")
-	}
+	symbol := printoutConfig.Alphabet.GetSymbol(*sudokuCell.Value)
+
+	printFunc(fmt.Sprintf("%-*s", printoutConfig.ValueCharactersLength, symbol))
 }
 
 // printNoValuePlaceholder prints empty spaces with amount adjusted with characters
@@ -238,13 +280,15 @@ func (dp *DataPrinter) printHorizontalBorderLine(sudoku *models.Sudoku, printout
 // buildSudokuPrintoutConfig creates rintout configuration that is used to
 // actually make a printout of a sudoku
 func (dp *DataPrinter) buildSudokuPrintoutConfig(sudoku *models.Sudoku) sudokuPrintoutConfig {
-	valueCharactersLength := len(strconv.Itoa(int(sudoku.BoxSize * sudoku.BoxSize)))
+	alphabet := sudoku.GetSymbolAlphabet()
+	valueCharactersLength := alphabet.GetSymbolLength(int(sudoku.BoxSize) * int(sudoku.BoxSize))
 
-	valuesPerLine := int(sudoku.Layout.Width * sudoku.BoxSize)
+	valuesPerLine := int(sudoku.Layout.Width) * int(sudoku.BoxSize)
 	valuesCharactersCountPerLine := valuesPerLine * valueCharactersLength
 	separatorsCount := valuesPerLine + 1
 
 	return sudokuPrintoutConfig{
+		Alphabet:              alphabet,
 		ValueCharactersLength: valueCharactersLength,
 		MaxIndex:              int(math.Max(0, float64(sudoku.BoxSize-1))),
 		CharactersPerLine:     valuesCharactersCountPerLine + separatorsCount,
@@ -73,3 +73,104 @@ func TestPrintSudoku(t *testing.T) {
 		}
 	}
 }
+
+func TestPrintSudoku_Comparisons(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+
+	jsonBytes, _ := os.ReadFile("../../testConfigs/simple1.json")
+	sudokuDto := models.SudokuDTO{}
+	json.Unmarshal(jsonBytes, &sudokuDto)
+	sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			Lesser:  models.SudokuCellPositionDTO{Row: 0, Column: 1},
+		},
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 2},
+			Lesser:  models.SudokuCellPositionDTO{Row: 0, Column: 1},
+		},
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 3},
+			Lesser:  models.SudokuCellPositionDTO{Row: 1, Column: 3},
+		},
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 2, Column: 4},
+			Lesser:  models.SudokuCellPositionDTO{Row: 1, Column: 4},
+		},
+	}
+	sudoku := sudokuDto.ToSudoku()
+
+	expectedLines := []string{
+		"║   >   <   ║   │   │ 4 ║ 3 │ 1 │   ║",
+		"║───────────║─v─────────║───────────║",
+		"║───────────║─────^─────║───────────║",
+	}
+
+	dataPrinter := GetNewDataPrinter(settings, testPrinter)
+	dataPrinter.PrintSudoku(sudoku, testPrinter)
+
+	for _, expectedLine := range expectedLines {
+		if !strings.Contains(testPrinter.PrintedData, expectedLine) {
+			t.Errorf(
+				"Printed sudoku output does not contain required string: '%s'",
+				expectedLine)
+		}
+	}
+}
+
+func TestPrintSudoku_Alphabet(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+
+	jsonBytes, _ := os.ReadFile("../../testConfigs/simple1.json")
+	sudokuDto := models.SudokuDTO{}
+	json.Unmarshal(jsonBytes, &sudokuDto)
+	sudoku := sudokuDto.ToSudoku()
+	sudoku.Alphabet = "A-I"
+
+	expectedLines := []string{
+		"║   │   │   ║   │   │ D ║ C │ A │   ║",
+		"║ C │   │ I ║ B │ G │   ║ E │ F │   ║",
+		"║   │   │ D ║   │   │   ║   │   │ C ║",
+	}
+
+	dataPrinter := GetNewDataPrinter(settings, testPrinter)
+	dataPrinter.PrintSudoku(sudoku, testPrinter)
+
+	for _, expectedLine := range expectedLines {
+		if !strings.Contains(testPrinter.PrintedData, expectedLine) {
+			t.Errorf(
+				"Printed sudoku output does not contain required string: '%s'",
+				expectedLine)
+		}
+	}
+}
+
+func TestPrintSudoku_BigBoxSize(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	testPrinter := testHelpers.NewTestPrinter()
+
+	jsonBytes, _ := os.ReadFile("../../testConfigs/6x6boxSize_solution.json")
+	sudokuDto := models.SudokuDTO{}
+	json.Unmarshal(jsonBytes, &sudokuDto)
+	sudoku := sudokuDto.ToSudoku()
+	sudoku.Boxes[1].Disabled = true
+
+	expectedLines := []string{
+		"╔═════════════════════════════╦═════════════════════════════╦",
+		"║ 13 │ 4  │ 29 │ 18 │ 31 │ 27 ║                             ║ 21 │ 12 │ 28 │ 19 │ 3  │ 16 ║",
+		"║ 32 │ 30 │ 5  │ 26 │ 11 │ 8  ║                             ║ 23 │ 1  │ 15 │ 34 │ 33 │ 9  ║",
+	}
+
+	dataPrinter := GetNewDataPrinter(settings, testPrinter)
+	dataPrinter.PrintSudoku(sudoku, testPrinter)
+
+	for _, expectedLine := range expectedLines {
+		if !strings.Contains(testPrinter.PrintedData, expectedLine) {
+			t.Errorf(
+				"Printed sudoku output does not contain required string: '%s'",
+				expectedLine)
+		}
+	}
+}
@@ -1,31 +1,42 @@
 package dataReader
 
 import (
+	"log/slog"
+
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services/printer"
 	"github.com/Michu8258/kangaroo/services/prompts"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
 )
 
 type DataReader struct {
 	Settings        *models.Settings
 	TerminalPrinter printer.IPrinter
-	DebugPrinter    printer.IPrinter
+	Logger          *slog.Logger
 	Prompter        prompts.IPrompter
+	SudokuFormats   sudokuFormats.ISudokuFormats
 }
 
 type IDataReader interface {
 	ReadSudokuFromConsole(request *models.SudokuConfigRequest) (*models.SudokuDTO, error)
 	ReadSudokuFromJsonFile(path string) (*models.SudokuDTO, error)
+	ReadSudokuFromFile(path string, alphabet string) (*models.SudokuDTO, error)
+	ReadSudokusFromFile(path string, formatName string, alphabet string) ([]*models.SudokuDTO, error)
+	ReadSudokuFiles(path string, alphabet string) ([]*models.SudokuFile, error)
+	ReadSudokuGameFromFile(path string) (*models.SudokuGameDTO, error)
+	ReadSudokuFileData(path string) ([]byte, error)
 }
 
 func GetNewDataReader(settings *models.Settings,
 	terminalPrinter printer.IPrinter,
-	debugPrinter printer.IPrinter,
-	prompter prompts.IPrompter) IDataReader {
+	logger *slog.Logger,
+	prompter prompts.IPrompter,
+	formats sudokuFormats.ISudokuFormats) IDataReader {
 	return &DataReader{
 		Settings:        settings,
 		TerminalPrinter: terminalPrinter,
-		DebugPrinter:    debugPrinter,
+		Logger:          logger,
 		Prompter:        prompter,
+		SudokuFormats:   formats,
 	}
 }
@@ -13,7 +13,8 @@ import (
 func (reader *DataReader) ReadSudokuFromConsole(request *models.SudokuConfigRequest) (
 	*models.SudokuDTO, error) {
 
-	readError := errors.New("failed to read sudoku user data inputs")
+	readError := models.WithKind(models.ErrInvalidInput,
+		errors.New("failed to read sudoku user data inputs"))
 
 	boxSize, err := reader.Prompter.PromptGetBoxSize(request.BoxSize)
 	if err != nil {
@@ -35,10 +36,9 @@ func (reader *DataReader) ReadSudokuFromConsole(request *models.SudokuConfigRequ
 	request.LayoutHeight = &layoutHeight
 
 	sudokuDto := reader.buildEmptySudokuDTO(request)
-	err = reader.Prompter.PromptSudokuValues(sudokuDto)
+	err = reader.Prompter.PromptSudokuValues(sudokuDto, false)
 	if err != nil {
-		reader.DebugPrinter.PrintError(err.Error())
-		reader.DebugPrinter.PrintNewLine()
+		reader.Logger.Debug("sudoku values prompt failed", "error", err)
 		return nil, readError
 	}
 
@@ -47,42 +47,10 @@ func (reader *DataReader) ReadSudokuFromConsole(request *models.SudokuConfigRequ
 
 // buildEmptySudokuDTO builds sudokuDTO object based un user provided requirements
 func (reader *DataReader) buildEmptySudokuDTO(request *models.SudokuConfigRequest) *models.SudokuDTO {
-	sudokuDto := &models.SudokuDTO{
-		BoxSize: *request.BoxSize,
-		Layout: models.SudokuLayoutDTO{
-			Width:  *request.LayoutWidth,
-			Height: *request.LayoutHeight,
-		},
-		Boxes: models.GenericSlice[*models.SudokuBoxDTO]{},
-	}
-
-	var bowRowIndex int8 = 0
-	var boxColumnIndex int8 = 0
-
-	for bowRowIndex = 0; bowRowIndex < sudokuDto.Layout.Height; bowRowIndex++ {
-		for boxColumnIndex = 0; boxColumnIndex < sudokuDto.Layout.Width; boxColumnIndex++ {
-			sudokuBox := &models.SudokuBoxDTO{
-				Disabled:    false,
-				IndexRow:    bowRowIndex,
-				IndexColumn: boxColumnIndex,
-				Cells:       models.GenericSlice[*models.SudokuCellDTO]{},
-			}
-
-			var cellRowIndex int8 = 0
-			var cellColumnIndex int8 = 0
-
-			for cellRowIndex = 0; cellRowIndex < sudokuDto.BoxSize; cellRowIndex++ {
-				for cellColumnIndex = 0; cellColumnIndex < sudokuDto.BoxSize; cellColumnIndex++ {
-					sudokuBox.Cells = append(sudokuBox.Cells, &models.SudokuCellDTO{
-						Value:            nil,
-						IndexRowInBox:    cellRowIndex,
-						IndexColumnInBox: cellColumnIndex,
-					})
-				}
-			}
+	sudokuDto := models.NewEmptySudokuDTO(*request.BoxSize, *request.LayoutWidth, *request.LayoutHeight)
 
-			sudokuDto.Boxes = append(sudokuDto.Boxes, sudokuBox)
-		}
+	if request.Alphabet != nil {
+		sudokuDto.Alphabet = *request.Alphabet
 	}
 
 	return sudokuDto
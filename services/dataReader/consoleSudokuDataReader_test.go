@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -68,7 +69,6 @@ func TestReadSudokuFromConsole(t *testing.T) {
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
 		terminalPrinter := testHelpers.NewTestPrinter()
-		debugPrinter := testHelpers.NewTestPrinter()
 
 		var sudokuError error = nil
 		if testCase.sudokuPromptError {
@@ -115,7 +115,7 @@ func TestReadSudokuFromConsole(t *testing.T) {
 			LayoutSizePromptFunc:     &layoutPromptError,
 		})
 
-		dataReader := GetNewDataReader(settings, terminalPrinter, debugPrinter, prompter)
+		dataReader := GetNewDataReader(settings, terminalPrinter, logger.NewDiscardLogger(), prompter, nil)
 		sudokuDTO, error := dataReader.ReadSudokuFromConsole(testCase.requestConfig)
 
 		hasError := error != nil
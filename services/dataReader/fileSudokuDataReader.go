@@ -4,33 +4,192 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
 )
 
+// ReadSudokuFromFile reads raw sudoku data object from file with specified path.
+// If the file contains multiple sudokus (e.g. a collection of puzzles), the
+// first one is returned. Format of the file is detected as in ReadSudokusFromFile.
+func (reader *DataReader) ReadSudokuFromFile(path string, alphabet string) (*models.SudokuDTO, error) {
+	sudokus, err := reader.ReadSudokusFromFile(path, "", alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sudokus) > 1 {
+		reader.Logger.Warn("sudoku input file contains multiple sudokus, the first one is used",
+			"path", path, "count", len(sudokus))
+	}
+
+	return sudokus[0], nil
+}
+
+// ReadSudokusFromFile reads all raw sudoku data objects from file with specified
+// path in the format with provided name. Empty format name means that the format is
+// detected from the content, or chosen by the file extension if the content is
+// ambiguous. Alphabet is used to read values of text files. The path can be either
+// relative (to main.go) or absolute.
+func (reader *DataReader) ReadSudokusFromFile(path string, formatName string,
+	alphabet string) ([]*models.SudokuDTO, error) {
+
+	var format *sudokuFormats.SudokuFormat
+	if len(formatName) > 0 {
+		format = reader.SudokuFormats.GetFormatByName(formatName)
+		if format == nil || !format.CanRead() {
+			return nil, models.WithKind(models.ErrInvalidInput,
+				fmt.Errorf("sudoku can not be read from '%s' format", formatName))
+		}
+	}
+
+	absolutePath, sudokuDataBytes, err := readSudokuFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if format == nil {
+		format = reader.SudokuFormats.GetReadFormat(absolutePath, sudokuDataBytes)
+	}
+
+	reader.Logger.Debug("sudoku input file format resolved", "path", absolutePath, "format", format.Name)
+
+	return readSudokus(format, absolutePath, sudokuDataBytes, alphabet)
+}
+
+// ReadSudokuFiles reads all sudokus from the file with specified path, or from
+// all files of the directory with specified path (sorted by name, hidden files
+// and subdirectories are skipped). Formats of files are detected as in
+// ReadSudokusFromFile. Returns an error if any of the files can not be read.
+func (reader *DataReader) ReadSudokuFiles(path string, alphabet string) ([]*models.SudokuFile, error) {
+	absolutePath, err := helpers.MakeFilePathAbsolute(path)
+	if err != nil {
+		return nil, models.WithKind(models.ErrIO, err)
+	}
+
+	info, err := os.Stat(absolutePath)
+	if err != nil {
+		return nil, models.WithKind(models.ErrIO,
+			fmt.Errorf("sudoku input path '%s' does not exist", absolutePath))
+	}
+
+	filePaths := []string{absolutePath}
+	if info.IsDir() {
+		entries, err := os.ReadDir(absolutePath)
+		if err != nil {
+			return nil, models.WithKind(models.ErrIO,
+				fmt.Errorf("unable to read sudoku input directory '%s'", absolutePath))
+		}
+
+		// directory entries are sorted by name
+		filePaths = []string{}
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+
+			filePaths = append(filePaths, filepath.Join(absolutePath, entry.Name()))
+		}
+
+		if len(filePaths) < 1 {
+			return nil, models.WithKind(models.ErrInvalidInput,
+				fmt.Errorf("there are no files in sudoku input directory '%s'", absolutePath))
+		}
+	}
+
+	files := []*models.SudokuFile{}
+	for _, filePath := range filePaths {
+		sudokus, err := reader.ReadSudokusFromFile(filePath, "", alphabet)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, &models.SudokuFile{Path: filePath, Sudokus: sudokus})
+	}
+
+	return files, nil
+}
+
 // ReadFromJsonFile reads raw sudoku data object from file with specified path.
 // The path can be either relative (to main.go) or absolute.
 func (reader *DataReader) ReadSudokuFromJsonFile(path string) (*models.SudokuDTO, error) {
-	absolutePath, err := helpers.MakeFilePathAbsolute(path)
+	absolutePath, sudokuDataBytes, err := readSudokuFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	format := reader.SudokuFormats.GetFormatByName(sudokuFormats.JsonFormatName)
+	sudokus, err := readSudokus(format, absolutePath, sudokuDataBytes, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return sudokus[0], nil
+}
+
+// ReadSudokuGameFromFile reads saved state of the game played in the terminal
+// from JSON file with specified path
+func (reader *DataReader) ReadSudokuGameFromFile(path string) (*models.SudokuGameDTO, error) {
+	absolutePath, gameDataBytes, err := readSudokuFile(path)
 	if err != nil {
 		return nil, err
 	}
 
+	game := &models.SudokuGameDTO{}
+	if err := json.Unmarshal(gameDataBytes, game); err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("failed to parse saved game file '%s' - %w", absolutePath, err))
+	}
+
+	if game.Puzzle == nil {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("saved game file '%s' does not contain sudoku puzzle", absolutePath))
+	}
+
+	return game, nil
+}
+
+// ReadSudokuFileData reads content of the sudoku file with specified path
+// without parsing it, e.g. to keep data not represented in sudoku object
+func (reader *DataReader) ReadSudokuFileData(path string) ([]byte, error) {
+	_, sudokuDataBytes, err := readSudokuFile(path)
+	return sudokuDataBytes, err
+}
+
+// readSudokuFile reads content of the file with specified path.
+// Returns absolute path of the file and its content.
+func readSudokuFile(path string) (string, []byte, error) {
+	absolutePath, err := helpers.MakeFilePathAbsolute(path)
+	if err != nil {
+		return "", nil, models.WithKind(models.ErrIO, err)
+	}
+
 	if _, err := os.Stat(absolutePath); err != nil {
-		return nil, fmt.Errorf("sudoku input data file '%s' does not exist", absolutePath)
+		return "", nil, models.WithKind(models.ErrIO,
+			fmt.Errorf("sudoku input data file '%s' does not exist", absolutePath))
 	}
 
 	sudokuDataBytes, err := os.ReadFile(absolutePath)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read sudoku input data file '%s'", absolutePath)
+		return "", nil, models.WithKind(models.ErrIO,
+			fmt.Errorf("unable to read sudoku input data file '%s'", absolutePath))
 	}
 
-	sudoku := models.SudokuDTO{}
-	err = json.Unmarshal(sudokuDataBytes, &sudoku)
+	return absolutePath, sudokuDataBytes, nil
+}
+
+// readSudokus converts content of the file into raw sudoku data objects
+func readSudokus(format *sudokuFormats.SudokuFormat, absolutePath string,
+	sudokuDataBytes []byte, alphabet string) ([]*models.SudokuDTO, error) {
+
+	sudokus, err := format.Read(sudokuDataBytes, alphabet)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse sudoku input data file '%s'", absolutePath)
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("failed to parse sudoku input data file '%s' - %w", absolutePath, err))
 	}
 
-	return &sudoku, nil
+	return sudokus, nil
 }
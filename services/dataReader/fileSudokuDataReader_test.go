@@ -1,8 +1,19 @@
 package dataReader
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -27,12 +38,21 @@ func TestReadSudokuFromJsonFile(t *testing.T) {
 			filePath:     "../../testConfigs/simple1.json",
 			expectsError: false,
 		},
+		{
+			name:         "Correct case with symbols",
+			filePath:     "../../testConfigs/alphabet1.json",
+			expectsError: false,
+		},
+		{
+			name:         "Symbol not matching value",
+			filePath:     "../../testConfigs/invalidSymbols.json",
+			expectsError: true,
+		},
 	}
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
 		terminalPrinter := testHelpers.NewTestPrinter()
-		debugPrinter := testHelpers.NewTestPrinter()
 		prompter := testHelpers.GetNewTestPrompter(&testHelpers.TestPrompterConfig{
 			SelectError:              nil,
 			SudokuPromptError:        nil,
@@ -41,7 +61,8 @@ func TestReadSudokuFromJsonFile(t *testing.T) {
 			LayoutSizePromptFunc:     nil,
 		})
 
-		dataReader := GetNewDataReader(settings, terminalPrinter, debugPrinter, prompter)
+		dataReader := GetNewDataReader(settings, terminalPrinter, logger.NewDiscardLogger(), prompter,
+			newTestSudokuFormats(settings))
 		_, err := dataReader.ReadSudokuFromJsonFile(testCase.filePath)
 		hasError := err != nil
 
@@ -50,3 +71,340 @@ func TestReadSudokuFromJsonFile(t *testing.T) {
 		}
 	}
 }
+
+func TestReadSudokuFromFile(t *testing.T) {
+	directory := t.TempDir()
+	compactFilePath := filepath.Join(directory, "compact.sudoku")
+	jsonFilePath := filepath.Join(directory, "simple1.sudoku")
+	jsonTxtFilePath := filepath.Join(directory, "simple1.txt")
+	collectionFilePath := filepath.Join(directory, "collection.sdm")
+	invalidFilePath := filepath.Join(directory, "invalid.sdk")
+
+	compactSudoku := ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"
+	jsonSudoku, err := os.ReadFile("../../testConfigs/simple1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, data := range map[string][]byte{
+		compactFilePath:    []byte(compactSudoku),
+		jsonFilePath:       jsonSudoku,
+		jsonTxtFilePath:    jsonSudoku,
+		collectionFilePath: []byte(compactSudoku + "\n" + strings.ReplaceAll(compactSudoku, ".", "0") + "\n"),
+		invalidFilePath:    []byte("#A author\n12345\n"),
+	} {
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name                string
+		filePath            string
+		alphabet            string
+		expectedBoxSize     int8
+		expectedComparisons int
+		expectedErrorKind   error
+	}{
+		{
+			name:            "JSON file",
+			filePath:        "../../testConfigs/simple1.json",
+			expectedBoxSize: 3,
+		},
+		{
+			name:                "TXT file saved by the cli",
+			filePath:            "../../testConfigs/comparison1.txt",
+			expectedBoxSize:     3,
+			expectedComparisons: 54,
+		},
+		{
+			name:            "Text content detected",
+			filePath:        compactFilePath,
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "JSON content detected",
+			filePath:        jsonFilePath,
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "JSON content in TXT file",
+			filePath:        jsonTxtFilePath,
+			expectedBoxSize: 3,
+		},
+		{
+			name:            "First sudoku of a collection",
+			filePath:        collectionFilePath,
+			expectedBoxSize: 3,
+		},
+		{
+			name:              "Invalid SDK file",
+			filePath:          invalidFilePath,
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Symbol out of alphabet",
+			filePath:          compactFilePath,
+			alphabet:          "A-I",
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Non existing file",
+			filePath:          filepath.Join(directory, "missing.txt"),
+			expectedErrorKind: models.ErrIO,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		dataReader := GetNewDataReader(settings, testHelpers.NewTestPrinter(), logger.NewDiscardLogger(),
+			nil, newTestSudokuFormats(settings))
+
+		sudoku, err := dataReader.ReadSudokuFromFile(testCase.filePath, testCase.alphabet)
+		if testCase.expectedErrorKind != nil {
+			if !errors.Is(err, testCase.expectedErrorKind) {
+				t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedErrorKind, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		if sudoku.BoxSize != testCase.expectedBoxSize || len(sudoku.Comparisons) != testCase.expectedComparisons {
+			t.Errorf("%s: Invalid sudoku read from the file", testCase.name)
+		}
+	}
+}
+
+func TestReadSudokusFromFile(t *testing.T) {
+	collectionFilePath := filepath.Join(t.TempDir(), "collection.txt")
+	compactSudoku := ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"
+	err := os.WriteFile(collectionFilePath, []byte(compactSudoku+"\n"+compactSudoku+"\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name              string
+		filePath          string
+		formatName        string
+		expectedCount     int
+		expectedErrorKind error
+	}{
+		{
+			name:          "Format detected",
+			filePath:      collectionFilePath,
+			expectedCount: 2,
+		},
+		{
+			name:          "Format chosen by name",
+			filePath:      "../../testConfigs/simple1.json",
+			formatName:    "JSON",
+			expectedCount: 1,
+		},
+		{
+			name:              "File not matching the format",
+			filePath:          collectionFilePath,
+			formatName:        "ss",
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Unsupported format",
+			filePath:          collectionFilePath,
+			formatName:        "bad",
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		dataReader := GetNewDataReader(settings, testHelpers.NewTestPrinter(), logger.NewDiscardLogger(),
+			nil, newTestSudokuFormats(settings))
+
+		sudokus, err := dataReader.ReadSudokusFromFile(testCase.filePath, testCase.formatName, "")
+		if testCase.expectedErrorKind != nil {
+			if !errors.Is(err, testCase.expectedErrorKind) {
+				t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedErrorKind, err)
+			}
+			continue
+		}
+
+		if err != nil || len(sudokus) != testCase.expectedCount {
+			t.Errorf("%s: Expected %d sudokus, got %d (%v)", testCase.name, testCase.expectedCount, len(sudokus), err)
+		}
+	}
+}
+
+func TestReadSudokuFiles(t *testing.T) {
+	directory := t.TempDir()
+	compactSudoku := ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"
+	files := map[string]string{
+		"b.txt":    compactSudoku + "\n" + compactSudoku + "\n",
+		"a.txt":    compactSudoku,
+		".hidden":  "not a sudoku",
+		"bad.json": "{",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	validDirectory := filepath.Join(directory, "valid")
+	emptyDirectory := filepath.Join(directory, "empty")
+	for _, path := range []string{validDirectory, emptyDirectory} {
+		if err := os.Mkdir(path, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, name := range []string{"b.txt", "a.txt"} {
+		if err := os.WriteFile(filepath.Join(validDirectory, name), []byte(files[name]), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		path              string
+		expectedFiles     []string
+		expectedCounts    []int
+		expectedErrorKind error
+	}{
+		{
+			name:           "Single file",
+			path:           filepath.Join(directory, "b.txt"),
+			expectedFiles:  []string{"b.txt"},
+			expectedCounts: []int{2},
+		},
+		{
+			name:           "Directory sorted by file names",
+			path:           validDirectory,
+			expectedFiles:  []string{"a.txt", "b.txt"},
+			expectedCounts: []int{1, 2},
+		},
+		{
+			name:              "Directory with invalid file",
+			path:              directory,
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Empty directory",
+			path:              emptyDirectory,
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Missing path",
+			path:              filepath.Join(directory, "missing"),
+			expectedErrorKind: models.ErrIO,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		dataReader := GetNewDataReader(settings, testHelpers.NewTestPrinter(), logger.NewDiscardLogger(),
+			nil, newTestSudokuFormats(settings))
+
+		sudokuFiles, err := dataReader.ReadSudokuFiles(testCase.path, "")
+		if testCase.expectedErrorKind != nil {
+			if !errors.Is(err, testCase.expectedErrorKind) {
+				t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedErrorKind, err)
+			}
+			continue
+		}
+
+		if err != nil || len(sudokuFiles) != len(testCase.expectedFiles) {
+			t.Errorf("%s: Expected %d files, got %d (%v)", testCase.name, len(testCase.expectedFiles),
+				len(sudokuFiles), err)
+			continue
+		}
+
+		for index, sudokuFile := range sudokuFiles {
+			if filepath.Base(sudokuFile.Path) != testCase.expectedFiles[index] ||
+				len(sudokuFile.Sudokus) != testCase.expectedCounts[index] {
+				t.Errorf("%s: Unexpected file %s with %d sudokus", testCase.name, sudokuFile.Path,
+					len(sudokuFile.Sudokus))
+			}
+		}
+	}
+}
+
+func newTestSudokuFormats(settings *models.Settings) sudokuFormats.ISudokuFormats {
+	return sudokuFormats.GetNewSudokuFormats(settings, textSudokuParser.GetNewTextSudokuParser(settings),
+		dataPrinters.GetNewDataPrinter(settings, testHelpers.NewTestPrinter()),
+		binarySudokuManager.GetNewBinarySudokuManager(settings),
+		sudokuRenderer.GetNewSudokuRenderer(settings))
+}
+
+func TestReadSudokuGameFromFile(t *testing.T) {
+	directory := t.TempDir()
+	files := map[string]string{
+		"game.json": `{"puzzle": {"boxSize": 2, "layout": {"width": 2, "height": 2}, "boxes": []},
+			"entries": [{"row": 1, "column": 2, "value": 3}, {"row": 0, "column": 0, "pencilMarks": [1, 4]}],
+			"elapsedSeconds": 75, "hints": 2}`,
+		"noPuzzle.json": `{"entries": []}`,
+		"invalid.json":  "{",
+	}
+
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(directory, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		name              string
+		path              string
+		expectedErrorKind error
+	}{
+		{
+			name: "Correct game",
+			path: filepath.Join(directory, "game.json"),
+		},
+		{
+			name:              "Game without puzzle",
+			path:              filepath.Join(directory, "noPuzzle.json"),
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Invalid JSON",
+			path:              filepath.Join(directory, "invalid.json"),
+			expectedErrorKind: models.ErrInvalidInput,
+		},
+		{
+			name:              "Missing file",
+			path:              filepath.Join(directory, "missing.json"),
+			expectedErrorKind: models.ErrIO,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		dataReader := GetNewDataReader(settings, testHelpers.NewTestPrinter(), logger.NewDiscardLogger(),
+			nil, newTestSudokuFormats(settings))
+
+		game, err := dataReader.ReadSudokuGameFromFile(testCase.path)
+		if testCase.expectedErrorKind != nil {
+			if !errors.Is(err, testCase.expectedErrorKind) {
+				t.Errorf("%s: Expected error %v, got %v", testCase.name, testCase.expectedErrorKind, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Unexpected error %v", testCase.name, err)
+			continue
+		}
+
+		if game.Puzzle.BoxSize != 2 || game.ElapsedSeconds != 75 || game.Hints != 2 ||
+			len(game.Entries) != 2 || game.Entries[0].Row != 1 || game.Entries[0].Column != 2 ||
+			*game.Entries[0].Value != 3 || game.Entries[1].Value != nil ||
+			len(game.Entries[1].PencilMarks) != 2 {
+			t.Errorf("%s: Unexpected game %+v", testCase.name, game)
+		}
+	}
+}
@@ -1,31 +1,39 @@
 package dataWriter
 
 import (
+	"io"
 	"os"
 
 	"github.com/Michu8258/kangaroo/models"
 	"github.com/Michu8258/kangaroo/services/dataPrinters"
 	"github.com/Michu8258/kangaroo/services/printer"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
 )
 
 type DataWriter struct {
 	Settings           *models.Settings
 	DataPrinter        dataPrinters.IDataPrinter
 	TxtPrinterProvider func(file *os.File) printer.IPrinter
+	SudokuFormats      sudokuFormats.ISudokuFormats
 }
 
 type IDataWriter interface {
 	SaveSudokuToJson(sudoku *models.Sudoku, path string, overwrite bool) (bool, error)
 	SaveSudokuDtoToJson(sudokuDto *models.SudokuDTO, path string, overwrite bool) (bool, error)
 	SaveSudokuToTxt(sudoku *models.Sudoku, path string, overwrite bool) (bool, error)
+	SaveSudokuToFile(sudoku *models.Sudoku, path string, overwrite bool) (bool, error)
+	SaveSudokuDtoToFile(sudokuDto *models.SudokuDTO, path string, formatName string, overwrite bool) (bool, error)
+	SaveDataToFile(path string, overwrite bool, write func(writer io.Writer) error) (bool, error)
 }
 
 func GetNewDataWriter(settings *models.Settings,
 	dataPrinter dataPrinters.IDataPrinter,
-	txtPrinterProvider func(file *os.File) printer.IPrinter) IDataWriter {
+	txtPrinterProvider func(file *os.File) printer.IPrinter,
+	formats sudokuFormats.ISudokuFormats) IDataWriter {
 	return &DataWriter{
 		Settings:           settings,
 		DataPrinter:        dataPrinter,
 		TxtPrinterProvider: txtPrinterProvider,
+		SudokuFormats:      formats,
 	}
 }
@@ -1,12 +1,15 @@
 package dataWriter
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
 )
 
 // SaveSudokuToJson executes sudoku object JSON dump to selected file.
@@ -63,6 +66,109 @@ func (writer *DataWriter) SaveSudokuToTxt(sudoku *models.Sudoku,
 	return true, nil
 }
 
+// SaveSudokuToFile executes sudoku object dump to selected file in the format
+// matching extension of the file. Returns flag if indicating if file was written
+// and potential error
+func (writer *DataWriter) SaveSudokuToFile(sudoku *models.Sudoku, path string, overwrite bool) (bool, error) {
+	return writer.saveFormattedFile(path, "", overwrite,
+		func(format *sudokuFormats.SudokuFormat, buffer *bytes.Buffer) error {
+			return format.WriteSudoku(buffer, sudoku)
+		})
+}
+
+// SaveSudokuDtoToFile executes sudoku DTO object dump to selected file in the
+// format with provided name. Empty format name means the format matching extension
+// of the file. Returns flag if indicating if file was written and potential error
+func (writer *DataWriter) SaveSudokuDtoToFile(sudokuDto *models.SudokuDTO, path string,
+	formatName string, overwrite bool) (bool, error) {
+
+	return writer.saveFormattedFile(path, formatName, overwrite,
+		func(format *sudokuFormats.SudokuFormat, buffer *bytes.Buffer) error {
+			return format.Write(buffer, sudokuDto)
+		})
+}
+
+// SaveDataToFile writes data prepared by the function (e.g. a PDF document) to
+// selected file. The file is not created if the function fails. Returns flag
+// if indicating if file was written and potential error
+func (writer *DataWriter) SaveDataToFile(path string, overwrite bool,
+	write func(writer io.Writer) error) (bool, error) {
+
+	saveConfig := writer.prepareSaveConfig(path, overwrite)
+	if saveConfig.shortCircuit {
+		return false, saveConfig.err
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := write(buffer); err != nil {
+		return false, fmt.Errorf("failed to prepare data of file '%s' - %w",
+			saveConfig.absoluteFilePath, err)
+	}
+
+	err := os.WriteFile(saveConfig.absoluteFilePath, buffer.Bytes(), 0644)
+	if err != nil {
+		return false, models.WithKind(models.ErrIO, fmt.Errorf("failed to save data file '%s'",
+			saveConfig.absoluteFilePath))
+	}
+
+	return true, nil
+}
+
+// saveFormattedFile writes data prepared by the function in selected format
+// to the file. Returns flag if indicating if file was written and potential error
+func (writer *DataWriter) saveFormattedFile(path string, formatName string, overwrite bool,
+	writeFormat func(format *sudokuFormats.SudokuFormat, buffer *bytes.Buffer) error) (bool, error) {
+
+	format, err := writer.getWriteFormat(path, formatName)
+	if err != nil {
+		return false, err
+	}
+
+	saveConfig := writer.prepareSaveConfig(path, overwrite)
+	if saveConfig.shortCircuit {
+		return false, saveConfig.err
+	}
+
+	// data is prepared before the file is created, so the file is not
+	// created if the sudoku can not be represented in the format
+	buffer := &bytes.Buffer{}
+	err = writeFormat(format, buffer)
+	if err != nil {
+		return false, fmt.Errorf("failed to save sudoku to file '%s' - %w",
+			saveConfig.absoluteFilePath, err)
+	}
+
+	err = os.WriteFile(saveConfig.absoluteFilePath, buffer.Bytes(), 0644)
+	if err != nil {
+		return false, fmt.Errorf("failed to save sudoku data file '%s'",
+			saveConfig.absoluteFilePath)
+	}
+
+	return true, nil
+}
+
+// getWriteFormat returns format with provided name, or matching
+// extension of the file if the name is empty
+func (writer *DataWriter) getWriteFormat(path string, formatName string) (*sudokuFormats.SudokuFormat, error) {
+	if len(formatName) > 0 {
+		format := writer.SudokuFormats.GetFormatByName(formatName)
+		if format == nil || !format.CanWrite() {
+			return nil, models.WithKind(models.ErrInvalidInput,
+				fmt.Errorf("sudoku can not be written in '%s' format", formatName))
+		}
+
+		return format, nil
+	}
+
+	format := writer.SudokuFormats.GetFormatByPath(path)
+	if format == nil || !format.CanWrite() {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("unsupported file extension for path '%s'", path))
+	}
+
+	return format, nil
+}
+
 type saveConfig struct {
 	shortCircuit     bool
 	absoluteFilePath string
@@ -1,13 +1,19 @@
 package dataWriter
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
 	"github.com/Michu8258/kangaroo/services/dataPrinters"
 	"github.com/Michu8258/kangaroo/services/printer"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -147,6 +153,148 @@ func TestSaveSudokuToTxt(t *testing.T) {
 	}
 }
 
+func TestSaveSudokuToFile(t *testing.T) {
+	testCases := []fileWriteTestData[*models.Sudoku]{
+		{
+			name:           "Success SDK new file",
+			testData:       testHelpers.GetTestSudokuDto().ToSudoku(),
+			fileName:       "test.sdk",
+			expectedResult: true,
+		},
+		{
+			name:           "Success f-puzzles new file",
+			testData:       testHelpers.GetTestSudokuDto().ToSudoku(),
+			fileName:       "test.fpuzzles",
+			expectedResult: true,
+		},
+		{
+			name:           "Success PNG image new file",
+			testData:       testHelpers.GetTestSudokuDto().ToSudoku(),
+			fileName:       "test.png",
+			expectedResult: true,
+		},
+		{
+			name:             "SDK file already exists",
+			testData:         testHelpers.GetTestSudokuDto().ToSudoku(),
+			fileName:         "test.sdk",
+			precreateTheFile: true,
+			expectedResult:   false,
+		},
+		{
+			name:           "Sudoku not supported by the format",
+			testData:       models.NewEmptySudokuDTO(3, 3, 2).ToSudoku(),
+			fileName:       "test.ss",
+			expectedResult: false,
+			expectsError:   true,
+		},
+		{
+			name:           "Unsupported file extension",
+			testData:       testHelpers.GetTestSudokuDto().ToSudoku(),
+			fileName:       "test.bad",
+			expectedResult: false,
+			expectsError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		genericWriteTest(t, "SaveSudokuToFile", testCase,
+			func(writer IDataWriter, testData *models.Sudoku, path string, overwrite bool) (bool, error) {
+				written, err := writer.SaveSudokuToFile(testData, path, overwrite)
+				if _, statErr := os.Stat(path); err != nil && !testCase.precreateTheFile && statErr == nil {
+					t.Errorf("SaveSudokuToFile - %s: file was created despite the error", testCase.name)
+				}
+
+				return written, err
+			})
+	}
+}
+
+func TestSaveSudokuDtoToFile(t *testing.T) {
+	testCases := []struct {
+		fileWriteTestData[*models.SudokuDTO]
+		formatName string
+	}{
+		{
+			fileWriteTestData: fileWriteTestData[*models.SudokuDTO]{
+				name:           "Format chosen by extension",
+				testData:       testHelpers.GetTestSudokuDto(),
+				fileName:       "test.b64",
+				expectedResult: true,
+			},
+		},
+		{
+			fileWriteTestData: fileWriteTestData[*models.SudokuDTO]{
+				name:           "Format chosen by name",
+				testData:       testHelpers.GetTestSudokuDto(),
+				fileName:       "test.sudoku",
+				expectedResult: true,
+			},
+			formatName: "base64",
+		},
+		{
+			fileWriteTestData: fileWriteTestData[*models.SudokuDTO]{
+				name:           "Unsupported format name",
+				testData:       testHelpers.GetTestSudokuDto(),
+				fileName:       "test.json",
+				expectedResult: false,
+				expectsError:   true,
+			},
+			formatName: "bad",
+		},
+	}
+
+	for _, testCase := range testCases {
+		genericWriteTest(t, "SaveSudokuDtoToFile", testCase.fileWriteTestData,
+			func(writer IDataWriter, testData *models.SudokuDTO, path string, overwrite bool) (bool, error) {
+				return writer.SaveSudokuDtoToFile(testData, path, testCase.formatName, overwrite)
+			})
+	}
+}
+
+func TestSaveDataToFile(t *testing.T) {
+	testCases := []fileWriteTestData[func(writer io.Writer) error]{
+		{
+			name: "Success new file",
+			testData: func(writer io.Writer) error {
+				_, err := writer.Write([]byte("%PDF-1.4"))
+				return err
+			},
+			fileName:       "test.pdf",
+			expectedResult: true,
+		},
+		{
+			name: "File already exists",
+			testData: func(writer io.Writer) error {
+				return nil
+			},
+			fileName:         "test.pdf",
+			precreateTheFile: true,
+			expectedResult:   false,
+		},
+		{
+			name: "Data write failure",
+			testData: func(writer io.Writer) error {
+				return errors.New("data write error")
+			},
+			fileName:       "test.pdf",
+			expectedResult: false,
+			expectsError:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		genericWriteTest(t, "SaveDataToFile", testCase,
+			func(writer IDataWriter, testData func(writer io.Writer) error, path string, overwrite bool) (bool, error) {
+				written, err := writer.SaveDataToFile(path, overwrite, testData)
+				if _, statErr := os.Stat(path); err != nil && statErr == nil {
+					t.Errorf("SaveDataToFile - %s: file was created despite the error", testCase.name)
+				}
+
+				return written, err
+			})
+	}
+}
+
 func genericWriteTest[T interface{}](t *testing.T, testGroupName string, testCaseData fileWriteTestData[T],
 	testedFunc func(writer IDataWriter, testData T, path string, overwrite bool) (bool, error)) {
 
@@ -172,10 +320,14 @@ func genericWriteTest[T interface{}](t *testing.T, testGroupName string, testCas
 		}
 	}
 
+	formats := sudokuFormats.GetNewSudokuFormats(setting,
+		textSudokuParser.GetNewTextSudokuParser(setting), dataPrinter,
+		binarySudokuManager.GetNewBinarySudokuManager(setting),
+		sudokuRenderer.GetNewSudokuRenderer(setting))
 	writer := GetNewDataWriter(setting, dataPrinter,
 		func(file *os.File) printer.IPrinter {
 			return txtPrinter
-		})
+		}, formats)
 
 	result, err := testedFunc(writer, testCaseData.testData, path, testCaseData.overwrite)
 
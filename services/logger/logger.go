@@ -0,0 +1,85 @@
+package logger
+
+import (
+	"context"
+	"io"
+	"log/slog"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// settingsHandler is a slog handler which uses level and format of records
+// configured in the settings at the moment of logging, so the logger can be
+// created before the settings are changed with configuration and cli options
+type settingsHandler struct {
+	settings    *models.Settings
+	textHandler slog.Handler
+	jsonHandler slog.Handler
+}
+
+// settingsLeveler provides minimum level of logged records from the settings
+type settingsLeveler struct {
+	settings *models.Settings
+}
+
+// New creates structured logger writing records to provided writer
+func New(settings *models.Settings, writer io.Writer) *slog.Logger {
+	options := &slog.HandlerOptions{
+		Level: settingsLeveler{settings: settings},
+	}
+
+	return slog.New(&settingsHandler{
+		settings:    settings,
+		textHandler: slog.NewTextHandler(writer, options),
+		jsonHandler: slog.NewJSONHandler(writer, options),
+	})
+}
+
+// NewDiscardLogger creates logger ignoring all records
+func NewDiscardLogger() *slog.Logger {
+	return slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{
+		Level: slog.Level(127),
+	}))
+}
+
+// Level returns minimum level of logged records
+func (leveler settingsLeveler) Level() slog.Level {
+	return leveler.settings.GetLogLevel()
+}
+
+// Enabled reports whether the handler handles records at the given level
+func (handler *settingsHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return handler.getHandler().Enabled(ctx, level)
+}
+
+// Handle writes the record in configured format
+func (handler *settingsHandler) Handle(ctx context.Context, record slog.Record) error {
+	return handler.getHandler().Handle(ctx, record)
+}
+
+// WithAttrs returns a new handler including provided attributes in every record
+func (handler *settingsHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &settingsHandler{
+		settings:    handler.settings,
+		textHandler: handler.textHandler.WithAttrs(attrs),
+		jsonHandler: handler.jsonHandler.WithAttrs(attrs),
+	}
+}
+
+// WithGroup returns a new handler qualifying attributes with the group name
+func (handler *settingsHandler) WithGroup(name string) slog.Handler {
+	return &settingsHandler{
+		settings:    handler.settings,
+		textHandler: handler.textHandler.WithGroup(name),
+		jsonHandler: handler.jsonHandler.WithGroup(name),
+	}
+}
+
+// getHandler returns handler of configured format
+func (handler *settingsHandler) getHandler() slog.Handler {
+	if handler.settings.LogFormat == models.JSONLogFormat {
+		return handler.jsonHandler
+	}
+
+	return handler.textHandler
+}
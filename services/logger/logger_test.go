@@ -0,0 +1,128 @@
+package logger
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestLogger(t *testing.T) {
+	testCases := []struct {
+		name             string
+		logLevel         string
+		logFormat        string
+		debugPrints      bool
+		expectedContent  []string
+		unexpectedOutput []string
+	}{
+		{
+			name:             "Default level",
+			logLevel:         "",
+			logFormat:        models.TextLogFormat,
+			expectedContent:  []string{`level=WARN msg=warning depth=1 cell.row=2`, "level=ERROR"},
+			unexpectedOutput: []string{"level=DEBUG", "level=INFO"},
+		},
+		{
+			name:            "Debug level",
+			logLevel:        "debug",
+			logFormat:       models.TextLogFormat,
+			expectedContent: []string{"level=DEBUG", "level=INFO", "level=WARN", "level=ERROR"},
+		},
+		{
+			name:            "Debug prints",
+			logLevel:        "error",
+			logFormat:       models.TextLogFormat,
+			debugPrints:     true,
+			expectedContent: []string{"level=DEBUG", "level=ERROR"},
+		},
+		{
+			name:             "JSON format",
+			logLevel:         "info",
+			logFormat:        models.JSONLogFormat,
+			expectedContent:  []string{`"level":"INFO"`, `"depth":1,"cell":{"row":2}`},
+			unexpectedOutput: []string{`"level":"DEBUG"`, "level=INFO"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		settings.LogLevel = testCase.logLevel
+		settings.LogFormat = testCase.logFormat
+		settings.UseDebugPrints = testCase.debugPrints
+		buffer := &bytes.Buffer{}
+
+		logger := New(settings, buffer).With("depth", 1).WithGroup("cell")
+		logger.Debug("debug", "row", 2)
+		logger.Info("info", "row", 2)
+		logger.Warn("warning", "row", 2)
+		logger.Error("error", "row", 2)
+
+		for _, expectedContent := range testCase.expectedContent {
+			if !strings.Contains(buffer.String(), expectedContent) {
+				t.Errorf("%s: Logs '%s' do not contain '%s'.", testCase.name, buffer.String(), expectedContent)
+			}
+		}
+
+		for _, unexpectedOutput := range testCase.unexpectedOutput {
+			if strings.Contains(buffer.String(), unexpectedOutput) {
+				t.Errorf("%s: Logs '%s' contain '%s'.", testCase.name, buffer.String(), unexpectedOutput)
+			}
+		}
+	}
+}
+
+func TestOutput(t *testing.T) {
+	directory := t.TempDir()
+	testCases := []struct {
+		name             string
+		logFile          string
+		expectedFallback string
+		expectedFile     bool
+	}{
+		{
+			name:             "No log file",
+			expectedFallback: "record",
+		},
+		{
+			name:         "Log file",
+			logFile:      filepath.Join(directory, "kangaroo.log"),
+			expectedFile: true,
+		},
+		{
+			name:             "Invalid log file",
+			logFile:          filepath.Join(directory, "missing", "kangaroo.log"),
+			expectedFallback: "failed to open log file",
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		settings.LogFile = testCase.logFile
+		fallback := &bytes.Buffer{}
+
+		output := NewOutput(settings, fallback)
+		output.Write([]byte("record\n"))
+		output.Write([]byte("record\n"))
+		err := output.Close()
+		if err != nil {
+			t.Errorf("%s: Unexpected error while closing the output: %s", testCase.name, err)
+		}
+
+		if !strings.Contains(fallback.String(), testCase.expectedFallback) {
+			t.Errorf("%s: Fallback output '%s' does not contain '%s'.",
+				testCase.name, fallback.String(), testCase.expectedFallback)
+		}
+
+		if testCase.expectedFile {
+			data, err := os.ReadFile(testCase.logFile)
+			if err != nil || string(data) != "record\nrecord\n" {
+				t.Errorf("%s: Unexpected log file content '%s' (%v).", testCase.name, data, err)
+			}
+		}
+	}
+}
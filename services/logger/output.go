@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Output writes log records to the log file configured in the settings,
+// or to the fallback writer if there is no log file. The file is opened
+// with the first record, so it is not created if nothing is logged.
+type Output struct {
+	settings *models.Settings
+	fallback io.Writer
+	once     sync.Once
+	writer   io.Writer
+	file     *os.File
+}
+
+// NewOutput creates log records output
+func NewOutput(settings *models.Settings, fallback io.Writer) *Output {
+	return &Output{
+		settings: settings,
+		fallback: fallback,
+	}
+}
+
+// Write writes the log record. If the log file can not be opened,
+// records are written to the fallback writer.
+func (output *Output) Write(data []byte) (int, error) {
+	output.once.Do(output.open)
+	return output.writer.Write(data)
+}
+
+// Close closes the log file if it was opened
+func (output *Output) Close() error {
+	output.once.Do(func() {
+		output.writer = output.fallback
+	})
+
+	if output.file == nil {
+		return nil
+	}
+
+	return output.file.Close()
+}
+
+// open opens the log file in append mode
+func (output *Output) open() {
+	output.writer = output.fallback
+	if len(output.settings.LogFile) < 1 {
+		return
+	}
+
+	file, err := os.OpenFile(output.settings.LogFile, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0644)
+	if err != nil {
+		fmt.Fprintf(output.fallback, "failed to open log file: %s\n", err)
+		return
+	}
+
+	output.file = file
+	output.writer = file
+}
@@ -10,12 +10,6 @@ import (
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
-func TestDebugPrinter(t *testing.T) {
-	testPrinter(t, func(settings *models.Settings, writer io.Writer) IPrinter {
-		return NewDebugPrinter(settings, writer)
-	})
-}
-
 func TestTerminalPrinter(t *testing.T) {
 	testPrinter(t, func(settings *models.Settings, writer io.Writer) IPrinter {
 		return NewTerminalPrinter(settings, writer)
@@ -32,7 +26,6 @@ func testPrinter(t *testing.T,
 	printerProvider func(settings *models.Settings, writer io.Writer) IPrinter) {
 
 	settings := testHelpers.GetTestSettings()
-	settings.UseDebugPrints = true
 
 	strs := []string{"default", "primary", "success", "error", "border", "\n"}
 	buffer := &bytes.Buffer{}
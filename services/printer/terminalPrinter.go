@@ -19,37 +19,37 @@ func NewTerminalPrinter(settings *models.Settings, writer io.Writer) IPrinter {
 }
 
 func (cp TerminalPrinter) PrintDefault(text string) {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.DefaultStyle.Render(text)))
 	}
 }
 
 func (cp TerminalPrinter) PrintPrimary(text string) {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.PrimaryStyle.Render(text)))
 	}
 }
 
 func (cp TerminalPrinter) PrintSuccess(text string) {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.SuccessStyle.Render(text)))
 	}
 }
 
 func (cp TerminalPrinter) PrintError(text string) {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.ErrorStyle.Render(text)))
 	}
 }
 
 func (cp TerminalPrinter) PrintBorder(text string) {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.BorderStyle.Render(text)))
 	}
 }
 
 func (cp TerminalPrinter) PrintNewLine() {
-	if !cp.settings.SilentConsolePrints {
+	if !cp.settings.IsConsoleSilent() {
 		cp.writer.Write([]byte(models.TerminalStyles.DefaultStyle.Render("\n")))
 	}
 }
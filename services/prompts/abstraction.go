@@ -15,9 +15,10 @@ type Prompter struct {
 type IPrompter interface {
 	PromptMakeSelectChoice(title string, options []models.PromptSelectOption,
 		initialChoiceIndex int) (models.PromptSelectOption, error)
-	PromptSudokuValues(sudokuDto *models.SudokuDTO) error
+	PromptSudokuValues(sudokuDto *models.SudokuDTO, allowConflicts bool) error
 	PromptGetBoxSize(initialBoxSize *int8) (int8, error)
 	PromptGetLayoutSize(initialSize *int8, direction string) (int8, error)
+	PromptPlaySudoku(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error
 }
 
 func GetNewPrompter(settings *models.Settings,
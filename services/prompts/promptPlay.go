@@ -0,0 +1,789 @@
+package prompts
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/Michu8258/kangaroo/models"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// playAction is an action of the game which needs the solution of the puzzle
+type playAction int
+
+const (
+	playNoAction playAction = iota
+	playHintAction
+	playCheckAction
+	playFinishAction
+)
+
+// playTickMsg is sent every second to update the game timer
+type playTickMsg struct{}
+
+// playSolutionMsg carries result of solving the puzzle
+type playSolutionMsg struct {
+	solution *models.SudokuDTO
+	err      error
+}
+
+type sudokuPlayPrompt struct {
+	grid          *sudokuValuesPrompt
+	game          *models.SudokuGameDTO
+	actions       *models.SudokuGameActions
+	givens        map[*models.SudokuCellDTO]bool
+	pencilMarks   map[*models.SudokuCellDTO][]int
+	conflicts     map[*models.SudokuCellDTO]bool
+	wrongCells    map[*models.SudokuCellDTO]bool
+	solution      map[*models.SudokuCellDTO]int
+	solutionError error
+	solving       bool
+	pendingAction playAction
+	pencilMode    bool
+	elapsed       int
+	hints         int
+	won           bool
+	quit          bool
+	status        string
+	statusIsError bool
+}
+
+// PromptPlaySudoku runs the game of solving the puzzle in the terminal. Given values
+// are locked, values entered by the player are validated while typing and can be
+// checked against the solution. The game state is written back to the game object,
+// saving is done with provided actions.
+func (prompter *Prompter) PromptPlaySudoku(game *models.SudokuGameDTO,
+	actions *models.SudokuGameActions) error {
+
+	failError := fmt.Errorf("failed to play the sudoku game")
+	initialModel, err := buildSudokuPlayPromptModel(game, actions, prompter.Settings)
+	if err != nil {
+		prompter.TerminalPrinter.PrintError(err.Error())
+		prompter.TerminalPrinter.PrintNewLine()
+		return failError
+	}
+
+	model, err := prompter.TeaProgramRunner(initialModel)
+	if err != nil {
+		prompter.TerminalPrinter.PrintError(err.Error())
+		prompter.TerminalPrinter.PrintNewLine()
+		return failError
+	}
+
+	if _, ok := model.(sudokuPlayPrompt); ok {
+		return nil
+	}
+
+	return failError
+}
+
+// Init iniitalizes tea model state - starts the game timer
+func (m sudokuPlayPrompt) Init() tea.Cmd {
+	return playTick()
+}
+
+// Update updates tea model state
+func (m sudokuPlayPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var command tea.Cmd
+
+	switch messageType := msg.(type) {
+	case playTickMsg:
+		command = tickPlayTimer(&m)
+
+	case playSolutionMsg:
+		command = applyPlaySolution(&m, messageType)
+
+	case tea.KeyMsg:
+		command = handlePlayKey(&m, messageType)
+	}
+
+	refreshPlayGrid(&m)
+	return m, command
+}
+
+// View renders output based on model state
+func (m sudokuPlayPrompt) View() string {
+	if m.quit {
+		return ""
+	}
+
+	builder := strings.Builder{}
+
+	mode := "values"
+	if m.pencilMode {
+		mode = "pencil marks"
+	}
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Time: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render(formatPlayTime(m.elapsed)))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tHints: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render(strconv.Itoa(m.hints)))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tMode: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render(mode))
+	builder.WriteString("\n")
+
+	printSudokuGrid(&builder, m.grid)
+
+	if m.won {
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render(fmt.Sprintf(
+			"You won! Sudoku solved in %s with %d hint(s).", formatPlayTime(m.elapsed), m.hints)))
+		builder.WriteString("\n")
+		if m.statusIsError {
+			builder.WriteString(models.TerminalStyles.ErrorStyle.Render(m.status))
+			builder.WriteString("\n")
+		}
+
+		builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Press any key to exit."))
+		builder.WriteString("\n")
+		return builder.String()
+	}
+
+	if marks := m.pencilMarks[m.grid.currentCell]; len(marks) > 0 {
+		symbols := []string{}
+		for _, mark := range marks {
+			symbols = append(symbols, m.grid.alphabet.GetSymbol(mark))
+		}
+
+		builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Pencil marks: "))
+		builder.WriteString(models.TerminalStyles.PrimaryStyle.Render(strings.Join(symbols, " ")))
+		builder.WriteString("\n")
+	}
+
+	printPlayStatus(&builder, &m)
+	builder.WriteString("\n")
+	printPlayControls(&builder, &m)
+
+	return builder.String()
+}
+
+// printPlayStatus prints result of the last action, or conflicts and
+// amount of empty cells if there is no such result
+func printPlayStatus(builder *strings.Builder, model *sudokuPlayPrompt) {
+	switch {
+	case model.statusIsError:
+		builder.WriteString(models.TerminalStyles.ErrorStyle.Render(model.status))
+	case len(model.status) > 0:
+		builder.WriteString(models.TerminalStyles.PrimaryStyle.Render(model.status))
+	case len(model.conflicts) > 0:
+		builder.WriteString(models.TerminalStyles.ErrorStyle.Render(
+			fmt.Sprintf("%d cell(s) break the rules", len(model.conflicts))))
+	default:
+		builder.WriteString(models.TerminalStyles.DefaultStyle.Render(
+			fmt.Sprintf("%d cell(s) left", countEmptyPlayCells(model))))
+	}
+
+	builder.WriteString("\n")
+}
+
+// printPlayControls print controls of the game
+func printPlayControls(builder *strings.Builder, model *sudokuPlayPrompt) {
+	builder.WriteString(models.TerminalStyles.PrimaryStyle.Render("Controls:"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Move: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("arrows/hjkl"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tInsert value: "))
+	if model.grid.alphabet != nil {
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render(
+			fmt.Sprintf("symbols %s", model.grid.alphabet.Specification)))
+	} else {
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render("numbers 0-9"))
+	}
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tDelete value: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("delete/backspace"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Toggle pencil marks: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("tab"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tHint: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("?"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tCheck values: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("!"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Save: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("ctrl+s"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tSave and quit: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("esc"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tQuit without saving: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("ctrl+c"))
+	builder.WriteString("\n")
+}
+
+// handlePlayKey executes action bound to the pressed key
+func handlePlayKey(model *sudokuPlayPrompt, key tea.KeyMsg) tea.Cmd {
+	if model.won {
+		model.quit = true
+		return tea.Quit
+	}
+
+	model.status, model.statusIsError = "", false
+
+	if model.grid.alphabet != nil && key.Type == tea.KeyRunes && !key.Alt && len(key.Runes) == 1 {
+		// symbols take precedence over game keys, digits that are
+		// not a part of the alphabet cannot be used to build a value
+		if value, ok := model.grid.alphabet.GetValue(key.Runes[0]); ok {
+			return enterPlayValue(model, value)
+		}
+
+		if unicode.IsDigit(key.Runes[0]) {
+			return nil
+		}
+	}
+
+	switch key.String() {
+	case "ctrl+c":
+		model.quit = true
+		return tea.Quit
+
+	case "esc":
+		if savePlayGame(model) {
+			model.quit = true
+			return tea.Quit
+		}
+
+	case "ctrl+s":
+		if savePlayGame(model) {
+			setPlayStatus(model, "Game saved", false)
+		}
+
+	case "up", "k":
+		goUpSudokuCell(model.grid)
+
+	case "down", "j":
+		goDownSudokuCell(model.grid)
+
+	case "left", "h":
+		goLeftSudokuCell(model.grid)
+
+	case "right", "l":
+		goRightSudokuCell(model.grid)
+
+	case "tab":
+		model.pencilMode = !model.pencilMode
+
+	case "?":
+		return runPlayAction(model, playHintAction)
+
+	case "!":
+		return runPlayAction(model, playCheckAction)
+
+	case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		digit, _ := strconv.Atoi(key.String())
+		return enterPlayDigit(model, digit)
+
+	case "delete":
+		return clearPlayCell(model)
+
+	case "backspace":
+		if !canEditPlayCell(model) {
+			return nil
+		}
+
+		backspaceCurrentCellValue(model.grid)
+		return afterPlayEdit(model)
+	}
+
+	return nil
+}
+
+// playTick schedules the next update of the game timer
+func playTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg {
+		return playTickMsg{}
+	})
+}
+
+// tickPlayTimer increments time of the game, the timer stops when the game is over
+func tickPlayTimer(model *sudokuPlayPrompt) tea.Cmd {
+	if model.won || model.quit {
+		return nil
+	}
+
+	model.elapsed++
+	model.game.ElapsedSeconds = model.elapsed
+	return playTick()
+}
+
+// canEditPlayCell checks if the player can change current cell,
+// given values and cells of disabled boxes are locked
+func canEditPlayCell(model *sudokuPlayPrompt) bool {
+	if model.grid.currentBox.Disabled {
+		setPlayStatus(model, "This cell is not a part of the puzzle", true)
+		return false
+	}
+
+	if model.givens[model.grid.currentCell] {
+		setPlayStatus(model, "Given values can not be changed", true)
+		return false
+	}
+
+	return true
+}
+
+// enterPlayValue sets value of current cell, or toggles the pencil
+// mark of the value in pencil marks mode
+func enterPlayValue(model *sudokuPlayPrompt, value int) tea.Cmd {
+	if !canEditPlayCell(model) {
+		return nil
+	}
+
+	if model.pencilMode {
+		togglePlayPencilMark(model, value)
+		return nil
+	}
+
+	setValue(model.grid, value)
+	return afterPlayEdit(model)
+}
+
+// enterPlayDigit enters a digit to current cell. Values of sudokus with up to
+// nine values are replaced, longer values are built digit by digit, but never
+// exceed maximum value of the sudoku.
+func enterPlayDigit(model *sudokuPlayPrompt, digit int) tea.Cmd {
+	maximumValue := int(model.grid.sudokuDTO.BoxSize) * int(model.grid.sudokuDTO.BoxSize)
+	if maximumValue <= 9 || model.pencilMode {
+		if digit < 1 || digit > maximumValue {
+			return nil
+		}
+
+		return enterPlayValue(model, digit)
+	}
+
+	if !canEditPlayCell(model) {
+		return nil
+	}
+
+	previousValue := model.grid.currentCell.Value
+	appendValue(model.grid, digit)
+	if model.grid.currentCell.Value != nil && *model.grid.currentCell.Value > maximumValue {
+		model.grid.currentCell.Value = previousValue
+		return nil
+	}
+
+	return afterPlayEdit(model)
+}
+
+// clearPlayCell removes value of current cell, or its pencil marks if it has no value
+func clearPlayCell(model *sudokuPlayPrompt) tea.Cmd {
+	if !canEditPlayCell(model) {
+		return nil
+	}
+
+	if model.grid.currentCell.Value == nil {
+		delete(model.pencilMarks, model.grid.currentCell)
+		updatePlayGame(model)
+		return nil
+	}
+
+	clearCurrentCellValue(model.grid)
+	return afterPlayEdit(model)
+}
+
+// togglePlayPencilMark adds or removes the pencil mark of current cell
+func togglePlayPencilMark(model *sudokuPlayPrompt, value int) {
+	cell := model.grid.currentCell
+	marks := model.pencilMarks[cell]
+
+	if slices.Contains(marks, value) {
+		marks = slices.DeleteFunc(slices.Clone(marks), func(mark int) bool {
+			return mark == value
+		})
+	} else {
+		marks = append(slices.Clone(marks), value)
+		slices.Sort(marks)
+	}
+
+	if len(marks) > 0 {
+		model.pencilMarks[cell] = marks
+	} else {
+		delete(model.pencilMarks, cell)
+	}
+
+	updatePlayGame(model)
+}
+
+// afterPlayEdit validates values after change of current cell and
+// finishes the game when all cells are filled without conflicts
+func afterPlayEdit(model *sudokuPlayPrompt) tea.Cmd {
+	delete(model.wrongCells, model.grid.currentCell)
+	model.conflicts = getConflictingCells(model.grid.sudokuDTO)
+	updatePlayGame(model)
+
+	if len(model.conflicts) > 0 || countEmptyPlayCells(model) > 0 {
+		return nil
+	}
+
+	return runPlayAction(model, playFinishAction)
+}
+
+// runPlayAction executes the action which needs the solution. If the solution
+// is not known yet, the puzzle is solved in background and the action is
+// executed once the solution is available.
+func runPlayAction(model *sudokuPlayPrompt, action playAction) tea.Cmd {
+	if model.solution == nil && model.solutionError == nil {
+		model.pendingAction = action
+		if model.solving {
+			return nil
+		}
+
+		if model.actions == nil || model.actions.Solve == nil {
+			model.solutionError = errors.New("solver is not available")
+		} else {
+			model.solving = true
+			setPlayStatus(model, "Solving the puzzle...", false)
+			solve, puzzle := model.actions.Solve, model.game.Puzzle
+			return func() tea.Msg {
+				solution, err := solve(puzzle)
+				return playSolutionMsg{solution: solution, err: err}
+			}
+		}
+	}
+
+	if model.solutionError != nil {
+		setPlayStatus(model, fmt.Sprintf("The puzzle can not be solved - %s", model.solutionError), true)
+		return nil
+	}
+
+	switch action {
+	case playHintAction:
+		revealPlayHint(model)
+	case playCheckAction:
+		checkPlayValues(model)
+	case playFinishAction:
+		finishPlayGame(model)
+	}
+
+	return nil
+}
+
+// applyPlaySolution stores the solution of the puzzle (values of the cells)
+// and executes pending action
+func applyPlaySolution(model *sudokuPlayPrompt, message playSolutionMsg) tea.Cmd {
+	model.solving = false
+	model.status, model.statusIsError = "", false
+
+	if message.err != nil {
+		model.solutionError = message.err
+	} else {
+		solution, err := getPlaySolutionValues(model.grid.sudokuDTO, message.solution)
+		model.solution, model.solutionError = solution, err
+	}
+
+	action := model.pendingAction
+	model.pendingAction = playNoAction
+	if action == playNoAction {
+		return nil
+	}
+
+	return runPlayAction(model, action)
+}
+
+// getPlaySolutionValues maps cells of the puzzle to values of the solution,
+// boxes and cells are matched by their order
+func getPlaySolutionValues(sudokuDto *models.SudokuDTO, solution *models.SudokuDTO) (
+	map[*models.SudokuCellDTO]int, error) {
+
+	mismatchError := errors.New("solution does not match the puzzle")
+	if solution == nil || len(solution.Boxes) != len(sudokuDto.Boxes) {
+		return nil, mismatchError
+	}
+
+	values := map[*models.SudokuCellDTO]int{}
+	for boxIndex, box := range sudokuDto.Boxes {
+		if box.Disabled {
+			continue
+		}
+
+		solutionCells := solution.Boxes[boxIndex].Cells
+		if len(solutionCells) != len(box.Cells) {
+			return nil, mismatchError
+		}
+
+		for cellIndex, cell := range box.Cells {
+			if solutionCells[cellIndex].Value == nil {
+				return nil, mismatchError
+			}
+
+			values[cell] = *solutionCells[cellIndex].Value
+		}
+	}
+
+	return values, nil
+}
+
+// revealPlayHint enters the solution value to current cell, or to the first
+// empty or wrong cell if current cell can not be revealed
+func revealPlayHint(model *sudokuPlayPrompt) {
+	isHidden := func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		return !box.Disabled && !model.givens[cell] &&
+			(cell.Value == nil || *cell.Value != model.solution[cell])
+	}
+
+	if !isHidden(model.grid.currentBox, model.grid.currentCell) {
+		box, cell := findPlayCell(model, isHidden)
+		if cell == nil {
+			setPlayStatus(model, "There is nothing to reveal", false)
+			return
+		}
+
+		model.grid.currentBox, model.grid.currentCell = box, cell
+	}
+
+	value := model.solution[model.grid.currentCell]
+	model.grid.currentCell.Value = &value
+	model.hints++
+
+	position := getCurrentCellPosition(model.grid)
+	setPlayStatus(model, fmt.Sprintf("Hint: %s in row %d, column %d",
+		model.grid.alphabet.GetSymbol(value), position.Row+1, position.Column+1), false)
+	afterPlayEdit(model)
+}
+
+// checkPlayValues marks entered values which differ from the solution
+func checkPlayValues(model *sudokuPlayPrompt) {
+	clear(model.wrongCells)
+	wrong := 0
+
+	findPlayCell(model, func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		if !box.Disabled && !model.givens[cell] && cell.Value != nil && *cell.Value != model.solution[cell] {
+			model.wrongCells[cell] = true
+			wrong++
+		}
+
+		return false
+	})
+
+	if wrong > 0 {
+		setPlayStatus(model, fmt.Sprintf("%d value(s) are wrong", wrong), true)
+		return
+	}
+
+	setPlayStatus(model, fmt.Sprintf("No mistakes so far, %d cell(s) left", countEmptyPlayCells(model)), false)
+}
+
+// finishPlayGame ends the game if all values match the solution and saves the
+// solved game, otherwise wrong values are marked
+func finishPlayGame(model *sudokuPlayPrompt) {
+	checkPlayValues(model)
+	if len(model.wrongCells) > 0 {
+		setPlayStatus(model, fmt.Sprintf("All cells are filled, but %d value(s) are wrong",
+			len(model.wrongCells)), true)
+		return
+	}
+
+	model.won = true
+	savePlayGame(model)
+}
+
+// savePlayGame writes state of the game to the game object and saves it.
+// Returns false and sets error status if the game could not be saved.
+func savePlayGame(model *sudokuPlayPrompt) bool {
+	updatePlayGame(model)
+
+	if model.actions == nil || model.actions.Save == nil {
+		setPlayStatus(model, "Saving the game is not available", true)
+		return false
+	}
+
+	if err := model.actions.Save(model.game); err != nil {
+		setPlayStatus(model, fmt.Sprintf("Failed to save the game - %s", err), true)
+		return false
+	}
+
+	return true
+}
+
+// updatePlayGame writes values and pencil marks entered by the player,
+// time and hints to the game object
+func updatePlayGame(model *sudokuPlayPrompt) {
+	entries := models.GenericSlice[*models.SudokuGameEntryDTO]{}
+
+	findPlayCell(model, func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		marks := model.pencilMarks[cell]
+		if box.Disabled || model.givens[cell] || (cell.Value == nil && len(marks) < 1) {
+			return false
+		}
+
+		entry := &models.SudokuGameEntryDTO{
+			SudokuCellPositionDTO: getSudokuCellPosition(model.grid.sudokuDTO, box, cell),
+			PencilMarks:           slices.Clone(marks),
+		}
+
+		if cell.Value != nil {
+			value := *cell.Value
+			entry.Value = &value
+		}
+
+		entries = append(entries, entry)
+		return false
+	})
+
+	slices.SortFunc(entries, func(first *models.SudokuGameEntryDTO, second *models.SudokuGameEntryDTO) int {
+		return cmp.Or(cmp.Compare(first.Row, second.Row), cmp.Compare(first.Column, second.Column))
+	})
+
+	model.game.Entries = entries
+	model.game.ElapsedSeconds = model.elapsed
+	model.game.Hints = model.hints
+	model.game.Solved = model.won
+}
+
+// refreshPlayGrid assigns styles to the cells of the grid - conflicting and
+// wrong values are errors, givens are highlighted, cells of disabled boxes are
+// blank and empty cells with pencil marks are dotted
+func refreshPlayGrid(model *sudokuPlayPrompt) {
+	cellStyles := map[*models.SudokuCellDTO]lipgloss.Style{}
+	emptyCellSigns := map[*models.SudokuCellDTO]string{}
+
+	findPlayCell(model, func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		switch {
+		case box.Disabled:
+			emptyCellSigns[cell] = " "
+		case model.conflicts[cell] || model.wrongCells[cell]:
+			cellStyles[cell] = models.TerminalStyles.ErrorStyle
+		case model.givens[cell]:
+			cellStyles[cell] = models.TerminalStyles.PrimaryStyle
+		case cell.Value != nil:
+			cellStyles[cell] = models.TerminalStyles.DefaultStyle
+		}
+
+		if !box.Disabled && len(model.pencilMarks[cell]) > 0 {
+			emptyCellSigns[cell] = "·"
+		}
+
+		return false
+	})
+
+	model.grid.cellStyles = cellStyles
+	model.grid.emptyCellSigns = emptyCellSigns
+}
+
+// findPlayCell returns the first box and cell matching the predicate,
+// returns nils if there is no such cell
+func findPlayCell(model *sudokuPlayPrompt,
+	predicate func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool) (
+	*models.SudokuBoxDTO, *models.SudokuCellDTO) {
+
+	for _, box := range model.grid.sudokuDTO.Boxes {
+		for _, cell := range box.Cells {
+			if predicate(box, cell) {
+				return box, cell
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// countEmptyPlayCells counts cells of enabled boxes without value
+func countEmptyPlayCells(model *sudokuPlayPrompt) int {
+	empty := 0
+	findPlayCell(model, func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		if !box.Disabled && cell.Value == nil {
+			empty++
+		}
+
+		return false
+	})
+
+	return empty
+}
+
+// setPlayStatus sets message presented below the grid
+func setPlayStatus(model *sudokuPlayPrompt, status string, isError bool) {
+	model.status, model.statusIsError = status, isError
+}
+
+// formatPlayTime formats time of the game as minutes and seconds,
+// hours are added for longer games
+func formatPlayTime(seconds int) string {
+	if seconds >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds%3600/60, seconds%60)
+	}
+
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+// buildSudokuPlayPromptModel builds a model of the game. The grid is a copy of the
+// puzzle, so the puzzle keeps only given values, with values and pencil marks of
+// the game entries applied.
+func buildSudokuPlayPromptModel(game *models.SudokuGameDTO, actions *models.SudokuGameActions,
+	settings *models.Settings) (*sudokuPlayPrompt, error) {
+
+	if game == nil || game.Puzzle == nil {
+		return nil, errors.New("the game has no sudoku puzzle")
+	}
+
+	grid, err := buildSudokuValuesPromptModel(game.Puzzle.ToSudoku().ToSudokuDto(), settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// conflicting values are highlighted with cell styles of the game
+	grid.conflicts = nil
+
+	model := &sudokuPlayPrompt{
+		grid:        grid,
+		game:        game,
+		actions:     actions,
+		givens:      map[*models.SudokuCellDTO]bool{},
+		pencilMarks: map[*models.SudokuCellDTO][]int{},
+		wrongCells:  map[*models.SudokuCellDTO]bool{},
+		elapsed:     game.ElapsedSeconds,
+		hints:       game.Hints,
+		won:         game.Solved,
+	}
+
+	findPlayCell(model, func(box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) bool {
+		if !box.Disabled && cell.Value != nil {
+			model.givens[cell] = true
+		}
+
+		return false
+	})
+
+	maximumValue := int(grid.sudokuDTO.BoxSize) * int(grid.sudokuDTO.BoxSize)
+	isValidValue := func(value int) bool {
+		return value >= 1 && value <= maximumValue
+	}
+
+	for _, entry := range game.Entries {
+		box, cell := getSudokuCellAtPosition(grid.sudokuDTO, entry.SudokuCellPositionDTO)
+		location := fmt.Sprintf("row %d, column %d", entry.Row+1, entry.Column+1)
+
+		switch {
+		case cell == nil || box.Disabled:
+			return nil, fmt.Errorf("game entry in %s is outside of the puzzle", location)
+		case model.givens[cell]:
+			return nil, fmt.Errorf("game entry in %s overrides given value", location)
+		case entry.Value != nil && !isValidValue(*entry.Value):
+			return nil, fmt.Errorf("game entry in %s has invalid value %d", location, *entry.Value)
+		case slices.ContainsFunc(entry.PencilMarks, func(mark int) bool { return !isValidValue(mark) }):
+			return nil, fmt.Errorf("game entry in %s has invalid pencil marks", location)
+		}
+
+		if entry.Value != nil {
+			value := *entry.Value
+			cell.Value = &value
+		}
+
+		if len(entry.PencilMarks) > 0 {
+			marks := slices.Clone(entry.PencilMarks)
+			slices.Sort(marks)
+			model.pencilMarks[cell] = slices.Compact(marks)
+		}
+	}
+
+	model.conflicts = getConflictingCells(grid.sudokuDTO)
+	refreshPlayGrid(model)
+
+	return model, nil
+}
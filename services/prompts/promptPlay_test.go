@@ -0,0 +1,569 @@
+package prompts
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPromptPlaySudoku(t *testing.T) {
+	testCases := []struct {
+		name          string
+		game          *models.SudokuGameDTO
+		runnerError   error
+		expectsError  bool
+		expectedPrint string
+	}{
+		{
+			name: "Success",
+			game: &models.SudokuGameDTO{Puzzle: testHelpers.GetTestSudokuDto()},
+		},
+		{
+			name:          "Runner error",
+			game:          &models.SudokuGameDTO{Puzzle: testHelpers.GetTestSudokuDto()},
+			runnerError:   errors.New("some error"),
+			expectsError:  true,
+			expectedPrint: "some error",
+		},
+		{
+			name:          "Game without puzzle",
+			game:          &models.SudokuGameDTO{},
+			expectsError:  true,
+			expectedPrint: "the game has no sudoku puzzle",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testPrinter := testHelpers.NewTestPrinter()
+		prompter := GetNewPrompter(testHelpers.GetTestSettings(), testPrinter,
+			func(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+				if testCase.runnerError != nil {
+					return nil, testCase.runnerError
+				}
+
+				return *model.(*sudokuPlayPrompt), nil
+			})
+
+		err := prompter.PromptPlaySudoku(testCase.game, &models.SudokuGameActions{})
+		if (err != nil) != testCase.expectsError {
+			t.Errorf("%s: invalid error state - %v", testCase.name, err)
+		}
+
+		if !strings.Contains(testPrinter.PrintedData, testCase.expectedPrint) {
+			t.Errorf("%s: printout is missing '%s'", testCase.name, testCase.expectedPrint)
+		}
+	}
+}
+
+func TestBuildSudokuPlayPromptModel(t *testing.T) {
+	testCases := []struct {
+		name          string
+		entries       models.GenericSlice[*models.SudokuGameEntryDTO]
+		expectedError string
+	}{
+		{
+			name: "Value and pencil marks",
+			entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 0}, Value: getIntPointer(2)},
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 1}, PencilMarks: []int{8, 6, 6}},
+			},
+		},
+		{
+			name: "Entry outside of the puzzle",
+			entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 9, Column: 0}, Value: getIntPointer(2)},
+			},
+			expectedError: "game entry in row 10, column 1 is outside of the puzzle",
+		},
+		{
+			name: "Entry overriding given value",
+			entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 5}, Value: getIntPointer(2)},
+			},
+			expectedError: "game entry in row 1, column 6 overrides given value",
+		},
+		{
+			name: "Invalid value",
+			entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 0}, Value: getIntPointer(10)},
+			},
+			expectedError: "game entry in row 1, column 1 has invalid value 10",
+		},
+		{
+			name: "Invalid pencil marks",
+			entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+				{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 0}, PencilMarks: []int{0}},
+			},
+			expectedError: "game entry in row 1, column 1 has invalid pencil marks",
+		},
+	}
+
+	for _, testCase := range testCases {
+		game := &models.SudokuGameDTO{Puzzle: getTestSudokDto(t), Entries: testCase.entries}
+		model, err := buildSudokuPlayPromptModel(game, nil, testHelpers.GetTestSettings())
+		if len(testCase.expectedError) > 0 {
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf("%s: expected error '%s', got '%v'", testCase.name, testCase.expectedError, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", testCase.name, err)
+			continue
+		}
+
+		firstBox := model.grid.sudokuDTO.Boxes[0]
+		if value := firstBox.Cells[0].Value; value == nil || *value != 2 ||
+			!reflect.DeepEqual(model.pencilMarks[firstBox.Cells[1]], []int{6, 8}) {
+			t.Errorf("%s: entries are not applied to the grid", testCase.name)
+		}
+
+		if game.Puzzle.Boxes[0].Cells[0].Value != nil {
+			t.Errorf("%s: entries are applied to the puzzle", testCase.name)
+		}
+
+		if len(model.givens) != 36 || !model.givens[model.grid.sudokuDTO.Boxes[1].Cells[2]] {
+			t.Errorf("%s: invalid given values", testCase.name)
+		}
+	}
+}
+
+func TestUpdate_PlayPrompt(t *testing.T) {
+	solution := getTestPlaySolution(t)
+	saveError := errors.New("disk is full")
+
+	// cells of the first row - (0, 0) is empty, (0, 5) is given value 4
+	firstCell := func(model *sudokuPlayPrompt) *models.SudokuCellDTO {
+		return model.grid.sudokuDTO.Boxes[0].Cells[0]
+	}
+	givenCell := func(model *sudokuPlayPrompt) *models.SudokuCellDTO {
+		return model.grid.sudokuDTO.Boxes[1].Cells[2]
+	}
+	hasValue := func(cell *models.SudokuCellDTO, value int) bool {
+		return cell.Value != nil && *cell.Value == value
+	}
+	fillButFirstCell := func(model *sudokuPlayPrompt) {
+		for boxIndex, box := range model.grid.sudokuDTO.Boxes {
+			for cellIndex, cell := range box.Cells {
+				if cell.Value == nil && cell != firstCell(model) {
+					cell.Value = solution.Boxes[boxIndex].Cells[cellIndex].Value
+				}
+			}
+		}
+	}
+
+	testCases := []struct {
+		name                 string
+		prepare              func(model *sudokuPlayPrompt)
+		saveError            error
+		messages             []tea.Msg
+		expectsResultCommand bool
+		modelStateValidator  func(model sudokuPlayPrompt, saves int) bool
+	}{
+		{
+			name:     "enter value",
+			messages: []tea.Msg{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return hasValue(firstCell(&model), 5) && len(model.game.Entries) == 1 &&
+					*model.game.Entries[0].Value == 5
+			},
+		},
+		{
+			name: "replace value",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return hasValue(firstCell(&model), 2)
+			},
+		},
+		{
+			name: "given value is locked",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.grid.currentBox = model.grid.sudokuDTO.Boxes[1]
+				model.grid.currentCell = givenCell(model)
+			},
+			messages: []tea.Msg{tea.KeyMsg{Type: tea.KeyDelete}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return hasValue(givenCell(&model), 4) && model.statusIsError &&
+					model.status == "Given values can not be changed"
+			},
+		},
+		{
+			name: "move and enter value",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return firstCell(&model).Value == nil &&
+					hasValue(model.grid.sudokuDTO.Boxes[0].Cells[1], 6)
+			},
+		},
+		{
+			name: "conflicting value",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				// the value is repeated in the row, the column and the box
+				return len(model.conflicts) == 3 && model.conflicts[givenCell(&model)] &&
+					reflect.DeepEqual(model.grid.cellStyles[firstCell(&model)], models.TerminalStyles.ErrorStyle)
+			},
+		},
+		{
+			name: "pencil marks",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyTab},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.pencilMode && firstCell(&model).Value == nil &&
+					reflect.DeepEqual(model.pencilMarks[firstCell(&model)], []int{2, 5}) &&
+					reflect.DeepEqual(model.game.Entries[0].PencilMarks, []int{2, 5}) &&
+					model.grid.emptyCellSigns[firstCell(&model)] == "·"
+			},
+		},
+		{
+			name: "delete value, then pencil marks",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.pencilMarks[firstCell(model)] = []int{2, 5}
+				firstCell(model).Value = getIntPointer(2)
+			},
+			messages: []tea.Msg{tea.KeyMsg{Type: tea.KeyDelete}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return firstCell(&model).Value == nil && len(model.pencilMarks[firstCell(&model)]) == 2
+			},
+		},
+		{
+			name: "delete pencil marks of empty cell",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.pencilMarks[firstCell(model)] = []int{2, 5}
+			},
+			messages: []tea.Msg{tea.KeyMsg{Type: tea.KeyBackspace}, tea.KeyMsg{Type: tea.KeyDelete}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return len(model.pencilMarks) == 0
+			},
+		},
+		{
+			name:                 "quit without saving",
+			messages:             []tea.Msg{tea.KeyMsg{Type: tea.KeyCtrlC}},
+			expectsResultCommand: true,
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.quit && saves == 0
+			},
+		},
+		{
+			name:                 "save and quit",
+			messages:             []tea.Msg{tea.KeyMsg{Type: tea.KeyEsc}},
+			expectsResultCommand: true,
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.quit && saves == 1
+			},
+		},
+		{
+			name:      "save failure",
+			saveError: saveError,
+			messages:  []tea.Msg{tea.KeyMsg{Type: tea.KeyEsc}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return !model.quit && model.statusIsError &&
+					model.status == "Failed to save the game - disk is full"
+			},
+		},
+		{
+			name:     "save",
+			messages: []tea.Msg{tea.KeyMsg{Type: tea.KeyCtrlS}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return !model.quit && saves == 1 && model.status == "Game saved"
+			},
+		},
+		{
+			name:                 "hint requests solution",
+			messages:             []tea.Msg{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}}},
+			expectsResultCommand: true,
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.solving && model.pendingAction == playHintAction && firstCell(&model).Value == nil
+			},
+		},
+		{
+			name: "hint reveals current cell",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}},
+				playSolutionMsg{solution: solution},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return hasValue(firstCell(&model), 2) && model.hints == 1 && model.game.Hints == 1 &&
+					!model.solving && model.status == "Hint: 2 in row 1, column 1"
+			},
+		},
+		{
+			name: "hint reveals first empty cell",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.grid.currentBox = model.grid.sudokuDTO.Boxes[1]
+				model.grid.currentCell = givenCell(model)
+			},
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'?'}},
+				playSolutionMsg{solution: solution},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return hasValue(firstCell(&model), 2) && model.grid.currentCell == firstCell(&model)
+			},
+		},
+		{
+			name: "check values",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}},
+				playSolutionMsg{solution: solution},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.wrongCells[firstCell(&model)] && model.status == "1 value(s) are wrong" &&
+					reflect.DeepEqual(model.grid.cellStyles[firstCell(&model)], models.TerminalStyles.ErrorStyle)
+			},
+		},
+		{
+			name: "check without mistakes",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}},
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}},
+				playSolutionMsg{solution: solution},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return len(model.wrongCells) == 0 && model.status == "No mistakes so far, 44 cell(s) left"
+			},
+		},
+		{
+			name: "unsolvable puzzle",
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}},
+				playSolutionMsg{err: errors.New("no solution")},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.statusIsError && model.status == "The puzzle can not be solved - no solution"
+			},
+		},
+		{
+			name:    "win",
+			prepare: fillButFirstCell,
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}},
+				playSolutionMsg{solution: solution},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.won && model.game.Solved && saves == 1 && len(model.game.Entries) == 45
+			},
+		},
+		{
+			name:    "filled with wrong value",
+			prepare: fillButFirstCell,
+			messages: []tea.Msg{
+				tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'2'}},
+				playSolutionMsg{solution: getTestSudokDto(t)},
+			},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return !model.won && model.statusIsError &&
+					model.status == "The puzzle can not be solved - solution does not match the puzzle"
+			},
+		},
+		{
+			name: "any key exits won game",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.won = true
+			},
+			messages:             []tea.Msg{tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'5'}}},
+			expectsResultCommand: true,
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.quit && firstCell(&model).Value == nil
+			},
+		},
+		{
+			name:                 "timer",
+			messages:             []tea.Msg{playTickMsg{}, playTickMsg{}},
+			expectsResultCommand: true,
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.elapsed == 2 && model.game.ElapsedSeconds == 2
+			},
+		},
+		{
+			name: "timer stops after win",
+			prepare: func(model *sudokuPlayPrompt) {
+				model.won = true
+			},
+			messages: []tea.Msg{playTickMsg{}},
+			modelStateValidator: func(model sudokuPlayPrompt, saves int) bool {
+				return model.elapsed == 0
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		saves := 0
+		actions := &models.SudokuGameActions{
+			Solve: func(puzzle *models.SudokuDTO) (*models.SudokuDTO, error) {
+				return solution, nil
+			},
+			Save: func(game *models.SudokuGameDTO) error {
+				if testCase.saveError != nil {
+					return testCase.saveError
+				}
+
+				saves++
+				return nil
+			},
+		}
+
+		game := &models.SudokuGameDTO{Puzzle: getTestSudokDto(t)}
+		model, err := buildSudokuPlayPromptModel(game, actions, testHelpers.GetTestSettings())
+		if err != nil {
+			t.Fatalf("%s: failed to build prompt model - %s", testCase.name, err)
+		}
+
+		if testCase.prepare != nil {
+			testCase.prepare(model)
+		}
+
+		var resultModel tea.Model = *model
+		var command tea.Cmd
+		for _, message := range testCase.messages {
+			resultModel, command = resultModel.Update(message)
+		}
+
+		if testCase.expectsResultCommand != (command != nil) {
+			t.Errorf("%s: invalid returned command state", testCase.name)
+		}
+
+		if !testCase.modelStateValidator(resultModel.(sudokuPlayPrompt), saves) {
+			t.Errorf("%s: invalid model state", testCase.name)
+		}
+	}
+}
+
+func TestPlayPrompt_SolverCommand(t *testing.T) {
+	solution := getTestPlaySolution(t)
+	var solvedPuzzle *models.SudokuDTO
+	actions := &models.SudokuGameActions{
+		Solve: func(puzzle *models.SudokuDTO) (*models.SudokuDTO, error) {
+			solvedPuzzle = puzzle
+			return solution, nil
+		},
+	}
+
+	game := &models.SudokuGameDTO{Puzzle: getTestSudokDto(t)}
+	model, _ := buildSudokuPlayPromptModel(game, actions, testHelpers.GetTestSettings())
+	model.grid.currentCell.Value = getIntPointer(2)
+
+	_, command := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	message, ok := command().(playSolutionMsg)
+	if !ok || message.solution != solution {
+		t.Errorf("Solver command returned unexpected message %v", message)
+	}
+
+	// only givens are solved, so values entered by the player do not affect the solution
+	if solvedPuzzle != game.Puzzle || solvedPuzzle.Boxes[0].Cells[0].Value != nil {
+		t.Error("Solver was not called with the puzzle of the game")
+	}
+}
+
+func TestView_PlayPrompt(t *testing.T) {
+	game := &models.SudokuGameDTO{
+		Puzzle: getTestSudokDto(t),
+		Entries: models.GenericSlice[*models.SudokuGameEntryDTO]{
+			{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 0}, Value: getIntPointer(2)},
+			{SudokuCellPositionDTO: models.SudokuCellPositionDTO{Row: 0, Column: 1}, PencilMarks: []int{6, 8}},
+		},
+		ElapsedSeconds: 75,
+		Hints:          2,
+	}
+	model, _ := buildSudokuPlayPromptModel(game, nil, testHelpers.GetTestSettings())
+	goRightSudokuCell(model.grid)
+
+	expectedSubstrings := []string{
+		"Time: 01:15",
+		"Hints: 2",
+		"Mode: values",
+		"║ 2 │ · │ _ ║ _ │ _ │ 4 ║ 3 │ 1 │ _ ║",
+		"║ 3 │ _ │ 9 ║ 2 │ 7 │ _ ║ 5 │ 6 │ _ ║",
+		"Pencil marks: 6 8",
+		"44 cell(s) left",
+		"Controls:",
+		"Toggle pencil marks: tab",
+		"Hint: ?",
+		"Check values: !",
+		"Save and quit: esc",
+		"Quit without saving: ctrl+c",
+	}
+
+	viewString := model.View()
+	for _, expectedSubstring := range expectedSubstrings {
+		if !strings.Contains(viewString, expectedSubstring) {
+			t.Errorf("Play prompt view string does not contain '%s' substring.", expectedSubstring)
+		}
+	}
+
+	model.won = true
+	viewString = model.View()
+	if !strings.Contains(viewString, "You won! Sudoku solved in 01:15 with 2 hint(s).") ||
+		strings.Contains(viewString, "Controls:") {
+		t.Errorf("Play prompt view string does not present won game: '%s'", viewString)
+	}
+
+	model.quit = true
+	if viewString := model.View(); viewString != "" {
+		t.Errorf("Play prompt view string should be empty, but it is: '%s'", viewString)
+	}
+}
+
+func TestView_PlayPrompt_DisabledBoxes(t *testing.T) {
+	sudokuDto := getTestSudokDto(t)
+	sudokuDto.Boxes[1].Disabled = true
+	model, _ := buildSudokuPlayPromptModel(&models.SudokuGameDTO{Puzzle: sudokuDto}, nil,
+		testHelpers.GetTestSettings())
+
+	viewString := model.View()
+	if !strings.Contains(viewString, "║ _ │ _ │ _ ║   │   │ 4 ║ 3 │ 1 │ _ ║") {
+		t.Errorf("Play prompt view string does not present disabled box: '%s'", viewString)
+	}
+}
+
+func TestFormatPlayTime(t *testing.T) {
+	testCases := map[int]string{
+		0:    "00:00",
+		75:   "01:15",
+		3599: "59:59",
+		3725: "1:02:05",
+	}
+
+	for seconds, expected := range testCases {
+		if result := formatPlayTime(seconds); result != expected {
+			t.Errorf("Expected '%s' for %d seconds, got '%s'", expected, seconds, result)
+		}
+	}
+}
+
+func getTestPlaySolution(t *testing.T) *models.SudokuDTO {
+	testFilePath := "../../testConfigs/simple1_solution.json"
+	bytes, err := os.ReadFile(testFilePath)
+	if err != nil {
+		t.Fatalf("failed to read sudoku test solution from file '%s'", testFilePath)
+	}
+
+	solution := &models.SudokuDTO{}
+	if err := json.Unmarshal(bytes, solution); err != nil {
+		t.Fatalf("failed to parse sudoku test solution - %s", err)
+	}
+
+	return solution
+}
@@ -2,9 +2,12 @@ package prompts
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
+	"unicode"
 
+	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -14,14 +17,34 @@ type sudokuValuesPrompt struct {
 	sudokuDTO         *models.SudokuDTO
 	settings          *models.Settings
 	quit              bool
+	alphabet          *models.SymbolAlphabet
 	charactersPerCell int
 	currentBox        *models.SudokuBoxDTO
 	currentCell       *models.SudokuCellDTO
+	// cellStyles overrides style of the cells which are not active,
+	// emptyCellSigns overrides sign printed in the cells without value
+	cellStyles     map[*models.SudokuCellDTO]lipgloss.Style
+	emptyCellSigns map[*models.SudokuCellDTO]string
+	// selection is a rectangle between the anchor and current cell
+	selectionAnchor *models.SudokuCellPositionDTO
+	undoHistory     []*sudokuEditorChange
+	redoHistory     []*sudokuEditorChange
+	status          string
+	// conflicts are checked while editing, nil disables the checks
+	conflicts *sudokuConflicts
+	// allowConflicts enables confirmation of the sudoku with conflicts (alt+enter)
+	allowConflicts bool
+	// terminal size (zero if not known) and the first box printed in the viewport
+	width          int
+	height         int
+	firstBoxRow    int8
+	firstBoxColumn int8
 }
 
 // PromptSudokuValues wraps logic for prompting user for sudoku values
-// input with respect to prior configuration (box size, layout sizes)
-func (prompter *Prompter) PromptSudokuValues(sudokuDto *models.SudokuDTO) error {
+// input with respect to prior configuration (box size, layout sizes).
+// Sudoku with conflicting values can be confirmed only if allowConflicts is set.
+func (prompter *Prompter) PromptSudokuValues(sudokuDto *models.SudokuDTO, allowConflicts bool) error {
 	failError := fmt.Errorf("failed to get sudoku values from manual input")
 	initialModel, err := buildSudokuValuesPromptModel(sudokuDto, prompter.Settings)
 	if err != nil {
@@ -30,7 +53,10 @@ func (prompter *Prompter) PromptSudokuValues(sudokuDto *models.SudokuDTO) error
 		return failError
 	}
 
-	model, err := prompter.TeaProgramRunner(initialModel)
+	initialModel.allowConflicts = allowConflicts
+
+	// positions of the mouse match the view only on alternate screen
+	model, err := prompter.TeaProgramRunner(initialModel, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	if err != nil {
 		prompter.TerminalPrinter.PrintError(err.Error())
 		prompter.TerminalPrinter.PrintNewLine()
@@ -53,70 +79,136 @@ func (m sudokuValuesPrompt) Init() tea.Cmd {
 func (m sudokuValuesPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch messageType := msg.(type) {
 	case tea.KeyMsg:
+		m.status = ""
+
+		// pasted text is received as a bunch of runes, but so can be keys typed
+		// quickly - text shorter than values of the box is handled key by key
+		if messageType.Type == tea.KeyRunes && !messageType.Alt && len(messageType.Runes) > 1 {
+			if len(messageType.Runes) < int(m.sudokuDTO.BoxSize)*int(m.sudokuDTO.BoxSize) {
+				var model tea.Model = m
+				for _, character := range messageType.Runes {
+					model, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{character}})
+				}
+
+				return model, nil
+			}
+
+			editSudoku(&m, func() {
+				if err := fillCurrentBox(&m, string(messageType.Runes)); err != nil {
+					m.status = fmt.Sprintf("Failed to fill the box - %s", err)
+				}
+			})
+			return m, nil
+		}
+
+		if m.alphabet != nil && messageType.Type == tea.KeyRunes &&
+			!messageType.Alt && len(messageType.Runes) == 1 {
+			// symbols take precedence over editor keys, digits that are
+			// not a part of the alphabet cannot be used to build a value
+			if value, ok := m.alphabet.GetValue(messageType.Runes[0]); ok {
+				editSudoku(&m, func() { setValue(&m, value) })
+				return m, nil
+			}
+
+			if unicode.IsDigit(messageType.Runes[0]) {
+				return m, nil
+			}
+		}
+
 		switch messageType.String() {
 		case "ctrl+c", "esc":
 			m.quit = true
 			return m, tea.Quit
 
-		case "enter":
+		case "enter", "alt+enter":
+			forced := m.allowConflicts && messageType.String() == "alt+enter"
+			if !forced && m.conflicts != nil && len(m.conflicts.cells) > 0 {
+				m.status = "Resolve the conflicts before confirming"
+				if m.allowConflicts {
+					m.status += ", or confirm anyway with alt+enter"
+				}
+
+				return m, nil
+			}
+
 			m.quit = true
 			return m, tea.Quit
 
 		case "up", "k":
-			goUpSudokuCell(&m)
+			moveSudokuCell(&m, goUpSudokuCell)
 
 		case "down", "j":
-			goDownSudokuCell(&m)
+			moveSudokuCell(&m, goDownSudokuCell)
 
 		case "left", "h":
-			goLeftSudokuCell(&m)
+			moveSudokuCell(&m, goLeftSudokuCell)
 
 		case "right", "l":
-			goRightSudokuCell(&m)
-
-		case "0":
-			appendValue(&m, 0)
+			moveSudokuCell(&m, goRightSudokuCell)
 
-		case "1":
-			appendValue(&m, 1)
+		case "shift+up":
+			selectSudokuCells(&m, goUpSudokuCell)
 
-		case "2":
-			appendValue(&m, 2)
+		case "shift+down":
+			selectSudokuCells(&m, goDownSudokuCell)
 
-		case "3":
-			appendValue(&m, 3)
+		case "shift+left":
+			selectSudokuCells(&m, goLeftSudokuCell)
 
-		case "4":
-			appendValue(&m, 4)
+		case "shift+right":
+			selectSudokuCells(&m, goRightSudokuCell)
 
-		case "5":
-			appendValue(&m, 5)
+		case "alt+up":
+			editSudoku(&m, func() { toggleComparison(&m, -1, 0) })
 
-		case "6":
-			appendValue(&m, 6)
+		case "alt+down":
+			editSudoku(&m, func() { toggleComparison(&m, 1, 0) })
 
-		case "7":
-			appendValue(&m, 7)
+		case "alt+left":
+			editSudoku(&m, func() { toggleComparison(&m, 0, -1) })
 
-		case "8":
-			appendValue(&m, 8)
+		case "alt+right":
+			editSudoku(&m, func() { toggleComparison(&m, 0, 1) })
 
-		case "9":
-			appendValue(&m, 9)
+		case "0", "1", "2", "3", "4", "5", "6", "7", "8", "9":
+			digit := int(messageType.Runes[0] - '0')
+			editSudoku(&m, func() { appendValue(&m, digit) })
 
 		case "delete":
-			clearCurrentCellValue(&m)
+			editSudoku(&m, func() { clearSelectedCells(&m) })
 
 		case "backspace":
-			backspaceCurrentCellValue(&m)
+			editSudoku(&m, func() { backspaceCurrentCellValue(&m) })
 
 		case "e":
-			changeDisableStateOfCurrentBox(&m, true)
+			editSudoku(&m, func() { changeDisableStateOfCurrentBox(&m, true) })
 
 		case "d":
-			changeDisableStateOfCurrentBox(&m, false)
+			editSudoku(&m, func() { changeDisableStateOfCurrentBox(&m, false) })
+
+		case "ctrl+z":
+			if !undoSudokuChange(&m) {
+				m.status = "Nothing to undo"
+			}
+
+		case "ctrl+y":
+			if !redoSudokuChange(&m) {
+				m.status = "Nothing to redo"
+			}
 		}
 
+		followSudokuCursor(&m)
+
+	case tea.MouseMsg:
+		if messageType.Action == tea.MouseActionPress {
+			m.status = ""
+		}
+
+		handleSudokuMouse(&m, messageType)
+
+	case tea.WindowSizeMsg:
+		m.width, m.height = messageType.Width, messageType.Height
+		followSudokuCursor(&m)
 	}
 
 	return m, nil
@@ -129,81 +221,140 @@ func (m sudokuValuesPrompt) View() string {
 	}
 
 	builder := strings.Builder{}
+	printSudokuGrid(&builder, &m)
+	printSudokuFooter(&builder, &m, getSudokuViewport(&m))
 
-	// iterate through all rows (boxes and cells)
+	return builder.String()
+}
+
+// printSudokuConflicts prints a status line with values repeated in houses
+// of the sudoku, only the first few of them if there are many
+func printSudokuConflicts(builder *strings.Builder, model *sudokuValuesPrompt) {
+	if model.conflicts == nil || len(model.conflicts.cells) < 1 {
+		return
+	}
+
+	maxDescriptions := 5
+	descriptions := model.conflicts.getDescriptions(model.alphabet)
+	status := fmt.Sprintf("Conflicts: %s", strings.Join(descriptions[:min(len(descriptions), maxDescriptions)], ", "))
+	if len(descriptions) > maxDescriptions {
+		status += fmt.Sprintf(" and %d more", len(descriptions)-maxDescriptions)
+	}
+
+	builder.WriteString(models.TerminalStyles.ErrorStyle.Render(status))
+	builder.WriteString("\n")
+}
+
+// printSudokuGrid prints boxes and cells of the sudoku visible in the viewport with borders
+func printSudokuGrid(builder *strings.Builder, model *sudokuValuesPrompt) {
+	viewport := getSudokuViewport(model)
+
+	// iterate through visible rows (boxes and cells)
 	var boxRowIndex int8 = 0
-	var maxBoxRowIndex int8 = m.sudokuDTO.Layout.Height - 1
+	var maxBoxRowIndex int8 = viewport.firstBoxRow + viewport.boxRows - 1
 	var cellRowIndex int8 = 0
-	var maxCellRowIndex int8 = m.sudokuDTO.BoxSize - 1
+	var maxCellRowIndex int8 = model.sudokuDTO.BoxSize - 1
 
-	for boxRowIndex = 0; boxRowIndex <= maxBoxRowIndex; boxRowIndex++ {
+	for boxRowIndex = viewport.firstBoxRow; boxRowIndex <= maxBoxRowIndex; boxRowIndex++ {
 		for cellRowIndex = 0; cellRowIndex <= maxCellRowIndex; cellRowIndex++ {
-			if boxRowIndex == 0 && cellRowIndex == 0 {
-				printTopBorderLine(&builder, &m)
+			if boxRowIndex == viewport.firstBoxRow && cellRowIndex == 0 {
+				printTopBorderLine(builder, model, viewport)
 			}
 
-			printSudokuValuesLine(&builder, &m, boxRowIndex, cellRowIndex)
+			printSudokuValuesLine(builder, model, viewport, boxRowIndex, cellRowIndex)
 
-			if cellRowIndex < m.sudokuDTO.BoxSize-1 {
-				printMidCellsLine(&builder, &m)
+			if cellRowIndex < model.sudokuDTO.BoxSize-1 && !viewport.compact {
+				printMidCellsLine(builder, model, viewport, boxRowIndex, cellRowIndex)
 			}
 		}
 
-		if boxRowIndex < m.sudokuDTO.Layout.Height-1 {
-			printMidBoxesLine(&builder, &m)
+		if boxRowIndex < maxBoxRowIndex {
+			printMidBoxesLine(builder, model, viewport)
 		}
 	}
 
-	printBottomBorderLine(&builder, &m)
+	printBottomBorderLine(builder, model, viewport)
 	builder.WriteString("\n")
-
-	printSudokuControls(&builder)
-
-	return builder.String()
 }
 
 // printTopBorderLine prints top border line of a sudoku puzzle
-func printTopBorderLine(builder *strings.Builder, model *sudokuValuesPrompt) {
-	printSudokuHorizontalBoxLine(builder, model, "╔", "═", "╗", "╦")
+func printTopBorderLine(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport) {
+	printSudokuHorizontalBoxLine(builder, model, viewport, "╔", "═", "╗", "╦")
 }
 
 // printMidCellsLine prints line of a sudoku puzzle that appears between cells
-func printMidCellsLine(builder *strings.Builder, model *sudokuValuesPrompt) {
-	printSudokuHorizontalBoxLine(builder, model, "║", "─", "║", "║")
+// with comparison signs between vertically adjacent cells
+func printMidCellsLine(builder *strings.Builder, model *sudokuValuesPrompt,
+	viewport *sudokuViewport, boxRowIndex int8, cellRowIndex int8) {
+
+	paddingLength := int(model.settings.SudokuPrintoutValuePaddingLength)
+	charsPerCell := model.charactersPerCell + 2*paddingLength
+	signIndex := paddingLength + (model.charactersPerCell-1)/2
+
+	builder.WriteString(models.TerminalStyles.BorderStyle.Render("║"))
+
+	var boxColumnIndex int8 = 0
+	var maxBoxColumnIndex int8 = viewport.firstBoxColumn + viewport.boxColumns - 1
+	var cellColumnIndex int8 = 0
+	for boxColumnIndex = viewport.firstBoxColumn; boxColumnIndex <= maxBoxColumnIndex; boxColumnIndex++ {
+		for cellColumnIndex = 0; cellColumnIndex < model.sudokuDTO.BoxSize; cellColumnIndex++ {
+			if cellColumnIndex > 0 {
+				builder.WriteString(models.TerminalStyles.BorderStyle.Render("─"))
+			}
+
+			upperCellPosition := models.SudokuCellPositionDTO{
+				Row:    helpers.GetAbsoluteCellIndex(model.sudokuDTO.BoxSize, boxRowIndex, cellRowIndex),
+				Column: helpers.GetAbsoluteCellIndex(model.sudokuDTO.BoxSize, boxColumnIndex, cellColumnIndex),
+			}
+			lowerCellPosition := models.SudokuCellPositionDTO{
+				Row:    upperCellPosition.Row + 1,
+				Column: upperCellPosition.Column,
+			}
+
+			for characterIndex := 0; characterIndex < charsPerCell; characterIndex++ {
+				if characterIndex != signIndex ||
+					!printComparisonSign(builder, model, upperCellPosition, lowerCellPosition, "v", "^") {
+					builder.WriteString(models.TerminalStyles.BorderStyle.Render("─"))
+				}
+			}
+		}
+
+		if boxColumnIndex < maxBoxColumnIndex {
+			builder.WriteString(models.TerminalStyles.BorderStyle.Render("║"))
+		}
+	}
+
+	builder.WriteString(models.TerminalStyles.BorderStyle.Render("║"))
+	builder.WriteString(models.TerminalStyles.BorderStyle.Render("\n"))
 }
 
 // printMidBoxesLine prints line of a sudoku puzzle that appears between boxes
-func printMidBoxesLine(builder *strings.Builder, model *sudokuValuesPrompt) {
-	printSudokuHorizontalBoxLine(builder, model, "║", "═", "║", "╬")
+func printMidBoxesLine(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport) {
+	printSudokuHorizontalBoxLine(builder, model, viewport, "║", "═", "║", "╬")
 }
 
 // printBottomBorderLine prints bottom border line of a sudoku puzzle
-func printBottomBorderLine(builder *strings.Builder, model *sudokuValuesPrompt) {
-	printSudokuHorizontalBoxLine(builder, model, "╚", "═", "╝", "╩")
+func printBottomBorderLine(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport) {
+	printSudokuHorizontalBoxLine(builder, model, viewport, "╚", "═", "╝", "╩")
 }
 
 // printSudokuHorizontalBoxLine prints sudoku vertival line - between boxes
-func printSudokuHorizontalBoxLine(builder *strings.Builder, model *sudokuValuesPrompt,
+func printSudokuHorizontalBoxLine(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport,
 	startSign string, middleSign string, endSign string, columnCrossSign string) {
 
-	paddingLength := int(model.settings.SudokuPrintoutValuePaddingLength)
-	charsPerCell := model.charactersPerCell + 2*paddingLength
+	// box width includes its right border
+	boxWidth, _ := getSudokuBoxPrintSize(model, viewport.compact)
 
 	builder.WriteString(models.TerminalStyles.BorderStyle.Render(startSign))
 
 	var boxColumnIndex int8 = 0
-	var cellColumnIndex int8 = 0
-	for boxColumnIndex = 0; boxColumnIndex < model.sudokuDTO.Layout.Width; boxColumnIndex++ {
-		for cellColumnIndex = 0; cellColumnIndex < model.sudokuDTO.BoxSize; cellColumnIndex++ {
-			if cellColumnIndex > 0 {
-				builder.WriteString(models.TerminalStyles.BorderStyle.Render(middleSign))
-			}
-			for characterIndex := 0; characterIndex < charsPerCell; characterIndex++ {
-				builder.WriteString(models.TerminalStyles.BorderStyle.Render(middleSign))
-			}
+	var maxBoxColumnIndex int8 = viewport.firstBoxColumn + viewport.boxColumns - 1
+	for boxColumnIndex = viewport.firstBoxColumn; boxColumnIndex <= maxBoxColumnIndex; boxColumnIndex++ {
+		for characterIndex := 0; characterIndex < boxWidth-1; characterIndex++ {
+			builder.WriteString(models.TerminalStyles.BorderStyle.Render(middleSign))
 		}
 
-		if boxColumnIndex < model.sudokuDTO.Layout.Width-1 {
+		if boxColumnIndex < maxBoxColumnIndex {
 			builder.WriteString(models.TerminalStyles.BorderStyle.Render(columnCrossSign))
 		}
 	}
@@ -214,31 +365,43 @@ func printSudokuHorizontalBoxLine(builder *strings.Builder, model *sudokuValuesP
 
 // printSudokuValuesLine prints single row of sudoku values
 func printSudokuValuesLine(builder *strings.Builder, model *sudokuValuesPrompt,
-	boxRowIndex int8, cellRowIndex int8) {
+	viewport *sudokuViewport, boxRowIndex int8, cellRowIndex int8) {
 
 	builder.WriteString(models.TerminalStyles.BorderStyle.Render("║"))
 
 	var boxColumnIndex int8 = 0
+	var maxBoxColumnIndex int8 = viewport.firstBoxColumn + viewport.boxColumns - 1
 	var cellColumnIndex int8 = 0
 
-	for boxColumnIndex = 0; boxColumnIndex < model.sudokuDTO.Layout.Width; boxColumnIndex++ {
+	for boxColumnIndex = viewport.firstBoxColumn; boxColumnIndex <= maxBoxColumnIndex; boxColumnIndex++ {
 		sudokuBox := model.sudokuDTO.Boxes.FirstOrDefault(nil, func(box *models.SudokuBoxDTO) bool {
 			return box.IndexColumn == boxColumnIndex && box.IndexRow == boxRowIndex
 		})
 
 		for cellColumnIndex = 0; cellColumnIndex < model.sudokuDTO.BoxSize; cellColumnIndex++ {
 			if cellColumnIndex > 0 {
-				builder.WriteString(models.TerminalStyles.BorderStyle.Render("│"))
+				leftCellPosition := models.SudokuCellPositionDTO{
+					Row:    helpers.GetAbsoluteCellIndex(model.sudokuDTO.BoxSize, boxRowIndex, cellRowIndex),
+					Column: helpers.GetAbsoluteCellIndex(model.sudokuDTO.BoxSize, boxColumnIndex, cellColumnIndex) - 1,
+				}
+				rightCellPosition := models.SudokuCellPositionDTO{
+					Row:    leftCellPosition.Row,
+					Column: leftCellPosition.Column + 1,
+				}
+
+				if !printComparisonSign(builder, model, leftCellPosition, rightCellPosition, ">", "<") {
+					builder.WriteString(models.TerminalStyles.BorderStyle.Render("│"))
+				}
 			}
 
 			sudokuCell := sudokuBox.Cells.FirstOrDefault(nil, func(cell *models.SudokuCellDTO) bool {
 				return cell.IndexColumnInBox == cellColumnIndex && cell.IndexRowInBox == cellRowIndex
 			})
 
-			printSudokuCell(builder, model, sudokuBox, sudokuCell)
+			printSudokuCell(builder, model, viewport, sudokuBox, sudokuCell)
 		}
 
-		if boxColumnIndex < model.sudokuDTO.Layout.Width-1 {
+		if boxColumnIndex < maxBoxColumnIndex {
 			builder.WriteString(models.TerminalStyles.BorderStyle.Render("║"))
 		}
 	}
@@ -247,9 +410,29 @@ func printSudokuValuesLine(builder *strings.Builder, model *sudokuValuesPrompt,
 	builder.WriteString("\n")
 }
 
+// printComparisonSign prints inequality sign if there is a comparison defined
+// between cells with provided positions. Returns false if there is no comparison.
+func printComparisonSign(builder *strings.Builder, model *sudokuValuesPrompt,
+	first models.SudokuCellPositionDTO, second models.SudokuCellPositionDTO,
+	firstGreaterSign string, firstLesserSign string) bool {
+
+	comparison := model.sudokuDTO.FindComparison(first, second)
+	if comparison == nil {
+		return false
+	}
+
+	sign := firstLesserSign
+	if comparison.Greater == first {
+		sign = firstGreaterSign
+	}
+
+	builder.WriteString(models.TerminalStyles.PrimaryStyle.Render(sign))
+	return true
+}
+
 // printSudokuCell prints single sudoku cell balue
 func printSudokuCell(builder *strings.Builder, model *sudokuValuesPrompt,
-	box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) {
+	viewport *sudokuViewport, box *models.SudokuBoxDTO, cell *models.SudokuCellDTO) {
 
 	var style lipgloss.Style
 
@@ -260,8 +443,16 @@ func printSudokuCell(builder *strings.Builder, model *sudokuValuesPrompt,
 		model.currentCell.IndexRowInBox == cell.IndexRowInBox &&
 		model.currentCell.IndexColumnInBox == cell.IndexColumnInBox
 
+	cellStyle, hasCellStyle := model.cellStyles[cell]
+
 	if isActiveCell {
 		style = models.TerminalStyles.SuccessStyle
+	} else if isSudokuCellSelected(model, getSudokuCellPosition(model.sudokuDTO, box, cell)) {
+		style = models.TerminalStyles.SelectionStyle
+	} else if model.conflicts != nil && model.conflicts.cells[cell] > 0 {
+		style = models.TerminalStyles.ErrorStyle
+	} else if hasCellStyle {
+		style = cellStyle
 	} else if box.Disabled {
 		style = models.TerminalStyles.BorderStyle
 	} else if cell.Value == nil {
@@ -270,13 +461,18 @@ func printSudokuCell(builder *strings.Builder, model *sudokuValuesPrompt,
 		style = models.TerminalStyles.PrimaryStyle
 	}
 
-	printValuePadding(builder, model, style)
+	printValuePadding(builder, model, viewport, style)
 	if cell.Value == nil {
+		emptySign, ok := model.emptyCellSigns[cell]
+		if !ok {
+			emptySign = "_"
+		}
+
 		for characterIndex := 0; characterIndex < model.charactersPerCell; characterIndex++ {
-			builder.WriteString(style.Render("_"))
+			builder.WriteString(style.Render(emptySign))
 		}
 	} else {
-		stringValue := strconv.Itoa(*cell.Value)
+		stringValue := model.alphabet.GetSymbol(*cell.Value)
 		if len(stringValue) > model.charactersPerCell {
 			stringValue = stringValue[:model.charactersPerCell]
 		}
@@ -292,13 +488,15 @@ func printSudokuCell(builder *strings.Builder, model *sudokuValuesPrompt,
 			builder.WriteString(style.Render("x"))
 		}
 	}
-	printValuePadding(builder, model, style)
+	printValuePadding(builder, model, viewport, style)
 }
 
 // printValuePadding prints padding before and after a sudoku value (horizontal padding)
-func printValuePadding(builder *strings.Builder, model *sudokuValuesPrompt, style lipgloss.Style) {
+func printValuePadding(builder *strings.Builder, model *sudokuValuesPrompt,
+	viewport *sudokuViewport, style lipgloss.Style) {
+
 	var paddingIndex int8 = 0
-	if model.settings.SudokuPrintoutValuePaddingLength >= 1 {
+	if model.settings.SudokuPrintoutValuePaddingLength >= 1 && !viewport.compact {
 		for paddingIndex = 0; paddingIndex < model.settings.SudokuPrintoutValuePaddingLength; paddingIndex++ {
 			builder.WriteString(style.Render(" "))
 		}
@@ -306,7 +504,7 @@ func printValuePadding(builder *strings.Builder, model *sudokuValuesPrompt, styl
 }
 
 // printSudokuControls print controls of sudoku editor
-func printSudokuControls(builder *strings.Builder) {
+func printSudokuControls(builder *strings.Builder, model *sudokuValuesPrompt) {
 	builder.WriteString(models.TerminalStyles.PrimaryStyle.Render("Controls:"))
 	builder.WriteString("\n")
 
@@ -321,7 +519,12 @@ func printSudokuControls(builder *strings.Builder) {
 	builder.WriteString("\n")
 
 	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("InsertValue: "))
-	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("numbers 0-9"))
+	if model.alphabet != nil {
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render(
+			fmt.Sprintf("symbols %s", model.alphabet.Specification)))
+	} else {
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render("numbers 0-9"))
+	}
 	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tDelete value: "))
 	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("delete"))
 	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tBackspace: "))
@@ -335,6 +538,40 @@ func printSudokuControls(builder *strings.Builder) {
 	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tEnable/disable box: "))
 	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("e/d"))
 	builder.WriteString("\n")
+
+	if model.allowConflicts {
+		builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Confirm with conflicting values: "))
+		builder.WriteString(models.TerminalStyles.SuccessStyle.Render("alt+enter"))
+		builder.WriteString("\n")
+	}
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Toggle comparison (>, <, none) with adjacent cell: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("alt+arrows"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Undo: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("ctrl+z"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tRedo: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("ctrl+y"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tSelect cells: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("shift+arrows"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("\tClear selected cells: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("delete"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Fill current box: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("paste values of the box in rows order"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render(" (e.g. 1.3|4.6|.89, blanks: . _ 0)"))
+	builder.WriteString("\n")
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Mouse: "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("click"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render(" to move, "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("drag/shift+click"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render(" to select cells, "))
+	builder.WriteString(models.TerminalStyles.SuccessStyle.Render("right click"))
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render(" to enable/disable box"))
+	builder.WriteString("\n")
 }
 
 // goUpSudokuCell navigates to the cell on the top from current one
@@ -547,6 +784,11 @@ func appendValue(model *sudokuValuesPrompt, value int) {
 	model.currentCell.Value = &newValue
 }
 
+// setValue replaces value of the cell (symbol based)
+func setValue(model *sudokuValuesPrompt, value int) {
+	model.currentCell.Value = &value
+}
+
 // clearCurrentCellValue removes value from current cell
 func clearCurrentCellValue(model *sudokuValuesPrompt) {
 	model.currentCell.Value = nil
@@ -558,6 +800,11 @@ func backspaceCurrentCellValue(model *sudokuValuesPrompt) {
 		return
 	}
 
+	if model.alphabet != nil {
+		model.currentCell.Value = nil
+		return
+	}
+
 	stringValue := strconv.Itoa(*model.currentCell.Value)
 	currentLength := len(stringValue)
 	if currentLength <= 1 {
@@ -574,7 +821,57 @@ func backspaceCurrentCellValue(model *sudokuValuesPrompt) {
 	model.currentCell.Value = &newValue
 }
 
-// changeDisableStateOfCurrentBox enables or disables current box
+// toggleComparison cycles the comparison between current cell and its neighbour
+// in the same box (pointed by offsets) - current cell greater, current cell lesser,
+// no comparison
+func toggleComparison(model *sudokuValuesPrompt, rowOffset int8, columnOffset int8) {
+	if model.currentBox == nil || model.currentCell == nil || model.currentBox.Disabled {
+		return
+	}
+
+	neighbourRowInBox := model.currentCell.IndexRowInBox + rowOffset
+	neighbourColumnInBox := model.currentCell.IndexColumnInBox + columnOffset
+	maxCellIndex := model.sudokuDTO.BoxSize - 1
+
+	if neighbourRowInBox < 0 || neighbourRowInBox > maxCellIndex ||
+		neighbourColumnInBox < 0 || neighbourColumnInBox > maxCellIndex {
+		return
+	}
+
+	currentPosition := getCurrentCellPosition(model)
+	neighbourPosition := models.SudokuCellPositionDTO{
+		Row:    currentPosition.Row + int(rowOffset),
+		Column: currentPosition.Column + int(columnOffset),
+	}
+
+	comparison := model.sudokuDTO.FindComparison(currentPosition, neighbourPosition)
+	if comparison == nil {
+		model.sudokuDTO.Comparisons = append(model.sudokuDTO.Comparisons, &models.SudokuComparisonDTO{
+			Greater: currentPosition,
+			Lesser:  neighbourPosition,
+		})
+		return
+	}
+
+	if comparison.Greater == currentPosition {
+		comparison.Greater = neighbourPosition
+		comparison.Lesser = currentPosition
+		return
+	}
+
+	model.sudokuDTO.Comparisons = slices.DeleteFunc(model.sudokuDTO.Comparisons,
+		func(c *models.SudokuComparisonDTO) bool {
+			return c == comparison
+		})
+}
+
+// getCurrentCellPosition computes absolute position of current cell
+func getCurrentCellPosition(model *sudokuValuesPrompt) models.SudokuCellPositionDTO {
+	return getSudokuCellPosition(model.sudokuDTO, model.currentBox, model.currentCell)
+}
+
+// changeDisableStateOfCurrentBox enables or disables current box.
+// Comparisons placed in disabled box are removed.
 func changeDisableStateOfCurrentBox(model *sudokuValuesPrompt, newEnabled bool) {
 	if model.currentBox == nil {
 		return
@@ -584,6 +881,137 @@ func changeDisableStateOfCurrentBox(model *sudokuValuesPrompt, newEnabled bool)
 	if model.currentBox.Disabled != newDisabled {
 		model.currentBox.Disabled = newDisabled
 	}
+
+	if newDisabled {
+		boxSize := int(model.sudokuDTO.BoxSize)
+		model.sudokuDTO.Comparisons = slices.DeleteFunc(model.sudokuDTO.Comparisons,
+			func(comparison *models.SudokuComparisonDTO) bool {
+				return comparison.Greater.Row/boxSize == int(model.currentBox.IndexRow) &&
+					comparison.Greater.Column/boxSize == int(model.currentBox.IndexColumn)
+			})
+	}
+}
+
+// moveSudokuCell executes the move from current cell and clears selection of cells
+func moveSudokuCell(model *sudokuValuesPrompt, move func(model *sudokuValuesPrompt)) {
+	model.selectionAnchor = nil
+	move(model)
+}
+
+// selectSudokuCells executes the move from current cell and extends selection
+// of cells, which starts in the cell where the first move was made
+func selectSudokuCells(model *sudokuValuesPrompt, move func(model *sudokuValuesPrompt)) {
+	if model.selectionAnchor == nil {
+		anchor := getCurrentCellPosition(model)
+		model.selectionAnchor = &anchor
+	}
+
+	move(model)
+}
+
+// isSudokuCellSelected checks if the cell with provided position is
+// in the rectangle between selection anchor and current cell
+func isSudokuCellSelected(model *sudokuValuesPrompt, position models.SudokuCellPositionDTO) bool {
+	if model.selectionAnchor == nil || model.currentBox == nil || model.currentCell == nil {
+		return false
+	}
+
+	current := getCurrentCellPosition(model)
+	return position.Row >= min(model.selectionAnchor.Row, current.Row) &&
+		position.Row <= max(model.selectionAnchor.Row, current.Row) &&
+		position.Column >= min(model.selectionAnchor.Column, current.Column) &&
+		position.Column <= max(model.selectionAnchor.Column, current.Column)
+}
+
+// clearSelectedCells removes values from all selected cells,
+// or from current cell if there is no selection
+func clearSelectedCells(model *sudokuValuesPrompt) {
+	if model.selectionAnchor == nil {
+		clearCurrentCellValue(model)
+		return
+	}
+
+	for _, box := range model.sudokuDTO.Boxes {
+		for _, cell := range box.Cells {
+			if isSudokuCellSelected(model, getSudokuCellPosition(model.sudokuDTO, box, cell)) {
+				cell.Value = nil
+			}
+		}
+	}
+}
+
+// fillCurrentBox sets values of all cells of current box to values of the text
+// (e.g. pasted from clipboard), in rows order. Box is not changed if the text
+// does not contain value for every cell of the box.
+func fillCurrentBox(model *sudokuValuesPrompt, text string) error {
+	if model.currentBox == nil {
+		return nil
+	}
+
+	if model.currentBox.Disabled {
+		return fmt.Errorf("disabled box can not be filled")
+	}
+
+	values, err := parseBoxValues(model, text)
+	if err != nil {
+		return err
+	}
+
+	for _, cell := range model.currentBox.Cells {
+		cellIndex := int(cell.IndexRowInBox)*int(model.sudokuDTO.BoxSize) + int(cell.IndexColumnInBox)
+		cell.Value = values[cellIndex]
+	}
+
+	return nil
+}
+
+// parseBoxValues reads values of the box from the text. Single character values
+// (symbols of the alphabet or digits) can be written one by one, longer values
+// have to be separated with ',', ';', '|' or '/'. Empty cells are marked with
+// '.', '_' or '0'.
+func parseBoxValues(model *sudokuValuesPrompt, text string) ([]*int, error) {
+	isSeparator := func(character rune) bool {
+		return unicode.IsSpace(character) || strings.ContainsRune(",;|/", character)
+	}
+
+	tokens := strings.FieldsFunc(text, isSeparator)
+	if model.alphabet != nil || model.charactersPerCell <= 1 {
+		tokens = []string{}
+		for _, character := range text {
+			if !isSeparator(character) && character != '-' && character != '+' {
+				tokens = append(tokens, string(character))
+			}
+		}
+	}
+
+	cellsCount := int(model.sudokuDTO.BoxSize) * int(model.sudokuDTO.BoxSize)
+	if len(tokens) != cellsCount {
+		return nil, fmt.Errorf("pasted text has %d values, but the box has %d cells", len(tokens), cellsCount)
+	}
+
+	values := []*int{}
+	for _, token := range tokens {
+		if model.alphabet != nil {
+			if value, ok := model.alphabet.GetValue([]rune(token)[0]); ok {
+				values = append(values, &value)
+				continue
+			}
+		}
+
+		if token == "." || token == "_" || strings.Trim(token, "0") == "" {
+			values = append(values, nil)
+			continue
+		}
+
+		value, err := strconv.Atoi(token)
+		if model.alphabet != nil || err != nil || value < 1 || value > cellsCount {
+			return nil, fmt.Errorf("pasted text has invalid value '%s'", token)
+		}
+
+		values = append(values, &value)
+	}
+
+	return values, nil
 }
 
 // buildSudokuValuesPromptModel builds a model for sudoku values input (prompt)
@@ -607,12 +1035,24 @@ func buildSudokuValuesPromptModel(sudokuDto *models.SudokuDTO, settings *models.
 			"failed to find sudoku cell with indexex (row: 0, column: 0) within first box")
 	}
 
+	var alphabet *models.SymbolAlphabet
+	if len(sudokuDto.Alphabet) > 0 {
+		parsedAlphabet, err := models.ParseSymbolAlphabet(sudokuDto.Alphabet, sudokuDto.BoxSize)
+		if err != nil {
+			return nil, err
+		}
+
+		alphabet = parsedAlphabet
+	}
+
 	return &sudokuValuesPrompt{
 		sudokuDTO:         sudokuDto,
 		settings:          settings,
 		quit:              false,
-		charactersPerCell: len(strconv.Itoa(int(sudokuDto.BoxSize * sudokuDto.BoxSize))),
+		alphabet:          alphabet,
+		charactersPerCell: alphabet.GetSymbolLength(int(sudokuDto.BoxSize) * int(sudokuDto.BoxSize)),
 		currentBox:        firstBox,
 		currentCell:       firstCell,
+		conflicts:         newSudokuConflicts(sudokuDto),
 	}, nil
 }
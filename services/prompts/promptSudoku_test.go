@@ -23,7 +23,7 @@ func TestPromptSudokuValues_Success(t *testing.T) {
 			return *mdl, nil
 		})
 
-	err := prompter.PromptSudokuValues(sudokuDto)
+	err := prompter.PromptSudokuValues(sudokuDto, false)
 
 	if err != nil {
 		t.Errorf("Unexpected error: '%s'", err)
@@ -40,7 +40,7 @@ func TestPromptSudokuValues_Error(t *testing.T) {
 			return nil, errors.New("some error")
 		})
 
-	err := prompter.PromptSudokuValues(sudokuDto)
+	err := prompter.PromptSudokuValues(sudokuDto, false)
 
 	if err == nil {
 		t.Errorf("An error was expected but none was returned from Sudoku prompt")
@@ -159,6 +159,38 @@ func TestUpdate_SudokuPrompt(t *testing.T) {
 				return model.quit == false
 			},
 		},
+		{
+			name:                 "comparison right",
+			teaKeyMessage:        tea.KeyMsg{Type: tea.KeyRight, Alt: true},
+			expectsResultCommand: false,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.quit == false && len(model.sudokuDTO.Comparisons) == 1
+			},
+		},
+		{
+			name:                 "comparison down",
+			teaKeyMessage:        tea.KeyMsg{Type: tea.KeyDown, Alt: true},
+			expectsResultCommand: false,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.quit == false && len(model.sudokuDTO.Comparisons) == 1
+			},
+		},
+		{
+			name:                 "comparison left - outside of box",
+			teaKeyMessage:        tea.KeyMsg{Type: tea.KeyLeft, Alt: true},
+			expectsResultCommand: false,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.quit == false && len(model.sudokuDTO.Comparisons) == 0
+			},
+		},
+		{
+			name:                 "comparison up - outside of box",
+			teaKeyMessage:        tea.KeyMsg{Type: tea.KeyUp, Alt: true},
+			expectsResultCommand: false,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.quit == false && len(model.sudokuDTO.Comparisons) == 0
+			},
+		},
 		{
 			name:                 "zero",
 			teaKeyMessage:        tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'0'}},
@@ -297,6 +329,7 @@ func TestView_SudokuPrompt_Render(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
 	sudokuDto := getTestSudokDto(t)
 	model, _ := buildSudokuValuesPromptModel(sudokuDto, settings)
+	model.allowConflicts = true
 
 	expectedSubstrings := []string{
 		"╔═══════════╦═══════════╦═══════════╗",
@@ -323,6 +356,479 @@ func TestView_SudokuPrompt_Render(t *testing.T) {
 		"Finish and confirm: enter",
 		"Cancel: esc/ctrl+c",
 		"Enable/disable box: e/d",
+		"Toggle comparison (>, <, none) with adjacent cell: alt+arrows",
+		"Undo: ctrl+z",
+		"Redo: ctrl+y",
+		"Select cells: shift+arrows",
+		"Clear selected cells: delete",
+		"Fill current box: paste values of the box in rows order",
+		"Confirm with conflicting values: alt+enter",
+		"Mouse: click to move, drag/shift+click to select cells, right click to enable/disable box",
+	}
+
+	viewString := model.View()
+
+	for _, expectedSubsting := range expectedSubstrings {
+		if !strings.Contains(viewString, expectedSubsting) {
+			t.Errorf("Sudoku prompt view string does not contain '%s' substring.",
+				expectedSubsting)
+		}
+	}
+}
+
+func TestUpdate_SudokuPrompt_EditorStates(t *testing.T) {
+	shiftUp := tea.KeyMsg{Type: tea.KeyShiftUp}
+	shiftDown := tea.KeyMsg{Type: tea.KeyShiftDown}
+	shiftRight := tea.KeyMsg{Type: tea.KeyShiftRight}
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	altRight := tea.KeyMsg{Type: tea.KeyRight, Alt: true}
+	remove := tea.KeyMsg{Type: tea.KeyDelete}
+	undo := tea.KeyMsg{Type: tea.KeyCtrlZ}
+	redo := tea.KeyMsg{Type: tea.KeyCtrlY}
+	runes := func(text string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(text)}
+	}
+
+	// value of the cell in the first box, zero means empty cell
+	hasValue := func(model sudokuValuesPrompt, cellIndex int, expectedValue int) bool {
+		value := model.sudokuDTO.Boxes[0].Cells[cellIndex].Value
+		if expectedValue == 0 {
+			return value == nil
+		}
+
+		return value != nil && *value == expectedValue
+	}
+
+	testCases := []struct {
+		name                string
+		messages            []tea.KeyMsg
+		expectedStatus      string
+		allowConflicts      bool
+		modelStateValidator func(model sudokuValuesPrompt) bool
+	}{
+		{
+			name:     "undo value",
+			messages: []tea.KeyMsg{runes("5"), undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 0) && len(model.undoHistory) == 0 && len(model.redoHistory) == 1
+			},
+		},
+		{
+			name:     "redo value",
+			messages: []tea.KeyMsg{runes("5"), undo, redo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 5) && len(model.undoHistory) == 1 && len(model.redoHistory) == 0
+			},
+		},
+		{
+			name:     "new change clears redo history",
+			messages: []tea.KeyMsg{runes("5"), undo, runes("6"), redo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 6) && len(model.redoHistory) == 0
+			},
+			expectedStatus: "Nothing to redo",
+		},
+		{
+			name:     "undo of multiple changes",
+			messages: []tea.KeyMsg{runes("5"), right, runes("6"), undo, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 0) && hasValue(model, 1, 0)
+			},
+		},
+		{
+			name:     "undo moves to changed cell",
+			messages: []tea.KeyMsg{right, runes("5"), down, down, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 1, 0) && model.currentCell == model.sudokuDTO.Boxes[0].Cells[1]
+			},
+		},
+		{
+			name:     "nothing to undo",
+			messages: []tea.KeyMsg{right, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.currentCell == model.sudokuDTO.Boxes[0].Cells[1]
+			},
+			expectedStatus: "Nothing to undo",
+		},
+		{
+			name:     "status cleared by next key",
+			messages: []tea.KeyMsg{undo, right},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.status == ""
+			},
+		},
+		{
+			name:     "undo box disable",
+			messages: []tea.KeyMsg{runes("d"), undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return !model.sudokuDTO.Boxes[0].Disabled
+			},
+		},
+		{
+			name:     "redo box disable",
+			messages: []tea.KeyMsg{runes("d"), undo, redo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.sudokuDTO.Boxes[0].Disabled
+			},
+		},
+		{
+			name:     "no history of unchanged box",
+			messages: []tea.KeyMsg{runes("e")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.undoHistory) == 0
+			},
+		},
+		{
+			name:     "undo reversed comparison",
+			messages: []tea.KeyMsg{altRight, altRight, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				comparison := model.sudokuDTO.FindComparison(models.SudokuCellPositionDTO{Row: 0, Column: 0},
+					models.SudokuCellPositionDTO{Row: 0, Column: 1})
+				return comparison != nil && comparison.Greater == models.SudokuCellPositionDTO{Row: 0, Column: 0}
+			},
+		},
+		{
+			name:     "undo comparison",
+			messages: []tea.KeyMsg{altRight, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.sudokuDTO.Comparisons) == 0
+			},
+		},
+		{
+			name:     "select cells",
+			messages: []tea.KeyMsg{shiftRight, shiftDown},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.selectionAnchor != nil &&
+					*model.selectionAnchor == models.SudokuCellPositionDTO{Row: 0, Column: 0} &&
+					isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 1, Column: 0}) &&
+					!isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 2, Column: 0})
+			},
+		},
+		{
+			name:     "select cells backwards",
+			messages: []tea.KeyMsg{down, down, shiftUp, shiftUp},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 2, Column: 0}) &&
+					isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 0, Column: 0})
+			},
+		},
+		{
+			name:     "move clears selection",
+			messages: []tea.KeyMsg{shiftRight, down},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.selectionAnchor == nil &&
+					!isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 0, Column: 0})
+			},
+		},
+		{
+			name:     "clear selected cells",
+			messages: []tea.KeyMsg{down, shiftDown, shiftRight, shiftRight, remove},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 3, 0) && hasValue(model, 5, 0) && hasValue(model, 6, 0) &&
+					hasValue(model, 7, 0) && *model.sudokuDTO.Boxes[1].Cells[3].Value == 2
+			},
+		},
+		{
+			name:     "undo clear of selected cells",
+			messages: []tea.KeyMsg{down, shiftDown, shiftRight, shiftRight, remove, undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 3, 3) && hasValue(model, 5, 9) && hasValue(model, 6, 4) &&
+					hasValue(model, 7, 1) && len(model.undoHistory) == 0
+			},
+		},
+		{
+			name:     "fill box from pasted text",
+			messages: []tea.KeyMsg{runes("1.3|4.6|.89")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 1) && hasValue(model, 1, 0) && hasValue(model, 3, 4) &&
+					hasValue(model, 6, 0) && hasValue(model, 8, 9) && len(model.undoHistory) == 1
+			},
+		},
+		{
+			name:     "undo fill of box",
+			messages: []tea.KeyMsg{runes("123456789"), undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 0) && hasValue(model, 3, 3) && hasValue(model, 8, 0)
+			},
+		},
+		{
+			name:     "fill box with too many values",
+			messages: []tea.KeyMsg{runes("1234567891")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 0) && len(model.undoHistory) == 0
+			},
+			expectedStatus: "Failed to fill the box - pasted text has 10 values, but the box has 9 cells",
+		},
+		{
+			name:     "fill disabled box",
+			messages: []tea.KeyMsg{runes("d"), runes("123456789")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 0) && len(model.undoHistory) == 1
+			},
+			expectedStatus: "Failed to fill the box - disabled box can not be filled",
+		},
+		{
+			name:     "conflicting value",
+			messages: []tea.KeyMsg{runes("4")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.conflicts.cells) == 3 &&
+					model.conflicts.cells[model.sudokuDTO.Boxes[0].Cells[0]] == 3
+			},
+			expectedStatus: "Conflicts: 4 in box (row: 1, column: 1), 4 in row 1, 4 in column 1",
+		},
+		{
+			name:     "many conflicts",
+			messages: []tea.KeyMsg{runes("111111111")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.conflicts.cells) == 11
+			},
+			expectedStatus: "1 in row 2, 1 in column 2 and 2 more",
+		},
+		{
+			name:           "enter refused with conflicts",
+			messages:       []tea.KeyMsg{runes("4"), {Type: tea.KeyEnter}},
+			allowConflicts: true,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return !model.quit
+			},
+			expectedStatus: "Resolve the conflicts before confirming, or confirm anyway with alt+enter",
+		},
+		{
+			name:           "alt+enter confirms with conflicts",
+			messages:       []tea.KeyMsg{runes("4"), {Type: tea.KeyEnter, Alt: true}},
+			allowConflicts: true,
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return model.quit
+			},
+		},
+		{
+			name:     "alt+enter refused if conflicts are not allowed",
+			messages: []tea.KeyMsg{runes("4"), {Type: tea.KeyEnter, Alt: true}},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return !model.quit && !strings.Contains(model.View(), "alt+enter")
+			},
+			expectedStatus: "Resolve the conflicts before confirming",
+		},
+		{
+			name:     "conflict resolved by new value",
+			messages: []tea.KeyMsg{runes("4"), {Type: tea.KeyBackspace}, runes("2")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 2) && len(model.conflicts.cells) == 0
+			},
+		},
+		{
+			name:     "conflict resolved by undo",
+			messages: []tea.KeyMsg{runes("4"), undo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.conflicts.cells) == 0
+			},
+		},
+		{
+			name:     "conflict restored by redo",
+			messages: []tea.KeyMsg{runes("4"), undo, redo},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.conflicts.cells) == 3
+			},
+		},
+		{
+			// disabled box breaks the only sub-sudoku
+			name:     "conflict resolved by disabling box",
+			messages: []tea.KeyMsg{runes("4"), runes("d")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return len(model.conflicts.cells) == 0 && len(model.conflicts.houses) == 8
+			},
+		},
+		{
+			name:     "keys typed quickly",
+			messages: []tea.KeyMsg{runes("5l6")},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return hasValue(model, 0, 5) && hasValue(model, 1, 6) && len(model.undoHistory) == 2
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		model, _ := buildSudokuValuesPromptModel(getTestSudokDto(t), settings)
+		model.allowConflicts = testCase.allowConflicts
+
+		var resultModel tea.Model = *model
+		for _, message := range testCase.messages {
+			resultModel, _ = resultModel.Update(message)
+		}
+
+		if !testCase.modelStateValidator(resultModel.(sudokuValuesPrompt)) {
+			t.Errorf("%s: invalid model state", testCase.name)
+		}
+
+		if len(testCase.expectedStatus) > 0 &&
+			!strings.Contains(resultModel.View(), testCase.expectedStatus) {
+			t.Errorf("%s: view does not contain status '%s'", testCase.name, testCase.expectedStatus)
+		}
+	}
+}
+
+func TestParseBoxValues(t *testing.T) {
+	testCases := []struct {
+		name              string
+		boxSize           int8
+		alphabet          string
+		text              string
+		expectedValues    []int
+		expectedErrorText string
+	}{
+		{
+			name:           "Digits and blanks",
+			boxSize:        2,
+			text:           "1_0.",
+			expectedValues: []int{1, 0, 0, 0},
+		},
+		{
+			name:           "Symbols of alphabet",
+			boxSize:        2,
+			alphabet:       "A-D",
+			text:           "A.|.D",
+			expectedValues: []int{1, 0, 0, 4},
+		},
+		{
+			name:           "Separated values",
+			boxSize:        4,
+			text:           "1,16,.,4;5,6,7,8|9,10,11,12/13,14,0,_",
+			expectedValues: []int{1, 16, 0, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 0, 0},
+		},
+		{
+			name:              "Value out of range",
+			boxSize:           2,
+			text:              "1235",
+			expectedErrorText: "pasted text has invalid value '5'",
+		},
+		{
+			name:              "Symbol out of alphabet",
+			boxSize:           2,
+			alphabet:          "A-D",
+			text:              "ABCE",
+			expectedErrorText: "pasted text has invalid value 'E'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := models.NewEmptySudokuDTO(testCase.boxSize, 1, 1)
+		sudokuDto.Alphabet = testCase.alphabet
+
+		model, err := buildSudokuValuesPromptModel(sudokuDto, testHelpers.GetTestSettings())
+		if err != nil {
+			t.Fatalf("%s: failed to build prompt model - %s", testCase.name, err)
+		}
+
+		values, err := parseBoxValues(model, testCase.text)
+		if len(testCase.expectedErrorText) > 0 {
+			if err == nil || err.Error() != testCase.expectedErrorText {
+				t.Errorf("%s: unexpected error %v", testCase.name, err)
+			}
+			continue
+		}
+
+		if err != nil || len(values) != len(testCase.expectedValues) {
+			t.Errorf("%s: unexpected values %v (%v)", testCase.name, values, err)
+			continue
+		}
+
+		for index, expectedValue := range testCase.expectedValues {
+			if (values[index] == nil) != (expectedValue == 0) ||
+				(values[index] != nil && *values[index] != expectedValue) {
+				t.Errorf("%s: unexpected value at index %d", testCase.name, index)
+			}
+		}
+	}
+}
+
+func TestView_SudokuPrompt_Comparisons(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	sudokuDto := getTestSudokDto(t)
+	sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 1},
+			Lesser:  models.SudokuCellPositionDTO{Row: 0, Column: 0},
+		},
+		{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 8},
+			Lesser:  models.SudokuCellPositionDTO{Row: 1, Column: 8},
+		},
+	}
+	model, _ := buildSudokuValuesPromptModel(sudokuDto, settings)
+
+	expectedSubstrings := []string{
+		"║ _ < _ │ _ ║ _ │ _ │ 4 ║ 3 │ 1 │ _ ║",
+		"║───────────║───────────║─────────v─║",
+	}
+
+	viewString := model.View()
+
+	for _, expectedSubsting := range expectedSubstrings {
+		if !strings.Contains(viewString, expectedSubsting) {
+			t.Errorf("Sudoku prompt view string does not contain '%s' substring.",
+				expectedSubsting)
+		}
+	}
+}
+
+func TestUpdate_SudokuPrompt_Alphabet(t *testing.T) {
+	testCases := []struct {
+		name          string
+		runes         []rune
+		expectedValue int
+	}{
+		{
+			name:          "symbol from alphabet",
+			runes:         []rune{'C'},
+			expectedValue: 3,
+		},
+		{
+			name:          "last symbol from alphabet",
+			runes:         []rune{'C', 'I'},
+			expectedValue: 9,
+		},
+		{
+			name:          "digit ignored",
+			runes:         []rune{'5'},
+			expectedValue: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		sudokuDto := testHelpers.GetTestSudokuDto()
+		sudokuDto.Alphabet = "A-I"
+		model, err := buildSudokuValuesPromptModel(sudokuDto, settings)
+		if err != nil {
+			t.Fatalf("%s: failed to build prompt model - %s", testCase.name, err)
+		}
+
+		var resultModel tea.Model = model
+		for _, character := range testCase.runes {
+			resultModel, _ = resultModel.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{character}})
+		}
+
+		// zero expected value means empty cell
+		value := resultModel.(sudokuValuesPrompt).currentCell.Value
+		if (value == nil) != (testCase.expectedValue == 0) ||
+			(value != nil && *value != testCase.expectedValue) {
+			t.Errorf("%s: invalid current cell value", testCase.name)
+		}
+	}
+}
+
+func TestView_SudokuPrompt_Alphabet(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	sudokuDto := getTestSudokDto(t)
+	sudokuDto.Alphabet = "A-I"
+	model, _ := buildSudokuValuesPromptModel(sudokuDto, settings)
+
+	expectedSubstrings := []string{
+		"║ _ │ _ │ _ ║ _ │ _ │ D ║ C │ A │ _ ║",
+		"║ C │ _ │ I ║ B │ G │ _ ║ E │ F │ _ ║",
+		"InsertValue: symbols A-I",
 	}
 
 	viewString := model.View()
@@ -569,6 +1075,40 @@ func TestBackspaceCurrentCellValue(t *testing.T) {
 	}
 }
 
+func TestToggleComparison(t *testing.T) {
+	sudokuDto := getTestSudokDto(t)
+	settings := testHelpers.GetTestSettings()
+	model, _ := buildSudokuValuesPromptModel(sudokuDto, settings)
+	model.currentBox = model.sudokuDTO.Boxes[4]
+	model.currentCell = model.currentBox.Cells[4]
+
+	current := models.SudokuCellPositionDTO{Row: 4, Column: 4}
+	neighbour := models.SudokuCellPositionDTO{Row: 4, Column: 5}
+
+	expectedStates := []*models.SudokuComparisonDTO{
+		{Greater: current, Lesser: neighbour},
+		{Greater: neighbour, Lesser: current},
+		nil,
+	}
+
+	for stateIndex, expectedState := range expectedStates {
+		toggleComparison(model, 0, 1)
+		comparison := model.sudokuDTO.FindComparison(current, neighbour)
+
+		if expectedState == nil {
+			if comparison != nil || len(model.sudokuDTO.Comparisons) > 0 {
+				t.Errorf("%d: comparison should be removed", stateIndex)
+			}
+			continue
+		}
+
+		if comparison == nil || *comparison != *expectedState {
+			t.Errorf("%d: unexpected comparison state %v, expected %v",
+				stateIndex, comparison, expectedState)
+		}
+	}
+}
+
 type sudokuMovementTestCaseData struct {
 	name                    string
 	initialActiveBoxIndex   int
@@ -0,0 +1,224 @@
+package prompts
+
+import (
+	"fmt"
+
+	"github.com/Michu8258/kangaroo/helpers"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sudokuHouse is a group of cells which have to contain different values
+type sudokuHouse struct {
+	name  string
+	cells []*models.SudokuCellDTO
+}
+
+// sudokuConflicts keeps cells with values repeated in houses of the sudoku.
+// After a change of values only houses of changed cells are checked again.
+type sudokuConflicts struct {
+	houses         []*sudokuHouse
+	cellHouses     map[*models.SudokuCellDTO][]int
+	houseConflicts [][]*models.SudokuCellDTO
+	// cells maps conflicting cells to amount of houses they conflict in
+	cells map[*models.SudokuCellDTO]int
+}
+
+// newSudokuConflicts finds houses of the sudoku and checks all of them
+func newSudokuConflicts(sudokuDto *models.SudokuDTO) *sudokuConflicts {
+	houses := getSudokuHouses(sudokuDto)
+	conflicts := &sudokuConflicts{
+		houses:         houses,
+		cellHouses:     map[*models.SudokuCellDTO][]int{},
+		houseConflicts: make([][]*models.SudokuCellDTO, len(houses)),
+		cells:          map[*models.SudokuCellDTO]int{},
+	}
+
+	for houseIndex, house := range houses {
+		for _, cell := range house.cells {
+			conflicts.cellHouses[cell] = append(conflicts.cellHouses[cell], houseIndex)
+		}
+
+		conflicts.checkHouse(houseIndex)
+	}
+
+	return conflicts
+}
+
+// update checks again houses of cells with changed values
+func (conflicts *sudokuConflicts) update(changedCells []*models.SudokuCellDTO) {
+	checkedHouses := map[int]bool{}
+	for _, cell := range changedCells {
+		for _, houseIndex := range conflicts.cellHouses[cell] {
+			if !checkedHouses[houseIndex] {
+				checkedHouses[houseIndex] = true
+				conflicts.checkHouse(houseIndex)
+			}
+		}
+	}
+}
+
+// checkHouse replaces conflicting cells of the house with the current ones
+func (conflicts *sudokuConflicts) checkHouse(houseIndex int) {
+	for _, cell := range conflicts.houseConflicts[houseIndex] {
+		conflicts.cells[cell]--
+		if conflicts.cells[cell] <= 0 {
+			delete(conflicts.cells, cell)
+		}
+	}
+
+	conflicts.houseConflicts[houseIndex] = getHouseConflicts(conflicts.houses[houseIndex].cells)
+	for _, cell := range conflicts.houseConflicts[houseIndex] {
+		conflicts.cells[cell]++
+	}
+}
+
+// getDescriptions describes repeated values, e.g. "5 in row 3", every
+// value of every house once
+func (conflicts *sudokuConflicts) getDescriptions(alphabet *models.SymbolAlphabet) []string {
+	descriptions := []string{}
+	described := map[string]bool{}
+
+	for houseIndex, cells := range conflicts.houseConflicts {
+		for _, cell := range cells {
+			description := fmt.Sprintf("%s in %s", alphabet.GetSymbol(*cell.Value),
+				conflicts.houses[houseIndex].name)
+			if !described[description] {
+				described[description] = true
+				descriptions = append(descriptions, description)
+			}
+		}
+	}
+
+	return descriptions
+}
+
+// getHouseConflicts returns cells of the house with repeated values
+func getHouseConflicts(cells []*models.SudokuCellDTO) []*models.SudokuCellDTO {
+	valueCounts := map[int]int{}
+	for _, cell := range cells {
+		if cell != nil && cell.Value != nil {
+			valueCounts[*cell.Value]++
+		}
+	}
+
+	houseConflicts := []*models.SudokuCellDTO{}
+	for _, cell := range cells {
+		if cell != nil && cell.Value != nil && valueCounts[*cell.Value] > 1 {
+			houseConflicts = append(houseConflicts, cell)
+		}
+	}
+
+	return houseConflicts
+}
+
+// getSudokuHouses returns groups of cells which have to contain different values -
+// enabled boxes and rows and columns of every sub-sudoku, so overlapping sub-sudokus
+// share their cells
+func getSudokuHouses(sudokuDto *models.SudokuDTO) []*sudokuHouse {
+	houses := []*sudokuHouse{}
+	for _, box := range sudokuDto.Boxes {
+		if !box.Disabled {
+			houses = append(houses, &sudokuHouse{
+				name:  "box " + helpers.GetCoordinatesString(box.IndexRow+1, box.IndexColumn+1, true),
+				cells: box.Cells,
+			})
+		}
+	}
+
+	grid := getSudokuCellsGrid(sudokuDto)
+	getCells := func(positions []models.SudokuCellPosition) []*models.SudokuCellDTO {
+		cells := []*models.SudokuCellDTO{}
+		for _, position := range positions {
+			cells = append(cells, grid[position.Row][position.Column])
+		}
+
+		return cells
+	}
+
+	for _, area := range sudokuDto.GetSubSudokuAreas() {
+		rows, columns := area.Rows(sudokuDto.BoxSize), area.Columns(sudokuDto.BoxSize)
+		for line := range rows {
+			houses = append(houses,
+				&sudokuHouse{name: fmt.Sprintf("row %d", rows[line][0].Row+1), cells: getCells(rows[line])},
+				&sudokuHouse{name: fmt.Sprintf("column %d", columns[line][0].Column+1), cells: getCells(columns[line])})
+		}
+	}
+
+	return houses
+}
+
+// getSudokuCellsGrid returns cells of the sudoku by their absolute row and column
+func getSudokuCellsGrid(sudokuDto *models.SudokuDTO) [][]*models.SudokuCellDTO {
+	rows := int(sudokuDto.BoxSize) * int(sudokuDto.Layout.Height)
+	columns := int(sudokuDto.BoxSize) * int(sudokuDto.Layout.Width)
+
+	grid := make([][]*models.SudokuCellDTO, rows)
+	for row := range grid {
+		grid[row] = make([]*models.SudokuCellDTO, columns)
+	}
+
+	for _, box := range sudokuDto.Boxes {
+		for _, cell := range box.Cells {
+			position := getSudokuCellPosition(sudokuDto, box, cell)
+			if position.Row >= 0 && position.Row < rows && position.Column >= 0 && position.Column < columns {
+				grid[position.Row][position.Column] = cell
+			}
+		}
+	}
+
+	return grid
+}
+
+// getConflictingCells returns cells with values repeated in any house of the sudoku
+func getConflictingCells(sudokuDto *models.SudokuDTO) map[*models.SudokuCellDTO]bool {
+	conflictingCells := map[*models.SudokuCellDTO]bool{}
+	for cell := range newSudokuConflicts(sudokuDto).cells {
+		conflictingCells[cell] = true
+	}
+
+	return conflictingCells
+}
+
+// getSudokuBox finds the box with provided indexes, returns nil if there is no such box
+func getSudokuBox(sudokuDto *models.SudokuDTO, boxRow int, boxColumn int) *models.SudokuBoxDTO {
+	return sudokuDto.Boxes.FirstOrDefault(nil, func(box *models.SudokuBoxDTO) bool {
+		return int(box.IndexRow) == boxRow && int(box.IndexColumn) == boxColumn
+	})
+}
+
+// getSudokuCellAtPosition finds the box and the cell with provided absolute position,
+// returns nils if the position is outside of the sudoku
+func getSudokuCellAtPosition(sudokuDto *models.SudokuDTO, position models.SudokuCellPositionDTO) (
+	*models.SudokuBoxDTO, *models.SudokuCellDTO) {
+
+	boxSize := int(sudokuDto.BoxSize)
+	if boxSize < 1 || position.Row < 0 || position.Column < 0 {
+		return nil, nil
+	}
+
+	box := getSudokuBox(sudokuDto, position.Row/boxSize, position.Column/boxSize)
+	if box == nil {
+		return nil, nil
+	}
+
+	cell := box.Cells.FirstOrDefault(nil, func(cell *models.SudokuCellDTO) bool {
+		return int(cell.IndexRowInBox) == position.Row%boxSize &&
+			int(cell.IndexColumnInBox) == position.Column%boxSize
+	})
+
+	if cell == nil {
+		return nil, nil
+	}
+
+	return box, cell
+}
+
+// getSudokuCellPosition computes absolute position of the cell of the box
+func getSudokuCellPosition(sudokuDto *models.SudokuDTO, box *models.SudokuBoxDTO,
+	cell *models.SudokuCellDTO) models.SudokuCellPositionDTO {
+
+	return models.SudokuCellPositionDTO{
+		Row:    helpers.GetAbsoluteCellIndex(sudokuDto.BoxSize, box.IndexRow, cell.IndexRowInBox),
+		Column: helpers.GetAbsoluteCellIndex(sudokuDto.BoxSize, box.IndexColumn, cell.IndexColumnInBox),
+	}
+}
@@ -0,0 +1,209 @@
+package prompts
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+func TestGetSudokuHouses(t *testing.T) {
+	testCases := []struct {
+		name           string
+		filePath       string
+		expectedHouses int
+	}{
+		{
+			name:           "Classic sudoku",
+			filePath:       "../../testConfigs/simple1.json",
+			expectedHouses: 27,
+		},
+		{
+			// 17 enabled boxes and two overlapping sub-sudokus
+			name:           "Overlapping sub-sudokus",
+			filePath:       "../../testConfigs/5x5boxes.json",
+			expectedHouses: 53,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := readTestSudokuDto(t, testCase.filePath)
+		houses := getSudokuHouses(sudokuDto)
+		if len(houses) != testCase.expectedHouses {
+			t.Errorf("%s: expected %d houses, got %d", testCase.name, testCase.expectedHouses, len(houses))
+		}
+
+		size := int(sudokuDto.BoxSize) * int(sudokuDto.BoxSize)
+		for _, house := range houses {
+			if len(house.cells) != size {
+				t.Errorf("%s: house has %d cells instead of %d", testCase.name, len(house.cells), size)
+				break
+			}
+		}
+	}
+}
+
+func TestGetConflictingCells(t *testing.T) {
+	testCases := []struct {
+		name              string
+		position          models.SudokuCellPositionDTO
+		value             int
+		expectedConflicts int
+	}{
+		{
+			name:              "Unique value",
+			position:          models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			value:             2,
+			expectedConflicts: 0,
+		},
+		{
+			name:              "Value repeated in the row",
+			position:          models.SudokuCellPositionDTO{Row: 0, Column: 8},
+			value:             4,
+			expectedConflicts: 2,
+		},
+		{
+			// the same cell repeats the value in the column and the box
+			name:              "Value repeated in the row, the column and the box",
+			position:          models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			value:             4,
+			expectedConflicts: 3,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := getTestSudokDto(t)
+		_, cell := getSudokuCellAtPosition(sudokuDto, testCase.position)
+		cell.Value = &testCase.value
+
+		conflicts := getConflictingCells(sudokuDto)
+		if len(conflicts) != testCase.expectedConflicts {
+			t.Errorf("%s: expected %d conflicting cells, got %d", testCase.name,
+				testCase.expectedConflicts, len(conflicts))
+		}
+
+		if testCase.expectedConflicts > 0 && !conflicts[cell] {
+			t.Errorf("%s: changed cell is not conflicting", testCase.name)
+		}
+	}
+}
+
+func TestSudokuConflicts_Update(t *testing.T) {
+	testCases := []struct {
+		name              string
+		filePath          string
+		changes           map[models.SudokuCellPositionDTO]int
+		expectedConflicts int
+	}{
+		{
+			name:     "Conflict added",
+			filePath: "../../testConfigs/simple1.json",
+			changes: map[models.SudokuCellPositionDTO]int{
+				{Row: 0, Column: 8}: 4,
+			},
+			expectedConflicts: 2,
+		},
+		{
+			name:     "Conflict added and resolved",
+			filePath: "../../testConfigs/simple1.json",
+			changes: map[models.SudokuCellPositionDTO]int{
+				{Row: 0, Column: 8}: 4,
+				{Row: 0, Column: 5}: 9,
+			},
+			expectedConflicts: 0,
+		},
+		{
+			// row 7 belongs to both sub-sudokus
+			name:     "Conflicts in overlapping sub-sudokus",
+			filePath: "../../testConfigs/5x5boxes.json",
+			changes: map[models.SudokuCellPositionDTO]int{
+				{Row: 6, Column: 6}: 3,
+				{Row: 6, Column: 7}: 3,
+			},
+			expectedConflicts: 2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := readTestSudokuDto(t, testCase.filePath)
+		conflicts := newSudokuConflicts(sudokuDto)
+		initialConflicts := len(conflicts.cells)
+
+		changedCells := []*models.SudokuCellDTO{}
+		for position, value := range testCase.changes {
+			_, cell := getSudokuCellAtPosition(sudokuDto, position)
+			cell.Value = &value
+			changedCells = append(changedCells, cell)
+		}
+
+		conflicts.update(changedCells)
+		if len(conflicts.cells)-initialConflicts != testCase.expectedConflicts {
+			t.Errorf("%s: expected %d new conflicting cells, got %d", testCase.name,
+				testCase.expectedConflicts, len(conflicts.cells)-initialConflicts)
+		}
+
+		checkedConflicts := newSudokuConflicts(sudokuDto)
+		if !maps.Equal(conflicts.cells, checkedConflicts.cells) {
+			t.Errorf("%s: updated conflicts differ from conflicts of all houses", testCase.name)
+		}
+	}
+}
+
+func TestGetSudokuCellAtPosition(t *testing.T) {
+	sudokuDto := getTestSudokDto(t)
+	testCases := []struct {
+		name         string
+		position     models.SudokuCellPositionDTO
+		expectedBox  *models.SudokuBoxDTO
+		expectedCell *models.SudokuCellDTO
+	}{
+		{
+			name:         "First cell",
+			position:     models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			expectedBox:  sudokuDto.Boxes[0],
+			expectedCell: sudokuDto.Boxes[0].Cells[0],
+		},
+		{
+			name:         "Cell of middle box",
+			position:     models.SudokuCellPositionDTO{Row: 4, Column: 5},
+			expectedBox:  sudokuDto.Boxes[4],
+			expectedCell: sudokuDto.Boxes[4].Cells[5],
+		},
+		{
+			name:     "Outside of the sudoku",
+			position: models.SudokuCellPositionDTO{Row: 9, Column: 0},
+		},
+		{
+			name:     "Negative position",
+			position: models.SudokuCellPositionDTO{Row: 0, Column: -1},
+		},
+	}
+
+	for _, testCase := range testCases {
+		box, cell := getSudokuCellAtPosition(sudokuDto, testCase.position)
+		if box != testCase.expectedBox || cell != testCase.expectedCell {
+			t.Errorf("%s: unexpected cell found", testCase.name)
+			continue
+		}
+
+		if cell != nil && getSudokuCellPosition(sudokuDto, box, cell) != testCase.position {
+			t.Errorf("%s: position of the cell does not match", testCase.name)
+		}
+	}
+}
+
+func readTestSudokuDto(t *testing.T, testFilePath string) *models.SudokuDTO {
+	bytes, err := os.ReadFile(testFilePath)
+	if err != nil {
+		t.Fatalf("failed to read sudoku test config from file '%s'", testFilePath)
+	}
+
+	sudokuDto := &models.SudokuDTO{}
+	if err := json.Unmarshal(bytes, sudokuDto); err != nil {
+		t.Fatalf("failed to parse sudoku test config - %s", err)
+	}
+
+	return sudokuDto
+}
@@ -0,0 +1,228 @@
+package prompts
+
+import (
+	"slices"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sudokuEditorSnapshot keeps copies of sudoku data that can be changed in the
+// editor - values of cells and disabled flags of boxes (in order of boxes and
+// cells), and comparisons
+type sudokuEditorSnapshot struct {
+	values      []*int
+	disabled    []bool
+	comparisons []models.SudokuComparisonDTO
+}
+
+// sudokuCellChange is a change of single cell value
+type sudokuCellChange struct {
+	cell   *models.SudokuCellDTO
+	before *int
+	after  *int
+}
+
+// sudokuEditorChange is a single change of the sudoku made in the editor, which
+// can be reverted and applied again. Current box and cell are restored with it.
+type sudokuEditorChange struct {
+	cells              []sudokuCellChange
+	toggledBoxes       []*models.SudokuBoxDTO
+	comparisonsChanged bool
+	comparisonsBefore  []models.SudokuComparisonDTO
+	comparisonsAfter   []models.SudokuComparisonDTO
+	currentBox         *models.SudokuBoxDTO
+	currentCell        *models.SudokuCellDTO
+}
+
+// editSudoku executes the edit of the sudoku and records changes made by it in
+// undo history. Redo history is cleared if the edit changed anything.
+func editSudoku(model *sudokuValuesPrompt, edit func()) {
+	snapshot := takeSudokuSnapshot(model.sudokuDTO)
+	currentBox, currentCell := model.currentBox, model.currentCell
+
+	edit()
+
+	change := getSudokuEditorChange(model.sudokuDTO, snapshot)
+	if change == nil {
+		return
+	}
+
+	change.currentBox, change.currentCell = currentBox, currentCell
+	updateSudokuConflicts(model, change)
+	model.undoHistory = append(model.undoHistory, change)
+	model.redoHistory = nil
+}
+
+// undoSudokuChange reverts the last change of the sudoku. Returns false
+// if there is nothing to undo.
+func undoSudokuChange(model *sudokuValuesPrompt) bool {
+	if len(model.undoHistory) < 1 {
+		return false
+	}
+
+	change := model.undoHistory[len(model.undoHistory)-1]
+	model.undoHistory = model.undoHistory[:len(model.undoHistory)-1]
+	applySudokuEditorChange(model, change, true)
+	model.redoHistory = append(model.redoHistory, change)
+	return true
+}
+
+// redoSudokuChange applies the last reverted change of the sudoku again.
+// Returns false if there is nothing to redo.
+func redoSudokuChange(model *sudokuValuesPrompt) bool {
+	if len(model.redoHistory) < 1 {
+		return false
+	}
+
+	change := model.redoHistory[len(model.redoHistory)-1]
+	model.redoHistory = model.redoHistory[:len(model.redoHistory)-1]
+	applySudokuEditorChange(model, change, false)
+	model.undoHistory = append(model.undoHistory, change)
+	return true
+}
+
+// applySudokuEditorChange sets sudoku data to the state before (revert)
+// or after the change, and moves to the cell where the change was made
+func applySudokuEditorChange(model *sudokuValuesPrompt, change *sudokuEditorChange, revert bool) {
+	for _, cellChange := range change.cells {
+		value := cellChange.after
+		if revert {
+			value = cellChange.before
+		}
+
+		cellChange.cell.Value = copyIntPointer(value)
+	}
+
+	for _, box := range change.toggledBoxes {
+		box.Disabled = !box.Disabled
+	}
+
+	if change.comparisonsChanged {
+		comparisons := change.comparisonsAfter
+		if revert {
+			comparisons = change.comparisonsBefore
+		}
+
+		model.sudokuDTO.Comparisons = restoreComparisons(comparisons)
+	}
+
+	model.currentBox, model.currentCell = change.currentBox, change.currentCell
+	updateSudokuConflicts(model, change)
+}
+
+// updateSudokuConflicts checks again houses changed by the change. Toggled
+// boxes change houses of the sudoku, so all of them are found again then.
+func updateSudokuConflicts(model *sudokuValuesPrompt, change *sudokuEditorChange) {
+	if model.conflicts == nil {
+		return
+	}
+
+	if len(change.toggledBoxes) > 0 {
+		model.conflicts = newSudokuConflicts(model.sudokuDTO)
+		return
+	}
+
+	changedCells := []*models.SudokuCellDTO{}
+	for _, cellChange := range change.cells {
+		changedCells = append(changedCells, cellChange.cell)
+	}
+
+	model.conflicts.update(changedCells)
+}
+
+// takeSudokuSnapshot copies sudoku data which can be changed in the editor
+func takeSudokuSnapshot(sudokuDto *models.SudokuDTO) *sudokuEditorSnapshot {
+	snapshot := &sudokuEditorSnapshot{
+		values:      []*int{},
+		disabled:    []bool{},
+		comparisons: copyComparisons(sudokuDto.Comparisons),
+	}
+
+	for _, box := range sudokuDto.Boxes {
+		snapshot.disabled = append(snapshot.disabled, box.Disabled)
+		for _, cell := range box.Cells {
+			snapshot.values = append(snapshot.values, copyIntPointer(cell.Value))
+		}
+	}
+
+	return snapshot
+}
+
+// getSudokuEditorChange compares sudoku data with the snapshot taken before
+// the edit. Returns nil if nothing has been changed.
+func getSudokuEditorChange(sudokuDto *models.SudokuDTO,
+	snapshot *sudokuEditorSnapshot) *sudokuEditorChange {
+
+	change := &sudokuEditorChange{}
+	valueIndex := 0
+	for boxIndex, box := range sudokuDto.Boxes {
+		if box.Disabled != snapshot.disabled[boxIndex] {
+			change.toggledBoxes = append(change.toggledBoxes, box)
+		}
+
+		for _, cell := range box.Cells {
+			before := snapshot.values[valueIndex]
+			valueIndex++
+
+			if !areValuesEqual(before, cell.Value) {
+				change.cells = append(change.cells, sudokuCellChange{
+					cell:   cell,
+					before: before,
+					after:  copyIntPointer(cell.Value),
+				})
+			}
+		}
+	}
+
+	comparisons := copyComparisons(sudokuDto.Comparisons)
+	if !slices.Equal(comparisons, snapshot.comparisons) {
+		change.comparisonsChanged = true
+		change.comparisonsBefore = snapshot.comparisons
+		change.comparisonsAfter = comparisons
+	}
+
+	if len(change.cells) < 1 && len(change.toggledBoxes) < 1 && !change.comparisonsChanged {
+		return nil
+	}
+
+	return change
+}
+
+// copyComparisons copies comparisons, which are changed in place by the editor
+func copyComparisons(comparisons models.GenericSlice[*models.SudokuComparisonDTO]) []models.SudokuComparisonDTO {
+	copied := []models.SudokuComparisonDTO{}
+	for _, comparison := range comparisons {
+		copied = append(copied, *comparison)
+	}
+
+	return copied
+}
+
+// restoreComparisons builds comparisons of the sudoku from copied ones
+func restoreComparisons(comparisons []models.SudokuComparisonDTO) models.GenericSlice[*models.SudokuComparisonDTO] {
+	restored := models.GenericSlice[*models.SudokuComparisonDTO]{}
+	for _, comparison := range comparisons {
+		restored = append(restored, &comparison)
+	}
+
+	return restored
+}
+
+// copyIntPointer returns pointer to a copy of the value, or nil
+func copyIntPointer(value *int) *int {
+	if value == nil {
+		return nil
+	}
+
+	copied := *value
+	return &copied
+}
+
+// areValuesEqual checks if both values are empty or equal
+func areValuesEqual(first *int, second *int) bool {
+	if first == nil || second == nil {
+		return first == second
+	}
+
+	return *first == *second
+}
@@ -0,0 +1,270 @@
+package prompts
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// sudokuViewport is a part of the sudoku grid printed in the editor - boxes
+// which fit the terminal. Compact viewport prints cells without padding and
+// without lines between rows of cells, so vertical comparisons are not printed.
+type sudokuViewport struct {
+	firstBoxRow    int8
+	firstBoxColumn int8
+	boxRows        int8
+	boxColumns     int8
+	compact        bool
+}
+
+// getSudokuViewport fits the grid into the terminal, starting with the first
+// visible box of the model. The whole grid is printed if the terminal size
+// is not known.
+func getSudokuViewport(model *sudokuValuesPrompt) *sudokuViewport {
+	viewport := &sudokuViewport{
+		boxRows:    model.sudokuDTO.Layout.Height,
+		boxColumns: model.sudokuDTO.Layout.Width,
+	}
+
+	if model.width < 1 || model.height < 1 {
+		return viewport
+	}
+
+	// grid is printed with an empty line below it
+	width, height := getSudokuGridPrintSize(model, false)
+	viewport.compact = width > model.width ||
+		height+1+getSudokuFooterHeight(model, viewport) > model.height
+
+	boxWidth, boxHeight := getSudokuBoxPrintSize(model, viewport.compact)
+	availableHeight := model.height - getSudokuFooterHeight(model, viewport) - 1
+	viewport.boxColumns = int8(min(max((model.width-1)/boxWidth, 1), int(model.sudokuDTO.Layout.Width)))
+	viewport.boxRows = int8(min(max((availableHeight-1)/boxHeight, 1), int(model.sudokuDTO.Layout.Height)))
+	viewport.firstBoxRow = min(max(model.firstBoxRow, 0), model.sudokuDTO.Layout.Height-viewport.boxRows)
+	viewport.firstBoxColumn = min(max(model.firstBoxColumn, 0), model.sudokuDTO.Layout.Width-viewport.boxColumns)
+
+	return viewport
+}
+
+// getSudokuBoxPrintSize returns amount of characters and lines needed to print
+// single box with its right and bottom border
+func getSudokuBoxPrintSize(model *sudokuValuesPrompt, compact bool) (int, int) {
+	boxSize := int(model.sudokuDTO.BoxSize)
+	if compact {
+		return boxSize * (model.charactersPerCell + 1), boxSize + 1
+	}
+
+	charsPerCell := model.charactersPerCell + 2*int(model.settings.SudokuPrintoutValuePaddingLength)
+	return boxSize * (charsPerCell + 1), 2 * boxSize
+}
+
+// getSudokuGridPrintSize returns amount of characters and lines needed
+// to print the whole grid
+func getSudokuGridPrintSize(model *sudokuValuesPrompt, compact bool) (int, int) {
+	boxWidth, boxHeight := getSudokuBoxPrintSize(model, compact)
+	return 1 + int(model.sudokuDTO.Layout.Width)*boxWidth, 1 + int(model.sudokuDTO.Layout.Height)*boxHeight
+}
+
+// getSudokuFooterHeight returns amount of lines printed below the grid
+func getSudokuFooterHeight(model *sudokuValuesPrompt, viewport *sudokuViewport) int {
+	builder := strings.Builder{}
+	printSudokuFooter(&builder, model, viewport)
+	return strings.Count(builder.String(), "\n")
+}
+
+// printSudokuFooter prints everything what is below the grid - position of the
+// viewport, comparisons of current cell (compact viewport), conflicts, status
+// and controls of the editor
+func printSudokuFooter(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport) {
+	if model.width > 0 && model.height > 0 {
+		printSudokuViewportPosition(builder, model, viewport)
+	}
+
+	if viewport.compact {
+		printCurrentCellComparisons(builder, model)
+	}
+
+	printSudokuConflicts(builder, model)
+	if len(model.status) > 0 {
+		builder.WriteString(models.TerminalStyles.ErrorStyle.Render(model.status))
+		builder.WriteString("\n")
+	}
+
+	printSudokuControls(builder, model)
+}
+
+// printSudokuViewportPosition prints rows and columns of cells visible in the viewport
+func printSudokuViewportPosition(builder *strings.Builder, model *sudokuValuesPrompt, viewport *sudokuViewport) {
+	boxSize := int(model.sudokuDTO.BoxSize)
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render(fmt.Sprintf(
+		"Rows %d-%d of %d, columns %d-%d of %d",
+		int(viewport.firstBoxRow)*boxSize+1, int(viewport.firstBoxRow+viewport.boxRows)*boxSize,
+		int(model.sudokuDTO.Layout.Height)*boxSize,
+		int(viewport.firstBoxColumn)*boxSize+1, int(viewport.firstBoxColumn+viewport.boxColumns)*boxSize,
+		int(model.sudokuDTO.Layout.Width)*boxSize)))
+
+	if viewport.boxRows < model.sudokuDTO.Layout.Height || viewport.boxColumns < model.sudokuDTO.Layout.Width {
+		builder.WriteString(models.TerminalStyles.DefaultStyle.Render(
+			" (scroll with mouse wheel, shift+wheel for columns)"))
+	}
+
+	builder.WriteString("\n")
+}
+
+// printCurrentCellComparisons prints comparisons of current cell with adjacent
+// cells, as vertical ones are not printed in compact grid
+func printCurrentCellComparisons(builder *strings.Builder, model *sudokuValuesPrompt) {
+	current := getCurrentCellPosition(model)
+	neighbours := []struct {
+		name     string
+		position models.SudokuCellPositionDTO
+	}{
+		{name: "up", position: models.SudokuCellPositionDTO{Row: current.Row - 1, Column: current.Column}},
+		{name: "down", position: models.SudokuCellPositionDTO{Row: current.Row + 1, Column: current.Column}},
+		{name: "left", position: models.SudokuCellPositionDTO{Row: current.Row, Column: current.Column - 1}},
+		{name: "right", position: models.SudokuCellPositionDTO{Row: current.Row, Column: current.Column + 1}},
+	}
+
+	comparisons := []string{}
+	for _, neighbour := range neighbours {
+		comparison := model.sudokuDTO.FindComparison(current, neighbour.position)
+		if comparison == nil {
+			continue
+		}
+
+		sign := "<"
+		if comparison.Greater == current {
+			sign = ">"
+		}
+
+		comparisons = append(comparisons, fmt.Sprintf("%s %s", sign, neighbour.name))
+	}
+
+	if len(comparisons) < 1 {
+		comparisons = append(comparisons, "none")
+	}
+
+	builder.WriteString(models.TerminalStyles.DefaultStyle.Render("Comparisons of current cell: "))
+	builder.WriteString(models.TerminalStyles.PrimaryStyle.Render(strings.Join(comparisons, ", ")))
+	builder.WriteString("\n")
+}
+
+// followSudokuCursor scrolls the viewport, so the box of current cell is visible
+func followSudokuCursor(model *sudokuValuesPrompt) {
+	if model.currentBox == nil {
+		return
+	}
+
+	viewport := getSudokuViewport(model)
+	if model.currentBox.IndexRow < viewport.firstBoxRow {
+		viewport.firstBoxRow = model.currentBox.IndexRow
+	} else if model.currentBox.IndexRow >= viewport.firstBoxRow+viewport.boxRows {
+		viewport.firstBoxRow = model.currentBox.IndexRow - viewport.boxRows + 1
+	}
+
+	if model.currentBox.IndexColumn < viewport.firstBoxColumn {
+		viewport.firstBoxColumn = model.currentBox.IndexColumn
+	} else if model.currentBox.IndexColumn >= viewport.firstBoxColumn+viewport.boxColumns {
+		viewport.firstBoxColumn = model.currentBox.IndexColumn - viewport.boxColumns + 1
+	}
+
+	model.firstBoxRow, model.firstBoxColumn = viewport.firstBoxRow, viewport.firstBoxColumn
+}
+
+// scrollSudokuViewport moves the viewport by provided amount of boxes,
+// without moving current cell
+func scrollSudokuViewport(model *sudokuValuesPrompt, boxRows int8, boxColumns int8) {
+	viewport := getSudokuViewport(model)
+	model.firstBoxRow = viewport.firstBoxRow + boxRows
+	model.firstBoxColumn = viewport.firstBoxColumn + boxColumns
+
+	viewport = getSudokuViewport(model)
+	model.firstBoxRow, model.firstBoxColumn = viewport.firstBoxRow, viewport.firstBoxColumn
+}
+
+// handleSudokuMouse selects cells with left button (shift or drag extends the
+// selection), toggles boxes with right button and scrolls with the wheel
+func handleSudokuMouse(model *sudokuValuesPrompt, message tea.MouseMsg) {
+	switch {
+	case message.Button == tea.MouseButtonWheelUp && message.Shift,
+		message.Button == tea.MouseButtonWheelLeft:
+		scrollSudokuViewport(model, 0, -1)
+		return
+
+	case message.Button == tea.MouseButtonWheelDown && message.Shift,
+		message.Button == tea.MouseButtonWheelRight:
+		scrollSudokuViewport(model, 0, 1)
+		return
+
+	case message.Button == tea.MouseButtonWheelUp:
+		scrollSudokuViewport(model, -1, 0)
+		return
+
+	case message.Button == tea.MouseButtonWheelDown:
+		scrollSudokuViewport(model, 1, 0)
+		return
+	}
+
+	position, ok := getSudokuPositionAtScreen(model, message.X, message.Y)
+	if !ok {
+		return
+	}
+
+	moveToPosition := func(model *sudokuValuesPrompt) {
+		box, cell := getSudokuCellAtPosition(model.sudokuDTO, position)
+		if cell != nil {
+			model.currentBox, model.currentCell = box, cell
+		}
+	}
+
+	switch {
+	case message.Button != tea.MouseButtonLeft && message.Button != tea.MouseButtonRight:
+		return
+
+	case message.Action == tea.MouseActionMotion && message.Button == tea.MouseButtonLeft,
+		message.Action == tea.MouseActionPress && message.Button == tea.MouseButtonLeft && message.Shift:
+		selectSudokuCells(model, moveToPosition)
+
+	case message.Action == tea.MouseActionPress && message.Button == tea.MouseButtonLeft:
+		moveSudokuCell(model, moveToPosition)
+
+	case message.Action == tea.MouseActionPress && message.Button == tea.MouseButtonRight:
+		moveSudokuCell(model, moveToPosition)
+		editSudoku(model, func() { changeDisableStateOfCurrentBox(model, model.currentBox.Disabled) })
+	}
+}
+
+// getSudokuPositionAtScreen finds position of the cell printed at provided
+// coordinates of the terminal. Returns false if there is no cell (e.g. border).
+func getSudokuPositionAtScreen(model *sudokuValuesPrompt, x int, y int) (models.SudokuCellPositionDTO, bool) {
+	viewport := getSudokuViewport(model)
+	boxWidth, boxHeight := getSudokuBoxPrintSize(model, viewport.compact)
+	boxSize := int(model.sudokuDTO.BoxSize)
+
+	if x < 1 || y < 1 {
+		return models.SudokuCellPositionDTO{}, false
+	}
+
+	boxColumn, boxX := (x-1)/boxWidth, (x-1)%boxWidth
+	boxRow, boxY := (y-1)/boxHeight, (y-1)%boxHeight
+	if boxColumn >= int(viewport.boxColumns) || boxRow >= int(viewport.boxRows) {
+		return models.SudokuCellPositionDTO{}, false
+	}
+
+	// every cell is followed by a separator (or border) on the right, and by
+	// a line below it, except of compact viewport with border below the box only
+	cellWidth, cellHeight := boxWidth/boxSize, 2
+	if viewport.compact {
+		cellHeight = 1
+	}
+
+	if boxX%cellWidth == cellWidth-1 || boxY >= boxSize*cellHeight || boxY%cellHeight != 0 {
+		return models.SudokuCellPositionDTO{}, false
+	}
+
+	return models.SudokuCellPositionDTO{
+		Row:    (int(viewport.firstBoxRow)+boxRow)*boxSize + boxY/cellHeight,
+		Column: (int(viewport.firstBoxColumn)+boxColumn)*boxSize + boxX/cellWidth,
+	}, true
+}
@@ -0,0 +1,406 @@
+package prompts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestGetSudokuViewport(t *testing.T) {
+	testCases := []struct {
+		name             string
+		boxSize          int8
+		layoutSize       int8
+		width            int
+		height           int
+		firstBoxRow      int8
+		firstBoxColumn   int8
+		expectedViewport sudokuViewport
+	}{
+		{
+			name:             "Unknown terminal size",
+			boxSize:          3,
+			layoutSize:       3,
+			expectedViewport: sudokuViewport{boxRows: 3, boxColumns: 3},
+		},
+		{
+			name:             "Whole grid fits the terminal",
+			boxSize:          3,
+			layoutSize:       3,
+			width:            120,
+			height:           60,
+			expectedViewport: sudokuViewport{boxRows: 3, boxColumns: 3},
+		},
+		{
+			name:             "Narrow terminal",
+			boxSize:          3,
+			layoutSize:       3,
+			width:            30,
+			height:           60,
+			expectedViewport: sudokuViewport{boxRows: 3, boxColumns: 3, compact: true},
+		},
+		{
+			name:             "Maximum layout",
+			boxSize:          models.SupportedMaximumBoxSize,
+			layoutSize:       models.SupportedMaximumLayoutSize,
+			width:            80,
+			height:           40,
+			expectedViewport: sudokuViewport{boxRows: 3, boxColumns: 3, compact: true},
+		},
+		{
+			name:             "First box limited by the layout",
+			boxSize:          models.SupportedMaximumBoxSize,
+			layoutSize:       models.SupportedMaximumLayoutSize,
+			width:            80,
+			height:           40,
+			firstBoxRow:      20,
+			firstBoxColumn:   -1,
+			expectedViewport: sudokuViewport{firstBoxRow: 13, boxRows: 3, boxColumns: 3, compact: true},
+		},
+		{
+			// at least one box is printed, even if it does not fit
+			name:             "Tiny terminal",
+			boxSize:          models.SupportedMaximumBoxSize,
+			layoutSize:       models.SupportedMaximumLayoutSize,
+			width:            10,
+			height:           5,
+			expectedViewport: sudokuViewport{boxRows: 1, boxColumns: 1, compact: true},
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := models.NewEmptySudokuDTO(testCase.boxSize, testCase.layoutSize, testCase.layoutSize)
+		model, _ := buildSudokuValuesPromptModel(sudokuDto, testHelpers.GetTestSettings())
+		model.width, model.height = testCase.width, testCase.height
+		model.firstBoxRow, model.firstBoxColumn = testCase.firstBoxRow, testCase.firstBoxColumn
+
+		viewport := getSudokuViewport(model)
+		if *viewport != testCase.expectedViewport {
+			t.Errorf("%s: expected viewport %+v, got %+v", testCase.name, testCase.expectedViewport, *viewport)
+		}
+
+		width, height := getSudokuGridPrintSize(model, viewport.compact)
+		if testCase.width > 0 && viewport.boxColumns < testCase.layoutSize && width <= testCase.width {
+			t.Errorf("%s: whole grid width fits the terminal, but not all boxes are visible", testCase.name)
+		}
+
+		view := model.View()
+		if testCase.height > 0 && viewport.boxRows > 1 && strings.Count(view, "\n") > testCase.height {
+			t.Errorf("%s: view has %d lines, more than the terminal", testCase.name, strings.Count(view, "\n"))
+		}
+
+		if testCase.height > 0 && viewport.boxRows == testCase.layoutSize && height > testCase.height {
+			t.Errorf("%s: grid height %d is larger than the terminal", testCase.name, height)
+		}
+	}
+}
+
+func TestGetSudokuPositionAtScreen(t *testing.T) {
+	testCases := []struct {
+		name             string
+		width            int
+		height           int
+		x                int
+		y                int
+		expectedPosition models.SudokuCellPositionDTO
+		expectedOk       bool
+	}{
+		{
+			name:             "First cell",
+			x:                1,
+			y:                1,
+			expectedPosition: models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			expectedOk:       true,
+		},
+		{
+			name:             "Padding of the cell",
+			x:                7,
+			y:                3,
+			expectedPosition: models.SudokuCellPositionDTO{Row: 1, Column: 1},
+			expectedOk:       true,
+		},
+		{
+			name: "Separator between cells",
+			x:    4,
+			y:    1,
+		},
+		{
+			name: "Line between cells",
+			x:    1,
+			y:    2,
+		},
+		{
+			name: "Top border",
+			x:    1,
+			y:    0,
+		},
+		{
+			name:             "Cell of the next box",
+			x:                13,
+			y:                13,
+			expectedPosition: models.SudokuCellPositionDTO{Row: 6, Column: 3},
+			expectedOk:       true,
+		},
+		{
+			name: "Outside of the grid",
+			x:    40,
+			y:    1,
+		},
+		{
+			name:             "Compact grid",
+			width:            30,
+			height:           60,
+			x:                7,
+			y:                5,
+			expectedPosition: models.SudokuCellPositionDTO{Row: 3, Column: 3},
+			expectedOk:       true,
+		},
+		{
+			name:   "Border below the box of compact grid",
+			width:  30,
+			height: 60,
+			x:      7,
+			y:      4,
+		},
+	}
+
+	for _, testCase := range testCases {
+		model, _ := buildSudokuValuesPromptModel(getTestSudokDto(t), testHelpers.GetTestSettings())
+		model.width, model.height = testCase.width, testCase.height
+
+		position, ok := getSudokuPositionAtScreen(model, testCase.x, testCase.y)
+		if ok != testCase.expectedOk || position != testCase.expectedPosition {
+			t.Errorf("%s: expected position %+v (%t), got %+v (%t)", testCase.name,
+				testCase.expectedPosition, testCase.expectedOk, position, ok)
+		}
+	}
+}
+
+func TestUpdate_SudokuPrompt_Mouse(t *testing.T) {
+	press := func(button tea.MouseButton, x int, y int) tea.MouseMsg {
+		return tea.MouseMsg{X: x, Y: y, Button: button, Action: tea.MouseActionPress}
+	}
+
+	isCurrentPosition := func(model sudokuValuesPrompt, row int, column int) bool {
+		return getCurrentCellPosition(&model) == models.SudokuCellPositionDTO{Row: row, Column: column}
+	}
+
+	testCases := []struct {
+		name                string
+		messages            []tea.Msg
+		modelStateValidator func(model sudokuValuesPrompt) bool
+	}{
+		{
+			name:     "click moves to the cell",
+			messages: []tea.Msg{press(tea.MouseButtonLeft, 5, 3)},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 1, 1)
+			},
+		},
+		{
+			name:     "click on the border",
+			messages: []tea.Msg{press(tea.MouseButtonLeft, 4, 3)},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 0, 0)
+			},
+		},
+		{
+			name: "drag selects cells",
+			messages: []tea.Msg{
+				press(tea.MouseButtonLeft, 1, 1),
+				tea.MouseMsg{X: 9, Y: 5, Button: tea.MouseButtonLeft, Action: tea.MouseActionMotion},
+			},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 2, 2) &&
+					isSudokuCellSelected(&model, models.SudokuCellPositionDTO{Row: 1, Column: 1})
+			},
+		},
+		{
+			name:     "shift+click selects cells",
+			messages: []tea.Msg{tea.MouseMsg{X: 9, Y: 5, Button: tea.MouseButtonLeft, Shift: true}},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 2, 2) && model.selectionAnchor != nil
+			},
+		},
+		{
+			name: "click clears selection",
+			messages: []tea.Msg{
+				tea.MouseMsg{X: 9, Y: 5, Button: tea.MouseButtonLeft, Shift: true},
+				press(tea.MouseButtonLeft, 1, 1),
+			},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 0, 0) && model.selectionAnchor == nil
+			},
+		},
+		{
+			name:     "right click disables the box",
+			messages: []tea.Msg{press(tea.MouseButtonRight, 13, 1)},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 0, 3) && model.currentBox.Disabled && len(model.undoHistory) == 1
+			},
+		},
+		{
+			name: "second right click enables the box",
+			messages: []tea.Msg{
+				press(tea.MouseButtonRight, 13, 1),
+				press(tea.MouseButtonRight, 13, 1),
+			},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return !model.currentBox.Disabled && len(model.undoHistory) == 2
+			},
+		},
+		{
+			name:     "middle click",
+			messages: []tea.Msg{press(tea.MouseButtonMiddle, 5, 3)},
+			modelStateValidator: func(model sudokuValuesPrompt) bool {
+				return isCurrentPosition(model, 0, 0)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		model, _ := buildSudokuValuesPromptModel(getTestSudokDto(t), testHelpers.GetTestSettings())
+
+		var resultModel tea.Model = *model
+		for _, message := range testCase.messages {
+			resultModel, _ = resultModel.Update(message)
+		}
+
+		if !testCase.modelStateValidator(resultModel.(sudokuValuesPrompt)) {
+			t.Errorf("%s: invalid model state", testCase.name)
+		}
+	}
+}
+
+func TestUpdate_SudokuPrompt_Scrolling(t *testing.T) {
+	wheel := func(button tea.MouseButton, shift bool) tea.MouseMsg {
+		return tea.MouseMsg{Button: button, Shift: shift, Action: tea.MouseActionPress}
+	}
+
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	repeat := func(message tea.Msg, count int) []tea.Msg {
+		messages := []tea.Msg{}
+		for index := 0; index < count; index++ {
+			messages = append(messages, message)
+		}
+
+		return messages
+	}
+
+	testCases := []struct {
+		name                   string
+		messages               []tea.Msg
+		expectedFirstBoxRow    int8
+		expectedFirstBoxColumn int8
+		expectedView           string
+	}{
+		{
+			name:         "initial viewport",
+			expectedView: "Rows 1-24 of 128, columns 1-24 of 128 (scroll with mouse wheel, shift+wheel for columns)",
+		},
+		{
+			name:                "wheel scrolls rows",
+			messages:            repeat(wheel(tea.MouseButtonWheelDown, false), 3),
+			expectedFirstBoxRow: 3,
+			expectedView:        "Rows 25-48 of 128",
+		},
+		{
+			name:                   "shift+wheel scrolls columns",
+			messages:               repeat(wheel(tea.MouseButtonWheelDown, true), 2),
+			expectedFirstBoxColumn: 2,
+			expectedView:           "columns 17-40 of 128",
+		},
+		{
+			name:         "wheel does not scroll above the grid",
+			messages:     repeat(wheel(tea.MouseButtonWheelUp, false), 2),
+			expectedView: "Rows 1-24 of 128",
+		},
+		{
+			name:                "wheel does not scroll below the grid",
+			messages:            repeat(wheel(tea.MouseButtonWheelDown, false), 20),
+			expectedFirstBoxRow: 13,
+			expectedView:        "Rows 105-128 of 128",
+		},
+		{
+			name:                   "viewport follows the cursor",
+			messages:               append(repeat(right, 30), repeat(down, 30)...),
+			expectedFirstBoxRow:    1,
+			expectedFirstBoxColumn: 1,
+		},
+		{
+			name:     "viewport goes back to the cursor",
+			messages: append(repeat(wheel(tea.MouseButtonWheelDown, false), 5), right),
+		},
+		{
+			name: "window resize keeps the cursor visible",
+			messages: append(repeat(right, 30),
+				tea.WindowSizeMsg{Width: 40, Height: 40}),
+			expectedFirstBoxColumn: 3,
+			expectedView:           "columns 25-32 of 128",
+		},
+	}
+
+	for _, testCase := range testCases {
+		sudokuDto := models.NewEmptySudokuDTO(models.SupportedMaximumBoxSize,
+			models.SupportedMaximumLayoutSize, models.SupportedMaximumLayoutSize)
+		model, _ := buildSudokuValuesPromptModel(sudokuDto, testHelpers.GetTestSettings())
+
+		var resultModel tea.Model = *model
+		resultModel, _ = resultModel.Update(tea.WindowSizeMsg{Width: 80, Height: 40})
+		for _, message := range testCase.messages {
+			resultModel, _ = resultModel.Update(message)
+		}
+
+		result := resultModel.(sudokuValuesPrompt)
+		if result.firstBoxRow != testCase.expectedFirstBoxRow ||
+			result.firstBoxColumn != testCase.expectedFirstBoxColumn {
+			t.Errorf("%s: expected first box (%d, %d), got (%d, %d)", testCase.name,
+				testCase.expectedFirstBoxRow, testCase.expectedFirstBoxColumn,
+				result.firstBoxRow, result.firstBoxColumn)
+		}
+
+		if len(testCase.expectedView) > 0 && !strings.Contains(result.View(), testCase.expectedView) {
+			t.Errorf("%s: view does not contain '%s'", testCase.name, testCase.expectedView)
+		}
+	}
+}
+
+func TestView_SudokuPrompt_Compact(t *testing.T) {
+	sudokuDto := getTestSudokDto(t)
+	sudokuDto.Comparisons = append(sudokuDto.Comparisons,
+		&models.SudokuComparisonDTO{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 0},
+			Lesser:  models.SudokuCellPositionDTO{Row: 1, Column: 0},
+		},
+		&models.SudokuComparisonDTO{
+			Greater: models.SudokuCellPositionDTO{Row: 0, Column: 1},
+			Lesser:  models.SudokuCellPositionDTO{Row: 0, Column: 0},
+		})
+
+	model, _ := buildSudokuValuesPromptModel(sudokuDto, testHelpers.GetTestSettings())
+	resultModel, _ := model.Update(tea.WindowSizeMsg{Width: 30, Height: 60})
+
+	expectedSubstrings := []string{
+		"╔═════╦═════╦═════╗",
+		"║_<_│_║_│_│4║3│1│_║",
+		"║3│_│9║2│7│_║5│6│_║",
+		"║═════╬═════╬═════║",
+		"Rows 1-9 of 9, columns 1-9 of 9",
+		"Comparisons of current cell: > down, < right",
+	}
+
+	view := resultModel.View()
+	for _, expectedSubstring := range expectedSubstrings {
+		if !strings.Contains(view, expectedSubstring) {
+			t.Errorf("Compact view does not contain '%s' substring.", expectedSubstring)
+		}
+	}
+
+	if strings.Contains(view, "───") {
+		t.Errorf("Compact view contains lines between rows of cells.")
+	}
+}
@@ -1,6 +1,8 @@
 package services
 
 import (
+	"io"
+	"log/slog"
 	"os"
 
 	"github.com/Michu8258/kangaroo/models"
@@ -9,15 +11,21 @@ import (
 	"github.com/Michu8258/kangaroo/services/dataPrinters"
 	"github.com/Michu8258/kangaroo/services/dataReader"
 	"github.com/Michu8258/kangaroo/services/dataWriter"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/services/printer"
 	"github.com/Michu8258/kangaroo/services/prompts"
+	"github.com/Michu8258/kangaroo/services/sudokuFormats"
+	"github.com/Michu8258/kangaroo/services/sudokuGenerator"
+	"github.com/Michu8258/kangaroo/services/sudokuGrader"
 	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
 type ServiceCollection struct {
 	TerminalPrinter printer.IPrinter
-	DebugPrinter    printer.IPrinter
+	Logger          *slog.Logger
 	DataReader      dataReader.IDataReader
 	DataWriter      dataWriter.IDataWriter
 	DataPrinter     dataPrinters.IDataPrinter
@@ -25,33 +33,51 @@ type ServiceCollection struct {
 	Prompter        prompts.IPrompter
 	Solver          crook.ISudokuSolver
 	SudokuEncoder   binarySudokuManager.IBinarySudokuManager
+	Generator       sudokuGenerator.ISudokuGenerator
+	SudokuFormats   sudokuFormats.ISudokuFormats
+	Renderer        sudokuRenderer.ISudokuRenderer
+	Grader          sudokuGrader.ISudokuGrader
 }
 
-// Build creates a service collection to use in the application
-func Build(settings *models.Settings) *ServiceCollection {
+// Build creates a service collection to use in the application. Logs are
+// written to provided writer.
+func Build(settings *models.Settings, logWriter io.Writer) *ServiceCollection {
 	terminalPrinter := printer.NewTerminalPrinter(settings, os.Stdout)
-	debugPrinter := printer.NewDebugPrinter(settings, os.Stdout)
+	structuredLogger := logger.New(settings, logWriter)
 	dataPrinter := dataPrinters.GetNewDataPrinter(settings, terminalPrinter)
+	sudokuInitializer := sudokuInit.GetNewSudokuInit(settings, structuredLogger)
+	sudokuEncoder := binarySudokuManager.GetNewBinarySudokuManager(settings)
+	renderer := sudokuRenderer.GetNewSudokuRenderer(settings)
+	formats := sudokuFormats.GetNewSudokuFormats(settings,
+		textSudokuParser.GetNewTextSudokuParser(settings), dataPrinter, sudokuEncoder, renderer)
 	prompter := prompts.GetNewPrompter(
 		settings,
 		terminalPrinter,
 		func(model tea.Model, opts ...tea.ProgramOption) (tea.Model, error) {
+			// standard output is reserved for JSON document
+			if settings.OutputFormat == models.JSONOutputFormat {
+				opts = append(opts, tea.WithOutput(os.Stderr))
+			}
 			program := tea.NewProgram(model, opts...)
 			return program.Run()
 		})
 
 	return &ServiceCollection{
 		TerminalPrinter: terminalPrinter,
-		DebugPrinter:    debugPrinter,
+		Logger:          structuredLogger,
 		Prompter:        prompter,
 		DataPrinter:     dataPrinter,
-		SudokuInit:      sudokuInit.GetNewSudokuInit(settings),
-		DataReader:      dataReader.GetNewDataReader(settings, terminalPrinter, debugPrinter, prompter),
+		SudokuInit:      sudokuInitializer,
+		DataReader:      dataReader.GetNewDataReader(settings, terminalPrinter, structuredLogger, prompter, formats),
 		DataWriter: dataWriter.GetNewDataWriter(settings, dataPrinter,
 			func(file *os.File) printer.IPrinter {
 				return printer.NewTxtFilePrinter(file)
-			}),
-		Solver:        crook.GetNewSudokuSolver(settings, debugPrinter),
-		SudokuEncoder: binarySudokuManager.GetNewBinarySudokuManager(settings),
+			}, formats),
+		Solver:        crook.GetNewSudokuSolver(settings, structuredLogger),
+		SudokuEncoder: sudokuEncoder,
+		Generator:     sudokuGenerator.GetNewSudokuGenerator(settings, sudokuInitializer),
+		SudokuFormats: formats,
+		Renderer:      renderer,
+		Grader:        sudokuGrader.GetNewSudokuGrader(settings),
 	}
 }
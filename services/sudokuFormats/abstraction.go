@@ -0,0 +1,42 @@
+package sudokuFormats
+
+import (
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
+)
+
+type SudokuFormats struct {
+	Settings      *models.Settings
+	TextParser    textSudokuParser.ITextSudokuParser
+	DataPrinter   dataPrinters.IDataPrinter
+	BinaryManager binarySudokuManager.IBinarySudokuManager
+	Renderer      sudokuRenderer.ISudokuRenderer
+	formats       []*SudokuFormat
+}
+
+type ISudokuFormats interface {
+	GetFormats() []*SudokuFormat
+	GetFormatByName(name string) *SudokuFormat
+	GetFormatByPath(path string) *SudokuFormat
+	GetReadFormat(path string, data []byte) *SudokuFormat
+}
+
+func GetNewSudokuFormats(settings *models.Settings,
+	textParser textSudokuParser.ITextSudokuParser,
+	dataPrinter dataPrinters.IDataPrinter,
+	binaryManager binarySudokuManager.IBinarySudokuManager,
+	renderer sudokuRenderer.ISudokuRenderer) ISudokuFormats {
+	sudokuFormats := &SudokuFormats{
+		Settings:      settings,
+		TextParser:    textParser,
+		DataPrinter:   dataPrinter,
+		BinaryManager: binaryManager,
+		Renderer:      renderer,
+	}
+
+	sudokuFormats.formats = sudokuFormats.buildFormats()
+	return sudokuFormats
+}
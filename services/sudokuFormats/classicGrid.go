@@ -0,0 +1,112 @@
+package sudokuFormats
+
+import (
+	"fmt"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// classicBoxSize is the box size of 9x9 sudoku, the only size
+// supported by most of the formats of other applications
+const classicBoxSize = 3
+
+// getClassicValues returns values of classic sudoku (square grid of enabled boxes,
+// e.g. 9x9) as rows of values, 0 means empty cell. Returns an error if the sudoku
+// is not classic or its box size is not one of provided box sizes.
+func getClassicValues(sudokuDto *models.SudokuDTO, boxSizes ...int8) ([][]int, error) {
+	if sudokuDto.Layout.Width != sudokuDto.BoxSize || sudokuDto.Layout.Height != sudokuDto.BoxSize ||
+		sudokuDto.Boxes.Any(func(box *models.SudokuBoxDTO) bool { return box.Disabled }) {
+		return nil, fmt.Errorf("only classic sudoku with %dx%d boxes layout and no disabled boxes is supported",
+			sudokuDto.BoxSize, sudokuDto.BoxSize)
+	}
+
+	supported := len(boxSizes) < 1
+	for _, boxSize := range boxSizes {
+		supported = supported || boxSize == sudokuDto.BoxSize
+	}
+
+	if !supported {
+		return nil, fmt.Errorf("box size %d is not supported (%v)", sudokuDto.BoxSize, boxSizes)
+	}
+
+	size := int(sudokuDto.BoxSize) * int(sudokuDto.BoxSize)
+	values := make([][]int, size)
+	for row := range values {
+		values[row] = make([]int, size)
+	}
+
+	for _, box := range sudokuDto.Boxes {
+		for _, cell := range box.Cells {
+			if cell.Value != nil {
+				row := int(box.IndexRow)*int(sudokuDto.BoxSize) + int(cell.IndexRowInBox)
+				column := int(box.IndexColumn)*int(sudokuDto.BoxSize) + int(cell.IndexColumnInBox)
+				values[row][column] = *cell.Value
+			}
+		}
+	}
+
+	return values, nil
+}
+
+// newClassicSudoku builds classic sudoku with provided rows of values,
+// 0 means empty cell. Returns an error if values do not form a valid grid.
+func newClassicSudoku(values [][]int) (*models.SudokuDTO, error) {
+	boxSize := 1
+	for boxSize*boxSize < len(values) {
+		boxSize++
+	}
+
+	if boxSize*boxSize != len(values) || boxSize < 2 {
+		return nil, fmt.Errorf("%d rows do not form a classic sudoku", len(values))
+	}
+
+	sudokuDto := models.NewEmptySudokuDTO(int8(boxSize), int8(boxSize), int8(boxSize))
+	for rowIndex, row := range values {
+		if len(row) != len(values) {
+			return nil, fmt.Errorf("row %d has %d values, but there are %d rows",
+				rowIndex+1, len(row), len(values))
+		}
+
+		for columnIndex, value := range row {
+			if value < 0 || value > len(values) {
+				return nil, fmt.Errorf("'%d' in row %d, column %d is not a value between 1 and %d",
+					value, rowIndex+1, columnIndex+1, len(values))
+			}
+
+			if value > 0 {
+				box := sudokuDto.Boxes[(rowIndex/boxSize)*boxSize+columnIndex/boxSize]
+				cellValue := value
+				box.Cells[(rowIndex%boxSize)*boxSize+columnIndex%boxSize].Value = &cellValue
+			}
+		}
+	}
+
+	return sudokuDto, nil
+}
+
+// getCandidates returns values which can be placed in the empty cell of
+// classic sudoku without breaking row, column and box uniqueness rules
+func getCandidates(values [][]int, row int, column int) []int {
+	boxSize := 1
+	for boxSize*boxSize < len(values) {
+		boxSize++
+	}
+
+	used := make([]bool, len(values)+1)
+	for index := range values {
+		used[values[row][index]] = true
+		used[values[index][column]] = true
+		boxRow := (row/boxSize)*boxSize + index/boxSize
+		boxColumn := (column/boxSize)*boxSize + index%boxSize
+		used[values[boxRow][boxColumn]] = true
+	}
+
+	candidates := []int{}
+	for value := 1; value <= len(values); value++ {
+		if !used[value] {
+			candidates = append(candidates, value)
+		}
+	}
+
+	return candidates
+}
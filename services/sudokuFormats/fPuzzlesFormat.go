@@ -0,0 +1,229 @@
+package sudokuFormats
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+type fPuzzlesCell struct {
+	Value  *int `json:"value,omitempty"`
+	Given  bool `json:"given,omitempty"`
+	Region *int `json:"region,omitempty"`
+}
+
+type fPuzzlesThermometer struct {
+	Lines [][]string `json:"lines"`
+}
+
+type fPuzzlesArrow struct {
+	Cells []string   `json:"cells"`
+	Lines [][]string `json:"lines"`
+}
+
+type fPuzzlesPuzzle struct {
+	Size        int                   `json:"size"`
+	Title       string                `json:"title,omitempty"`
+	Author      string                `json:"author,omitempty"`
+	Grid        [][]fPuzzlesCell      `json:"grid"`
+	Thermometer []fPuzzlesThermometer `json:"thermometer,omitempty"`
+	Arrow       []fPuzzlesArrow       `json:"arrow,omitempty"`
+}
+
+// fPuzzlesIgnoredKeys are keys of f-puzzles data that do not change rules of the
+// puzzle (metadata and cosmetic elements), or that are read as the puzzle
+var fPuzzlesIgnoredKeys = []string{
+	"size", "title", "author", "ruleset", "solution", "grid", "thermometer", "arrow",
+	"text", "line", "rectangle", "circle", "cage",
+}
+
+// detectFPuzzles checks if the data is a JSON object with f-puzzles grid
+func detectFPuzzles(data []byte) bool {
+	return hasJsonKeys(data, "size", "grid")
+}
+
+// readFPuzzles reads f-puzzles JSON data - size, given values, thermometers and
+// arrows. Returns an error if the puzzle uses irregular regions or constraints
+// which are not supported, so the puzzle is not solved with different rules.
+func readFPuzzles(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	if err := checkFPuzzlesConstraints(data); err != nil {
+		return nil, err
+	}
+
+	puzzle := &fPuzzlesPuzzle{}
+	if err := json.Unmarshal(data, puzzle); err != nil {
+		return nil, err
+	}
+
+	if len(puzzle.Grid) != puzzle.Size {
+		return nil, fmt.Errorf("grid has %d rows, but the size is %d", len(puzzle.Grid), puzzle.Size)
+	}
+
+	values := make([][]int, puzzle.Size)
+	for row, cells := range puzzle.Grid {
+		values[row] = make([]int, len(cells))
+		for column, cell := range cells {
+			if cell.Given && cell.Value != nil {
+				values[row][column] = *cell.Value
+			}
+		}
+	}
+
+	sudokuDto, err := newClassicSudoku(values)
+	if err != nil {
+		return nil, err
+	}
+
+	boxSize := int(sudokuDto.BoxSize)
+	for row, cells := range puzzle.Grid {
+		for column, cell := range cells {
+			if cell.Region != nil && *cell.Region != (row/boxSize)*boxSize+column/boxSize {
+				return nil, fmt.Errorf("irregular region of cell R%dC%d is not supported", row+1, column+1)
+			}
+		}
+	}
+
+	for _, thermometer := range puzzle.Thermometer {
+		for _, line := range thermometer.Lines {
+			path, err := parseFPuzzlesCells(line, puzzle.Size)
+			if err != nil {
+				return nil, err
+			}
+
+			sudokuDto.Thermometers = append(sudokuDto.Thermometers, &models.SudokuThermometerDTO{Cells: path})
+		}
+	}
+
+	for _, arrow := range puzzle.Arrow {
+		if len(arrow.Cells) != 1 {
+			return nil, fmt.Errorf("arrow with %d circle cells is not supported", len(arrow.Cells))
+		}
+
+		circle, err := parseFPuzzlesCells(arrow.Cells, puzzle.Size)
+		if err != nil {
+			return nil, err
+		}
+
+		arrowDto := &models.SudokuArrowDTO{Circle: *circle[0]}
+		for _, line := range arrow.Lines {
+			path, err := parseFPuzzlesCells(line, puzzle.Size)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, position := range path {
+				if *position != arrowDto.Circle {
+					arrowDto.Cells = append(arrowDto.Cells, position)
+				}
+			}
+		}
+
+		sudokuDto.Arrows = append(sudokuDto.Arrows, arrowDto)
+	}
+
+	return []*models.SudokuDTO{sudokuDto}, nil
+}
+
+// writeFPuzzles writes the sudoku as f-puzzles JSON data. Given values,
+// thermometers and arrows are written, comparisons are omitted.
+func writeFPuzzles(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	values, err := getClassicValues(sudokuDto, 2, 3, 4)
+	if err != nil {
+		return err
+	}
+
+	puzzle := &fPuzzlesPuzzle{
+		Size: len(values),
+		Grid: make([][]fPuzzlesCell, len(values)),
+	}
+
+	for row := range values {
+		puzzle.Grid[row] = make([]fPuzzlesCell, len(values))
+		for column, value := range values[row] {
+			if value > 0 {
+				puzzle.Grid[row][column] = fPuzzlesCell{Value: &values[row][column], Given: true}
+			}
+		}
+	}
+
+	for _, thermometer := range sudokuDto.Thermometers {
+		puzzle.Thermometer = append(puzzle.Thermometer, fPuzzlesThermometer{
+			Lines: [][]string{formatFPuzzlesCells(thermometer.Cells...)},
+		})
+	}
+
+	for _, arrow := range sudokuDto.Arrows {
+		circle := formatFPuzzlesCells(&arrow.Circle)
+		puzzle.Arrow = append(puzzle.Arrow, fPuzzlesArrow{
+			Cells: circle,
+			Lines: [][]string{append(circle, formatFPuzzlesCells(arrow.Cells...)...)},
+		})
+	}
+
+	data, err := json.Marshal(puzzle)
+	if err != nil {
+		return err
+	}
+
+	return writeData(writer, data)
+}
+
+// checkFPuzzlesConstraints returns an error if the f-puzzles data
+// contains constraints which are not supported
+func checkFPuzzlesConstraints(data []byte) error {
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	unsupported := []string{}
+	for key, value := range object {
+		value = bytes.TrimSpace(value)
+		if slices.Contains(fPuzzlesIgnoredKeys, key) ||
+			slices.Contains([]string{"null", "false", "[]", "{}", `""`}, string(value)) {
+			continue
+		}
+
+		unsupported = append(unsupported, key)
+	}
+
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return fmt.Errorf("constraints not supported: %s", strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// parseFPuzzlesCells converts f-puzzles cell references (e.g. 'R1C2') to zero
+// based positions. Returns an error if a reference is invalid or out of the grid.
+func parseFPuzzlesCells(references []string, size int) (models.GenericSlice[*models.SudokuCellPositionDTO], error) {
+	positions := models.GenericSlice[*models.SudokuCellPositionDTO]{}
+	for _, reference := range references {
+		var row, column int
+		_, err := fmt.Sscanf(strings.ToUpper(reference), "R%dC%d", &row, &column)
+		if err != nil || row < 1 || row > size || column < 1 || column > size {
+			return nil, fmt.Errorf("invalid cell reference '%s'", reference)
+		}
+
+		positions = append(positions, &models.SudokuCellPositionDTO{Row: row - 1, Column: column - 1})
+	}
+
+	return positions, nil
+}
+
+// formatFPuzzlesCells converts zero based positions to f-puzzles cell references
+func formatFPuzzlesCells(positions ...*models.SudokuCellPositionDTO) []string {
+	references := []string{}
+	for _, position := range positions {
+		references = append(references, fmt.Sprintf("R%dC%d", position.Row+1, position.Column+1))
+	}
+
+	return references
+}
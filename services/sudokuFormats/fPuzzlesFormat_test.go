@@ -0,0 +1,146 @@
+package sudokuFormats
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+func TestReadFPuzzles(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		puzzle               map[string]any
+		expectsError         bool
+		expectedValues       int
+		expectedThermometers int
+		expectedArrowCells   int
+	}{
+		{
+			name: "Givens and constraints",
+			puzzle: map[string]any{
+				"title":       "Test",
+				"thermometer": []any{map[string]any{"lines": [][]string{{"R1C1", "R1C2", "R1C3"}, {"R1C1", "R2C1"}}}},
+				"arrow":       []any{map[string]any{"cells": []string{"R5C5"}, "lines": [][]string{{"R5C5", "R6C6", "R7C7"}}}},
+				"line":        []any{map[string]any{"lines": [][]string{{"R9C9", "R8C8"}}}},
+				"killercage":  []any{},
+				"diagonal+":   false,
+			},
+			expectedValues:       2,
+			expectedThermometers: 2,
+			expectedArrowCells:   2,
+		},
+		{
+			name:         "Unsupported constraint",
+			puzzle:       map[string]any{"killercage": []any{map[string]any{"cells": []string{"R1C1"}, "value": "5"}}},
+			expectsError: true,
+		},
+		{
+			name:         "Arrow with multiple circle cells",
+			puzzle:       map[string]any{"arrow": []any{map[string]any{"cells": []string{"R1C1", "R1C2"}, "lines": [][]string{}}}},
+			expectsError: true,
+		},
+		{
+			name:         "Invalid cell reference",
+			puzzle:       map[string]any{"thermometer": []any{map[string]any{"lines": [][]string{{"R1C10"}}}}},
+			expectsError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		grid := newFPuzzlesGrid(9)
+		grid[0][0] = fPuzzlesCell{Value: intPointer(5), Given: true}
+		grid[8][8] = fPuzzlesCell{Value: intPointer(3), Given: true, Region: intPointer(8)}
+		grid[4][4] = fPuzzlesCell{Value: intPointer(7)}
+		testCase.puzzle["size"] = 9
+		testCase.puzzle["grid"] = grid
+
+		data, _ := json.Marshal(testCase.puzzle)
+		sudokus, err := newTestSudokuFormats().GetFormatByName(FPuzzlesFormatName).Read(data, "")
+
+		if testCase.expectsError {
+			if !errors.Is(err, models.ErrInvalidInput) {
+				t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		values, _ := getClassicValues(sudokus[0])
+		valuesCount := 81 - strings.Count(formatValues(values), "0")
+		arrowCells := 0
+		for _, arrow := range sudokus[0].Arrows {
+			arrowCells += len(arrow.Cells)
+		}
+
+		if valuesCount != testCase.expectedValues || len(sudokus[0].Thermometers) != testCase.expectedThermometers ||
+			arrowCells != testCase.expectedArrowCells {
+			t.Errorf("%s: Expected %d values, %d thermometers and %d arrow cells, got %d, %d and %d",
+				testCase.name, testCase.expectedValues, testCase.expectedThermometers, testCase.expectedArrowCells,
+				valuesCount, len(sudokus[0].Thermometers), arrowCells)
+		}
+	}
+}
+
+func TestReadFPuzzles_IrregularRegions(t *testing.T) {
+	grid := newFPuzzlesGrid(4)
+	grid[0][2] = fPuzzlesCell{Region: intPointer(0)}
+	data, _ := json.Marshal(map[string]any{"size": 4, "grid": grid})
+
+	_, err := newTestSudokuFormats().GetFormatByName(FPuzzlesFormatName).Read(data, "")
+	if !errors.Is(err, models.ErrInvalidInput) {
+		t.Errorf("Expected invalid input error, got %v", err)
+	}
+}
+
+func TestWriteFPuzzles(t *testing.T) {
+	sudokuDto := models.NewEmptySudokuDTO(2, 2, 2)
+	sudokuDto.Boxes[3].Cells[3].Value = intPointer(4)
+	sudokuDto.Thermometers = models.GenericSlice[*models.SudokuThermometerDTO]{
+		{Cells: models.GenericSlice[*models.SudokuCellPositionDTO]{{Row: 0, Column: 0}, {Row: 0, Column: 1}}},
+	}
+	sudokuDto.Arrows = models.GenericSlice[*models.SudokuArrowDTO]{
+		{Circle: models.SudokuCellPositionDTO{Row: 2, Column: 2}, Cells: models.GenericSlice[*models.SudokuCellPositionDTO]{{Row: 1, Column: 1}}},
+	}
+
+	buffer := &bytes.Buffer{}
+	format := newTestSudokuFormats().GetFormatByName(FPuzzlesFormatName)
+	if err := format.Write(buffer, sudokuDto); err != nil {
+		t.Fatalf("Unexpected write error %s", err)
+	}
+
+	for _, expectedContent := range []string{
+		`"size":4`, `{"value":4,"given":true}`, `"thermometer":[{"lines":[["R1C1","R1C2"]]}]`,
+		`"arrow":[{"cells":["R3C3"],"lines":[["R3C3","R2C2"]]}]`,
+	} {
+		if !strings.Contains(buffer.String(), expectedContent) {
+			t.Errorf("Written data '%s' does not contain '%s'", buffer.String(), expectedContent)
+		}
+	}
+
+	sudokus, err := format.Read(buffer.Bytes(), "")
+	if err != nil || len(sudokus[0].Thermometers) != 1 || len(sudokus[0].Arrows) != 1 ||
+		*sudokus[0].Boxes[3].Cells[3].Value != 4 {
+		t.Errorf("Written sudoku was not read back (%v)", err)
+	}
+}
+
+func newFPuzzlesGrid(size int) [][]fPuzzlesCell {
+	grid := make([][]fPuzzlesCell, size)
+	for row := range grid {
+		grid[row] = make([]fPuzzlesCell, size)
+	}
+
+	return grid
+}
+
+func intPointer(value int) *int {
+	return &value
+}
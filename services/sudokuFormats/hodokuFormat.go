@@ -0,0 +1,129 @@
+package sudokuFormats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// detectHoDoKu checks if the data starts with top border of HoDoKu grid
+func detectHoDoKu(data []byte) bool {
+	lines := getTextLines(data)
+	return len(lines) > 0 && (strings.HasPrefix(lines[0], ".-") || strings.HasPrefix(lines[0], "*-"))
+}
+
+// readHoDoKu reads HoDoKu candidates grid. Cells with a single digit are values,
+// cells with multiple candidates are empty. Border lines are ignored.
+func readHoDoKu(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	values := [][]int{}
+	for _, line := range getTextLines(data) {
+		if !strings.HasPrefix(line, "|") {
+			continue
+		}
+
+		row := []int{}
+		for _, cell := range strings.Fields(strings.ReplaceAll(line, "|", " ")) {
+			if _, err := strconv.Atoi(cell); err != nil {
+				return nil, fmt.Errorf("'%s' in row %d is not a list of candidates", cell, len(values)+1)
+			}
+
+			value := 0
+			if len(cell) == 1 {
+				value, _ = strconv.Atoi(cell)
+			}
+
+			row = append(row, value)
+		}
+
+		values = append(values, row)
+	}
+
+	if len(values) < 1 {
+		return nil, errors.New("there are no rows of the grid")
+	}
+
+	sudokuDto, err := newClassicSudoku(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*models.SudokuDTO{sudokuDto}, nil
+}
+
+// writeHoDoKu writes HoDoKu candidates grid. Empty cells contain all values
+// which do not break row, column and box uniqueness rules.
+func writeHoDoKu(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	values, err := getClassicValues(sudokuDto, classicBoxSize)
+	if err != nil {
+		return err
+	}
+
+	boxSize := int(sudokuDto.BoxSize)
+	cells := make([][]string, len(values))
+	widths := make([]int, len(values))
+
+	for rowIndex, row := range values {
+		cells[rowIndex] = make([]string, len(row))
+		for columnIndex, value := range row {
+			candidates := []int{value}
+			if value == 0 {
+				candidates = getCandidates(values, rowIndex, columnIndex)
+			}
+
+			cells[rowIndex][columnIndex] = formatDigits(candidates, "")
+			widths[columnIndex] = max(widths[columnIndex], len(cells[rowIndex][columnIndex]))
+		}
+	}
+
+	builder := &strings.Builder{}
+	writeHoDoKuBorder(builder, widths, boxSize, ".", ".", ".")
+
+	for rowIndex, row := range cells {
+		if rowIndex > 0 && rowIndex%boxSize == 0 {
+			writeHoDoKuBorder(builder, widths, boxSize, ":", "+", ":")
+		}
+
+		for columnIndex, cell := range row {
+			if columnIndex%boxSize == 0 {
+				builder.WriteString("| ")
+			} else {
+				builder.WriteString("  ")
+			}
+
+			builder.WriteString(cell + strings.Repeat(" ", widths[columnIndex]-len(cell)))
+			if columnIndex%boxSize == boxSize-1 {
+				builder.WriteString(" ")
+			}
+		}
+
+		builder.WriteString("|\n")
+	}
+
+	writeHoDoKuBorder(builder, widths, boxSize, "'", "'", "'")
+	return writeData(writer, []byte(builder.String()))
+}
+
+// writeHoDoKuBorder writes horizontal border line of HoDoKu grid
+func writeHoDoKuBorder(builder *strings.Builder, widths []int, boxSize int,
+	left string, middle string, right string) {
+
+	builder.WriteString(left)
+	for boxIndex := 0; boxIndex < boxSize; boxIndex++ {
+		if boxIndex > 0 {
+			builder.WriteString(middle)
+		}
+
+		width := 2*(boxSize-1) + 2
+		for _, columnWidth := range widths[boxIndex*boxSize : (boxIndex+1)*boxSize] {
+			width += columnWidth
+		}
+
+		builder.WriteString(strings.Repeat("-", width))
+	}
+
+	builder.WriteString(right + "\n")
+}
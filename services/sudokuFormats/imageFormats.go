@@ -0,0 +1,31 @@
+package sudokuFormats
+
+import (
+	"io"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+)
+
+// renderSvg draws the sudoku as SVG image
+func (sudokuFormats *SudokuFormats) renderSvg(writer io.Writer, sudoku *models.Sudoku) error {
+	return sudokuFormats.Renderer.RenderSvg(writer, sudoku, sudokuFormats.getRenderOptions())
+}
+
+// renderPng draws the sudoku as PNG image
+func (sudokuFormats *SudokuFormats) renderPng(writer io.Writer, sudoku *models.Sudoku) error {
+	return sudokuFormats.Renderer.RenderPng(writer, sudoku, sudokuFormats.getRenderOptions())
+}
+
+// renderHtml writes the sudoku as HTML page where the puzzle can be played
+func (sudokuFormats *SudokuFormats) renderHtml(writer io.Writer, sudoku *models.Sudoku) error {
+	return sudokuFormats.Renderer.RenderHtml(writer, sudoku, sudokuFormats.getRenderOptions())
+}
+
+// getRenderOptions returns options of the drawing based on the settings
+func (sudokuFormats *SudokuFormats) getRenderOptions() sudokuRenderer.RenderOptions {
+	return sudokuRenderer.RenderOptions{
+		Candidates: sudokuFormats.Settings.RenderCandidates,
+		Solution:   sudokuFormats.Settings.RenderSolution,
+	}
+}
@@ -0,0 +1,124 @@
+package sudokuFormats
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// MergeUnknownJsonFields writes sudoku as kangaroo JSON data, keeping fields of
+// the original JSON data that are not a part of sudoku data object (e.g. added by
+// other tools). Fields of boxes and cells are matched by their position in the
+// original data. Original data which is not kangaroo JSON data is ignored.
+func MergeUnknownJsonFields(originalData []byte, sudokuDto *models.SudokuDTO) ([]byte, error) {
+	data, err := json.MarshalIndent(sudokuDto, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if !detectJson(originalData) {
+		return data, nil
+	}
+
+	original, err := decodeJsonValue(originalData)
+	if err != nil {
+		return data, nil
+	}
+
+	updated, err := decodeJsonValue(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// data without unknown fields keeps order of sudoku data object fields
+	if !mergeUnknownJsonFields(original, updated, reflect.TypeOf(sudokuDto)) {
+		return data, nil
+	}
+
+	return json.MarshalIndent(updated, "", "  ")
+}
+
+// mergeUnknownJsonFields copies fields of the original JSON objects, which are not
+// fields of the data type, to the updated JSON objects. Returns true if any field
+// was copied.
+func mergeUnknownJsonFields(original any, updated any, dataType reflect.Type) bool {
+	for dataType.Kind() == reflect.Pointer {
+		dataType = dataType.Elem()
+	}
+
+	merged := false
+	switch dataType.Kind() {
+	case reflect.Struct:
+		originalObject, isOriginalObject := original.(map[string]any)
+		updatedObject, isUpdatedObject := updated.(map[string]any)
+		if !isOriginalObject || !isUpdatedObject {
+			return false
+		}
+
+		fields := getJsonFields(dataType)
+		for key, value := range originalObject {
+			fieldType, known := fields[key]
+			if !known {
+				updatedObject[key] = value
+				merged = true
+				continue
+			}
+
+			if updatedValue, ok := updatedObject[key]; ok {
+				merged = mergeUnknownJsonFields(value, updatedValue, fieldType) || merged
+			}
+		}
+
+	case reflect.Slice:
+		originalArray, isOriginalArray := original.([]any)
+		updatedArray, isUpdatedArray := updated.([]any)
+		if !isOriginalArray || !isUpdatedArray {
+			return false
+		}
+
+		for index := 0; index < len(originalArray) && index < len(updatedArray); index++ {
+			merged = mergeUnknownJsonFields(originalArray[index], updatedArray[index], dataType.Elem()) || merged
+		}
+	}
+
+	return merged
+}
+
+// getJsonFields returns types of struct fields by names of their JSON keys
+func getJsonFields(dataType reflect.Type) map[string]reflect.Type {
+	fields := map[string]reflect.Type{}
+	for _, field := range reflect.VisibleFields(dataType) {
+		if field.Anonymous || !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		if len(name) < 1 {
+			name = field.Name
+		}
+
+		fields[name] = field.Type
+	}
+
+	return fields
+}
+
+// decodeJsonValue decodes JSON data keeping numbers as they are written
+func decodeJsonValue(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,75 @@
+package sudokuFormats
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+func TestMergeUnknownJsonFields(t *testing.T) {
+	value := 5
+	sudokuDto := models.NewEmptySudokuDTO(2, 1, 1)
+	sudokuDto.Boxes[0].Cells[1].Value = &value
+
+	testCases := []struct {
+		name             string
+		originalData     string
+		expectedFields   []string
+		unexpectedFields []string
+	}{
+		{
+			name:             "Not a JSON object",
+			originalData:     "1...\n....\n",
+			unexpectedFields: []string{`"author"`},
+		},
+		{
+			name:             "Not a kangaroo JSON",
+			originalData:     `{"size": 4, "grid": [], "author": "Jane"}`,
+			unexpectedFields: []string{`"author"`, `"grid"`},
+		},
+		{
+			name:             "No unknown fields",
+			originalData:     `{"boxSize": 2, "comparisons": [], "boxes": [{"cells": [{"value": 1}]}]}`,
+			unexpectedFields: []string{`"comparisons"`},
+		},
+		{
+			name:         "Unknown fields of sudoku, box and cell",
+			originalData: `{"author": "Jane", "boxes": [{"color": "red", "cells": [{"note": 12345678901234567890}, {"value": 2}]}]}`,
+			expectedFields: []string{`"author": "Jane"`, `"color": "red"`,
+				`"note": 12345678901234567890`, `"value": 5`},
+		},
+		{
+			name:             "Unknown fields of missing box",
+			originalData:     `{"boxes": [{}, {"color": "red"}]}`,
+			unexpectedFields: []string{`"color"`},
+		},
+	}
+
+	for _, testCase := range testCases {
+		data, err := MergeUnknownJsonFields([]byte(testCase.originalData), sudokuDto)
+		if err != nil {
+			t.Errorf("%s: unexpected error - %s", testCase.name, err)
+			continue
+		}
+
+		mergedDto := &models.SudokuDTO{}
+		if err := json.Unmarshal(data, mergedDto); err != nil ||
+			*mergedDto.Boxes[0].Cells[1].Value != value || len(mergedDto.Boxes[0].Cells) != 4 {
+			t.Errorf("%s: merged data is not a valid sudoku", testCase.name)
+		}
+
+		for _, field := range testCase.expectedFields {
+			if !strings.Contains(string(data), field) {
+				t.Errorf("%s: merged data does not contain %s", testCase.name, field)
+			}
+		}
+
+		for _, field := range testCase.unexpectedFields {
+			if strings.Contains(string(data), field) {
+				t.Errorf("%s: merged data contains %s", testCase.name, field)
+			}
+		}
+	}
+}
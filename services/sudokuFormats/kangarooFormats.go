@@ -0,0 +1,115 @@
+package sudokuFormats
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/printer"
+)
+
+// detectJson checks if the data is a JSON object with sudoku boxes
+func detectJson(data []byte) bool {
+	return hasJsonKeys(data, "boxes")
+}
+
+// readJson reads sudoku from kangaroo JSON data
+func readJson(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	sudokuDto := &models.SudokuDTO{}
+	if err := json.Unmarshal(data, sudokuDto); err != nil {
+		return nil, err
+	}
+
+	if err := sudokuDto.ResolveSymbols(); err != nil {
+		return nil, err
+	}
+
+	return []*models.SudokuDTO{sudokuDto}, nil
+}
+
+// writeJson writes sudoku as kangaroo JSON data
+func writeJson(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	data, err := json.MarshalIndent(sudokuDto, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeData(writer, data)
+}
+
+// detectTxt checks if the data is a sudoku drawing
+func detectTxt(data []byte) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(data)), "╔")
+}
+
+// readTxt reads sudoku drawing, compact string or grid of values
+func (sudokuFormats *SudokuFormats) readTxt(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	sudokuDto, err := sudokuFormats.TextParser.ParseSudoku(string(data), alphabet)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*models.SudokuDTO{sudokuDto}, nil
+}
+
+// writeTxt writes sudoku drawing, the same as printed to the terminal
+func (sudokuFormats *SudokuFormats) writeTxt(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	buffer := &bytes.Buffer{}
+	txtPrinter := printer.NewTxtFilePrinter(buffer)
+	sudokuFormats.DataPrinter.PrintSudoku(sudokuDto.ToSudoku(), txtPrinter)
+	return writeData(writer, buffer.Bytes())
+}
+
+// detectBase64 checks if the data is base64 representation of sudoku binary data
+func (sudokuFormats *SudokuFormats) detectBase64(data []byte) bool {
+	_, err := sudokuFormats.readBase64(data, "")
+	return err == nil
+}
+
+// readBase64 reads sudoku from base64 (standard or URL-safe) representation
+// of sudoku binary data, the same as accepted by exec command
+func (sudokuFormats *SudokuFormats) readBase64(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	encodedData := strings.TrimSpace(string(data))
+	sudokuDto, err := sudokuFormats.BinaryManager.ReadFromBase64(encodedData)
+	if err != nil {
+		var urlErr error
+		sudokuDto, urlErr = sudokuFormats.BinaryManager.ReadFromBase64URL(encodedData)
+		if urlErr != nil {
+			return nil, err
+		}
+	}
+
+	return []*models.SudokuDTO{sudokuDto}, nil
+}
+
+// writeBase64 writes sudoku as standard base64 representation of sudoku binary data
+func (sudokuFormats *SudokuFormats) writeBase64(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	encodedData, err := sudokuFormats.BinaryManager.ToBase64(sudokuDto)
+	if err != nil {
+		return err
+	}
+
+	return writeData(writer, []byte(encodedData+"\n"))
+}
+
+// hasJsonKeys checks if the data is a JSON object with all provided keys
+func hasJsonKeys(data []byte, keys ...string) bool {
+	if !strings.HasPrefix(strings.TrimSpace(string(data)), "{") {
+		return false
+	}
+
+	object := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return false
+	}
+
+	for _, key := range keys {
+		if _, ok := object[key]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,192 @@
+package sudokuFormats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// detectSadMan checks if the data starts with SadMan Sudoku metadata
+// (e.g. '#A author') or puzzle section header
+func detectSadMan(data []byte) bool {
+	lines := getTextLines(data)
+	return len(lines) > 0 && (strings.HasPrefix(lines[0], "#") || lines[0] == "[Puzzle]")
+}
+
+// readSadMan reads SadMan Sudoku puzzle - metadata lines starting with '#'
+// and 9 lines of 9 values with '.' as blank. Puzzle can be placed in
+// '[Puzzle]' section, other sections (e.g. '[State]') are ignored.
+func (sudokuFormats *SudokuFormats) readSadMan(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	puzzleLines := []string{}
+	section := ""
+
+	for _, line := range getTextLines(data) {
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = line
+			continue
+		}
+
+		if !strings.HasPrefix(line, "#") && (len(section) < 1 || section == "[Puzzle]") {
+			puzzleLines = append(puzzleLines, line)
+		}
+	}
+
+	if len(puzzleLines) < 1 {
+		return nil, errors.New("there are no puzzle lines")
+	}
+
+	return sudokuFormats.readTxt([]byte(strings.Join(puzzleLines, "\n")), "")
+}
+
+// writeSadMan writes SadMan Sudoku puzzle, 9 lines of 9 values with '.' as blank
+func writeSadMan(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	values, err := getClassicValues(sudokuDto, classicBoxSize)
+	if err != nil {
+		return err
+	}
+
+	builder := &strings.Builder{}
+	for _, row := range values {
+		builder.WriteString(formatDigits(row, "."))
+		builder.WriteString("\n")
+	}
+
+	return writeData(writer, []byte(builder.String()))
+}
+
+// detectSadManMulti checks if the data consists of at least
+// two lines of 81 characters (one puzzle per line)
+func detectSadManMulti(data []byte) bool {
+	lines := getTextLines(data)
+	for _, line := range lines {
+		if len(line) != 81 {
+			return false
+		}
+	}
+
+	return len(lines) > 1
+}
+
+// readSadManMulti reads SadMan Sudoku collection of puzzles, one
+// puzzle per line written as 81 values with '0' or '.' as blank
+func (sudokuFormats *SudokuFormats) readSadManMulti(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	sudokus := []*models.SudokuDTO{}
+	for lineIndex, line := range getTextLines(data) {
+		if len(line) != 81 {
+			return nil, fmt.Errorf("line %d does not contain 81 values", lineIndex+1)
+		}
+
+		sudokuDto, err := sudokuFormats.TextParser.ParseSudoku(line, "")
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineIndex+1, err)
+		}
+
+		sudokus = append(sudokus, sudokuDto)
+	}
+
+	return sudokus, nil
+}
+
+// writeSadManMulti writes the sudoku as a line of SadMan Sudoku collection
+func writeSadManMulti(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	values, err := getClassicValues(sudokuDto, classicBoxSize)
+	if err != nil {
+		return err
+	}
+
+	builder := &strings.Builder{}
+	for _, row := range values {
+		builder.WriteString(formatDigits(row, "0"))
+	}
+
+	builder.WriteString("\n")
+	return writeData(writer, []byte(builder.String()))
+}
+
+// detectSimpleSudoku checks if the data contains values separated with '|'
+// and lines of '-' between rows of boxes
+func detectSimpleSudoku(data []byte) bool {
+	hasValuesSeparator, hasRowsSeparator := false, false
+	for _, line := range getTextLines(data) {
+		hasValuesSeparator = hasValuesSeparator || strings.Contains(line, "|")
+		hasRowsSeparator = hasRowsSeparator || isSimpleSudokuSeparator(line)
+	}
+
+	return hasValuesSeparator && hasRowsSeparator
+}
+
+// readSimpleSudoku reads Simple Sudoku puzzle, e.g. '2..|.1.|..9' lines
+// of values with '-----------' lines between rows of boxes
+func (sudokuFormats *SudokuFormats) readSimpleSudoku(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	rows := []string{}
+	for _, line := range getTextLines(data) {
+		if !isSimpleSudokuSeparator(line) {
+			rows = append(rows, strings.NewReplacer("|", "", "!", "").Replace(line))
+		}
+	}
+
+	return sudokuFormats.readTxt([]byte(strings.Join(rows, "\n")), "")
+}
+
+// writeSimpleSudoku writes Simple Sudoku puzzle with '.' as blank
+func writeSimpleSudoku(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	values, err := getClassicValues(sudokuDto, classicBoxSize)
+	if err != nil {
+		return err
+	}
+
+	builder := &strings.Builder{}
+	for rowIndex, row := range values {
+		if rowIndex > 0 && rowIndex%classicBoxSize == 0 {
+			builder.WriteString("-----------\n")
+		}
+
+		for boxIndex := 0; boxIndex < classicBoxSize; boxIndex++ {
+			if boxIndex > 0 {
+				builder.WriteString("|")
+			}
+
+			builder.WriteString(formatDigits(row[boxIndex*classicBoxSize:(boxIndex+1)*classicBoxSize], "."))
+		}
+
+		builder.WriteString("\n")
+	}
+
+	return writeData(writer, []byte(builder.String()))
+}
+
+// isSimpleSudokuSeparator checks if the line separates rows of boxes
+func isSimpleSudokuSeparator(line string) bool {
+	return len(line) > 0 && strings.Trim(line, "-+!") == ""
+}
+
+// getTextLines returns trimmed, non empty lines of the data
+func getTextLines(data []byte) []string {
+	lines := []string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	return lines
+}
+
+// formatDigits joins single digit values, empty cells are replaced with the blank
+func formatDigits(values []int, blank string) string {
+	builder := &strings.Builder{}
+	for _, value := range values {
+		if value == 0 {
+			builder.WriteString(blank)
+		} else {
+			builder.WriteString(strconv.Itoa(value))
+		}
+	}
+
+	return builder.String()
+}
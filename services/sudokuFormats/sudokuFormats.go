@@ -0,0 +1,310 @@
+package sudokuFormats
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// names of supported formats
+const (
+	JsonFormatName         = "json"
+	Base64FormatName       = "base64"
+	TxtFormatName          = "txt"
+	SadManFormatName       = "sdk"
+	SadManMultiFormatName  = "sdm"
+	SimpleSudokuFormatName = "ss"
+	HoDoKuFormatName       = "hodoku"
+	FPuzzlesFormatName     = "fpuzzles"
+	SvgFormatName          = "svg"
+	PngFormatName          = "png"
+	HtmlFormatName         = "html"
+)
+
+// Flags of sudoku data written in a format besides values of enabled boxes.
+// Data without a flag of the format is lost when the sudoku is written.
+const (
+	featureAlphabet byte = 1 << iota
+	featureThermometers
+	featureArrows
+	featureComparisons
+)
+
+const allFeatures = featureAlphabet | featureThermometers | featureArrows | featureComparisons
+
+// SudokuFormat describes a file format of sudoku data. Formats without
+// a reader can be used only as output, and without a writer only as input.
+// Image formats are rendered from the sudoku object, so givens can be told
+// apart from solved values.
+type SudokuFormat struct {
+	Name        string
+	Description string
+	Extensions  []string
+	features    byte
+	detect      func(data []byte) bool
+	read        func(data []byte, alphabet string) ([]*models.SudokuDTO, error)
+	write       func(writer io.Writer, sudokuDto *models.SudokuDTO) error
+	render      func(writer io.Writer, sudoku *models.Sudoku) error
+}
+
+// buildFormats returns all supported formats. Formats are detected in this order.
+func (sudokuFormats *SudokuFormats) buildFormats() []*SudokuFormat {
+	return []*SudokuFormat{
+		{
+			Name:        JsonFormatName,
+			Description: "Kangaroo JSON sudoku file",
+			Extensions:  []string{".json"},
+			features:    allFeatures,
+			detect:      detectJson,
+			read:        readJson,
+			write:       writeJson,
+		},
+		{
+			Name:        Base64FormatName,
+			Description: "Base64 representation of sudoku binary data, as used by exec command",
+			Extensions:  []string{".b64", ".base64"},
+			features:    allFeatures,
+			detect:      sudokuFormats.detectBase64,
+			read:        sudokuFormats.readBase64,
+			write:       sudokuFormats.writeBase64,
+		},
+		{
+			Name:        FPuzzlesFormatName,
+			Description: "f-puzzles JSON (grid, givens, thermometers and arrows)",
+			Extensions:  []string{".fpuzzles"},
+			features:    featureThermometers | featureArrows,
+			detect:      detectFPuzzles,
+			read:        readFPuzzles,
+			write:       writeFPuzzles,
+		},
+		{
+			Name:        TxtFormatName,
+			Description: "Sudoku drawing, compact string or grid of values",
+			Extensions:  []string{".txt"},
+			features:    featureComparisons,
+			detect:      detectTxt,
+			read:        sudokuFormats.readTxt,
+			write:       sudokuFormats.writeTxt,
+		},
+		{
+			Name:        HoDoKuFormatName,
+			Description: "HoDoKu candidates grid",
+			Extensions:  []string{".hodoku"},
+			detect:      detectHoDoKu,
+			read:        readHoDoKu,
+			write:       writeHoDoKu,
+		},
+		{
+			Name:        SimpleSudokuFormatName,
+			Description: "Simple Sudoku puzzle",
+			Extensions:  []string{".ss"},
+			detect:      detectSimpleSudoku,
+			read:        sudokuFormats.readSimpleSudoku,
+			write:       writeSimpleSudoku,
+		},
+		{
+			Name:        SadManMultiFormatName,
+			Description: "SadMan Sudoku collection of puzzles",
+			Extensions:  []string{".sdm"},
+			detect:      detectSadManMulti,
+			read:        sudokuFormats.readSadManMulti,
+			write:       writeSadManMulti,
+		},
+		{
+			Name:        SadManFormatName,
+			Description: "SadMan Sudoku puzzle",
+			Extensions:  []string{".sdk"},
+			detect:      detectSadMan,
+			read:        sudokuFormats.readSadMan,
+			write:       writeSadMan,
+		},
+		{
+			Name:        SvgFormatName,
+			Description: "SVG image of the sudoku (output only)",
+			Extensions:  []string{".svg"},
+			features:    allFeatures,
+			render:      sudokuFormats.renderSvg,
+		},
+		{
+			Name:        PngFormatName,
+			Description: "PNG image of the sudoku (output only)",
+			Extensions:  []string{".png"},
+			features:    allFeatures,
+			render:      sudokuFormats.renderPng,
+		},
+		{
+			Name:        HtmlFormatName,
+			Description: "HTML page where the sudoku can be played in a web browser (output only)",
+			Extensions:  []string{".html", ".htm"},
+			features:    allFeatures,
+			render:      sudokuFormats.renderHtml,
+		},
+	}
+}
+
+// GetFormats returns all supported formats
+func (sudokuFormats *SudokuFormats) GetFormats() []*SudokuFormat {
+	return sudokuFormats.formats
+}
+
+// GetFormatByName returns format with provided name, or nil if there is no such format
+func (sudokuFormats *SudokuFormats) GetFormatByName(name string) *SudokuFormat {
+	for _, format := range sudokuFormats.formats {
+		if format.Name == strings.ToLower(name) {
+			return format
+		}
+	}
+
+	return nil
+}
+
+// GetFormatByPath returns format matching extension of the file path,
+// or nil if the extension is not supported
+func (sudokuFormats *SudokuFormats) GetFormatByPath(path string) *SudokuFormat {
+	extension := strings.ToLower(filepath.Ext(path))
+	for _, format := range sudokuFormats.formats {
+		for _, formatExtension := range format.Extensions {
+			if formatExtension == extension {
+				return format
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetReadFormat returns format to read the file data with. Format is detected
+// from the content first, then from the extension of the file path. Data that
+// does not match any format is read as text.
+func (sudokuFormats *SudokuFormats) GetReadFormat(path string, data []byte) *SudokuFormat {
+	for _, format := range sudokuFormats.formats {
+		if format.CanRead() && format.detect != nil && format.detect(data) {
+			return format
+		}
+	}
+
+	format := sudokuFormats.GetFormatByPath(path)
+	if format != nil && format.CanRead() {
+		return format
+	}
+
+	return sudokuFormats.GetFormatByName(TxtFormatName)
+}
+
+// CanRead checks if sudoku can be read from data of the format
+func (format *SudokuFormat) CanRead() bool {
+	return format.read != nil
+}
+
+// CanWrite checks if sudoku can be written in the format
+func (format *SudokuFormat) CanWrite() bool {
+	return format.write != nil || format.render != nil
+}
+
+// GetLostInformation returns descriptions of sudoku data which is
+// not written in the format, empty if the format keeps all the data
+func (format *SudokuFormat) GetLostInformation(sudokuDto *models.SudokuDTO) []string {
+	lost := []string{}
+
+	if format.features&featureAlphabet == 0 && len(sudokuDto.Alphabet) > 0 {
+		lost = append(lost, fmt.Sprintf("alphabet '%s'", sudokuDto.Alphabet))
+	}
+
+	if format.features&featureThermometers == 0 && len(sudokuDto.Thermometers) > 0 {
+		lost = append(lost, fmt.Sprintf("%d thermometer(s)", len(sudokuDto.Thermometers)))
+	}
+
+	if format.features&featureArrows == 0 && len(sudokuDto.Arrows) > 0 {
+		lost = append(lost, fmt.Sprintf("%d arrow(s)", len(sudokuDto.Arrows)))
+	}
+
+	if format.features&featureComparisons == 0 && len(sudokuDto.Comparisons) > 0 {
+		lost = append(lost, fmt.Sprintf("%d comparison(s)", len(sudokuDto.Comparisons)))
+	}
+
+	return lost
+}
+
+// Read reads all sudokus from the data. Returns an error
+// of invalid input kind if the data is not valid.
+func (format *SudokuFormat) Read(data []byte, alphabet string) ([]*models.SudokuDTO, error) {
+	if !format.CanRead() {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("%s format can not be read", format.Name))
+	}
+
+	sudokus, err := format.read(data, alphabet)
+	if err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("invalid %s data: %w", format.Name, err))
+	}
+
+	if len(sudokus) < 1 {
+		return nil, models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("there is no sudoku in %s data", format.Name))
+	}
+
+	return sudokus, nil
+}
+
+// Write writes the sudoku to the writer. Returns an error of invalid
+// input kind if the sudoku can not be represented in the format.
+func (format *SudokuFormat) Write(writer io.Writer, sudokuDto *models.SudokuDTO) error {
+	if !format.CanWrite() {
+		return models.WithKind(models.ErrInvalidInput,
+			fmt.Errorf("%s format can not be written", format.Name))
+	}
+
+	var err error
+	if format.render != nil {
+		err = format.render(writer, sudokuDto.ToSudoku())
+	} else {
+		err = format.write(writer, sudokuDto)
+	}
+
+	if err != nil {
+		return models.WithKind(getErrorKind(err),
+			fmt.Errorf("failed to write %s data: %w", format.Name, err))
+	}
+
+	return nil
+}
+
+// WriteSudoku writes the sudoku object to the writer. Image formats use
+// the object directly, other formats write the sudoku converted to DTO.
+func (format *SudokuFormat) WriteSudoku(writer io.Writer, sudoku *models.Sudoku) error {
+	if format.render == nil {
+		return format.Write(writer, sudoku.ToSudokuDto())
+	}
+
+	err := format.render(writer, sudoku)
+	if err != nil {
+		return models.WithKind(getErrorKind(err),
+			fmt.Errorf("failed to write %s data: %w", format.Name, err))
+	}
+
+	return nil
+}
+
+// getErrorKind returns kind of the error, input is invalid
+// unless the error is already of a specific kind
+func getErrorKind(err error) error {
+	if errors.Is(err, models.ErrIO) {
+		return models.ErrIO
+	}
+
+	return models.ErrInvalidInput
+}
+
+// writeData writes the data to the writer, failure is an error of I/O kind
+func writeData(writer io.Writer, data []byte) error {
+	if _, err := writer.Write(data); err != nil {
+		return models.WithKind(models.ErrIO, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package sudokuFormats
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/binarySudokuManager"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/sudokuRenderer"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+const testPuzzle = ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3"
+
+func TestGetReadFormat(t *testing.T) {
+	formats := newTestSudokuFormats()
+	puzzle, err := newTestPuzzle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encodedPuzzle, err := binarySudokuManager.GetNewBinarySudokuManager(
+		testHelpers.GetTestSettings()).ToBase64URL(puzzle)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name           string
+		path           string
+		data           string
+		expectedFormat string
+	}{
+		{
+			name:           "Kangaroo JSON",
+			path:           "sudoku.txt",
+			data:           `{"boxSize": 3, "boxes": []}`,
+			expectedFormat: JsonFormatName,
+		},
+		{
+			name:           "f-puzzles JSON",
+			path:           "sudoku.json",
+			data:           `{"size": 9, "grid": []}`,
+			expectedFormat: FPuzzlesFormatName,
+		},
+		{
+			name:           "Invalid JSON by extension",
+			path:           "sudoku.json",
+			data:           `{"boxSize": 3`,
+			expectedFormat: JsonFormatName,
+		},
+		{
+			name:           "Base64 sudoku data",
+			path:           "sudoku.txt",
+			data:           "\n" + encodedPuzzle + "\n",
+			expectedFormat: Base64FormatName,
+		},
+		{
+			name:           "Sudoku drawing",
+			path:           "sudoku",
+			data:           "\n╔═══╦═══╗\n",
+			expectedFormat: TxtFormatName,
+		},
+		{
+			name:           "HoDoKu grid",
+			path:           "sudoku.txt",
+			data:           ".-----.-----.\n| 1  2 | 34  4 |\n",
+			expectedFormat: HoDoKuFormatName,
+		},
+		{
+			name:           "Simple Sudoku",
+			path:           "sudoku",
+			data:           "1..|...|...\n-----------\n",
+			expectedFormat: SimpleSudokuFormatName,
+		},
+		{
+			name:           "SadMan Sudoku collection",
+			path:           "sudoku.txt",
+			data:           testPuzzle + "\n" + testPuzzle + "\n",
+			expectedFormat: SadManMultiFormatName,
+		},
+		{
+			name:           "SadMan Sudoku metadata",
+			path:           "sudoku.txt",
+			data:           "#A author\n" + testPuzzle,
+			expectedFormat: SadManFormatName,
+		},
+		{
+			name:           "Extension of ambiguous content",
+			path:           "sudoku.SDK",
+			data:           testPuzzle,
+			expectedFormat: SadManFormatName,
+		},
+		{
+			name:           "Unknown extension",
+			path:           "sudoku.puzzle",
+			data:           testPuzzle,
+			expectedFormat: TxtFormatName,
+		},
+	}
+
+	for _, testCase := range testCases {
+		format := formats.GetReadFormat(testCase.path, []byte(testCase.data))
+		if format.Name != testCase.expectedFormat {
+			t.Errorf("%s: Expected format %s, got %s", testCase.name, testCase.expectedFormat, format.Name)
+		}
+	}
+}
+
+func TestSudokuFormats_RoundTrip(t *testing.T) {
+	formats := newTestSudokuFormats()
+	puzzle, err := newTestPuzzle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, format := range formats.GetFormats() {
+		// image formats are output only
+		if !format.CanRead() {
+			continue
+		}
+
+		buffer := &bytes.Buffer{}
+		if err := format.Write(buffer, puzzle); err != nil {
+			t.Errorf("%s: Unexpected write error %s", format.Name, err)
+			continue
+		}
+
+		sudokus, err := format.Read(buffer.Bytes(), "")
+		if err != nil {
+			t.Errorf("%s: Unexpected read error %s", format.Name, err)
+			continue
+		}
+
+		// single puzzle of SadMan formats is a plain grid or compact string
+		detected := formats.GetReadFormat("sudoku", buffer.Bytes())
+		if detected != format && format.Name != SadManFormatName && format.Name != SadManMultiFormatName {
+			t.Errorf("%s: Written data detected as %s format", format.Name, detected.Name)
+		}
+
+		// HoDoKu cells with a single candidate are read as values
+		values, err := getClassicValues(sudokus[0])
+		if format.Name == HoDoKuFormatName && err == nil {
+			expectedValues, _ := getClassicValues(puzzle)
+			for row := range values {
+				for column := range values[row] {
+					candidates := getCandidates(expectedValues, row, column)
+					if expectedValues[row][column] == 0 && len(candidates) == 1 && values[row][column] == candidates[0] {
+						values[row][column] = 0
+					}
+				}
+			}
+		}
+
+		if err != nil || formatValues(values) != strings.ReplaceAll(testPuzzle, ".", "0") {
+			t.Errorf("%s: Sudoku read from\n%s\ndiffers from the written one (%v)",
+				format.Name, buffer.String(), err)
+		}
+	}
+}
+
+func TestSudokuFormats_Images(t *testing.T) {
+	formats := newTestSudokuFormats()
+	puzzle, err := newTestPuzzle()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := formats.GetFormatByName(SvgFormatName).WriteSudoku(buffer, puzzle.ToSudoku()); err != nil ||
+		!strings.HasPrefix(buffer.String(), "<svg") {
+		t.Errorf("svg: Expected SVG image, got error %v", err)
+	}
+
+	buffer = &bytes.Buffer{}
+	if err := formats.GetFormatByName(PngFormatName).Write(buffer, puzzle); err != nil {
+		t.Errorf("png: Unexpected write error %s", err)
+	} else if _, err := png.Decode(buffer); err != nil {
+		t.Errorf("png: Written data is not a PNG image (%s)", err)
+	}
+
+	if _, err := formats.GetFormatByName(PngFormatName).Read(buffer.Bytes(), ""); !errors.Is(err, models.ErrInvalidInput) {
+		t.Errorf("png: Expected invalid input error on read, got %v", err)
+	}
+
+	buffer = &bytes.Buffer{}
+	if err := formats.GetFormatByPath("puzzle.htm").Write(buffer, puzzle); err != nil ||
+		!strings.HasPrefix(buffer.String(), "<!DOCTYPE html>") {
+		t.Errorf("html: Expected HTML page, got error %v", err)
+	}
+}
+
+func TestSudokuFormats_Errors(t *testing.T) {
+	samurai := models.NewEmptySudokuDTO(3, 7, 7)
+	bigSudoku := models.NewEmptySudokuDTO(4, 4, 4)
+
+	testCases := []struct {
+		name   string
+		format string
+		data   string
+		sudoku *models.SudokuDTO
+	}{
+		{name: "SDK without puzzle", format: SadManFormatName, data: "#A author\n[State]\n" + testPuzzle},
+		{name: "SDM invalid line", format: SadManMultiFormatName, data: testPuzzle + "\n123\n"},
+		{name: "SS invalid value", format: SimpleSudokuFormatName, data: strings.Repeat("x..|...|...\n", 9)},
+		{name: "JSON invalid symbols", format: JsonFormatName, data: `{"boxSize": 2, "alphabet": "A-D", "boxes": [{"cells": [{"symbol": "E"}]}]}`},
+		{name: "SDK samurai", format: SadManFormatName, sudoku: samurai},
+		{name: "SDM big sudoku", format: SadManMultiFormatName, sudoku: bigSudoku},
+		{name: "SS big sudoku", format: SimpleSudokuFormatName, sudoku: bigSudoku},
+		{name: "HoDoKu samurai", format: HoDoKuFormatName, sudoku: samurai},
+	}
+
+	formats := newTestSudokuFormats()
+
+	for _, testCase := range testCases {
+		format := formats.GetFormatByName(testCase.format)
+
+		var err error
+		if testCase.sudoku != nil {
+			err = format.Write(&bytes.Buffer{}, testCase.sudoku)
+		} else {
+			_, err = format.Read([]byte(testCase.data), "")
+		}
+
+		if !errors.Is(err, models.ErrInvalidInput) {
+			t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+		}
+	}
+}
+
+func TestGetLostInformation(t *testing.T) {
+	sudokuDto := models.NewEmptySudokuDTO(3, 3, 3)
+	sudokuDto.Alphabet = "A-I"
+	sudokuDto.Thermometers = models.GenericSlice[*models.SudokuThermometerDTO]{{}, {}}
+	sudokuDto.Arrows = models.GenericSlice[*models.SudokuArrowDTO]{{}}
+	sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{{}, {}, {}}
+
+	testCases := []struct {
+		format       string
+		sudoku       *models.SudokuDTO
+		expectedLost []string
+	}{
+		{format: JsonFormatName, sudoku: sudokuDto, expectedLost: []string{}},
+		{format: Base64FormatName, sudoku: sudokuDto, expectedLost: []string{}},
+		{format: TxtFormatName, sudoku: sudokuDto, expectedLost: []string{"alphabet 'A-I'", "2 thermometer(s)", "1 arrow(s)"}},
+		{format: FPuzzlesFormatName, sudoku: sudokuDto, expectedLost: []string{"alphabet 'A-I'", "3 comparison(s)"}},
+		{format: SadManFormatName, sudoku: sudokuDto, expectedLost: []string{
+			"alphabet 'A-I'", "2 thermometer(s)", "1 arrow(s)", "3 comparison(s)"}},
+		{format: SadManFormatName, sudoku: models.NewEmptySudokuDTO(3, 3, 3), expectedLost: []string{}},
+	}
+
+	formats := newTestSudokuFormats()
+
+	for _, testCase := range testCases {
+		lost := formats.GetFormatByName(testCase.format).GetLostInformation(testCase.sudoku)
+		if strings.Join(lost, ", ") != strings.Join(testCase.expectedLost, ", ") {
+			t.Errorf("%s: Expected lost information %v, got %v", testCase.format, testCase.expectedLost, lost)
+		}
+	}
+}
+
+func newTestSudokuFormats() ISudokuFormats {
+	settings := testHelpers.GetTestSettings()
+	return GetNewSudokuFormats(settings, textSudokuParser.GetNewTextSudokuParser(settings),
+		dataPrinters.GetNewDataPrinter(settings, testHelpers.NewTestPrinter()),
+		binarySudokuManager.GetNewBinarySudokuManager(settings),
+		sudokuRenderer.GetNewSudokuRenderer(settings))
+}
+
+func newTestPuzzle() (*models.SudokuDTO, error) {
+	values := [][]int{}
+	for row := 0; row < 9; row++ {
+		values = append(values, []int{})
+		for _, symbol := range testPuzzle[row*9 : (row+1)*9] {
+			value := 0
+			if symbol != '.' {
+				value = int(symbol - '0')
+			}
+
+			values[row] = append(values[row], value)
+		}
+	}
+
+	return newClassicSudoku(values)
+}
+
+func formatValues(values [][]int) string {
+	builder := &strings.Builder{}
+	for _, row := range values {
+		builder.WriteString(formatDigits(row, "0"))
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,27 @@
+package sudokuGenerator
+
+import (
+	"context"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+)
+
+type SudokuGenerator struct {
+	Settings   *models.Settings
+	SudokuInit sudokuInit.ISudokuInit
+}
+
+type ISudokuGenerator interface {
+	Generate(ctx context.Context, request *models.GenerateSudokuRequest) (
+		puzzle *models.SudokuDTO, solution *models.SudokuDTO, err error)
+	FindSolutions(ctx context.Context, sudoku *models.Sudoku, limit int,
+		onSolution func(solution *models.SudokuDTO) bool) (int, error)
+}
+
+func GetNewSudokuGenerator(settings *models.Settings, sudokuInit sudokuInit.ISudokuInit) ISudokuGenerator {
+	return &SudokuGenerator{
+		Settings:   settings,
+		SudokuInit: sudokuInit,
+	}
+}
@@ -0,0 +1,88 @@
+package sudokuGenerator
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Generate creates a sudoku puzzle with unique solution. First, random solution of
+// an empty sudoku is found. Then values are removed from cells in random order, as
+// long as the puzzle has single solution. Returns the puzzle and its solution.
+func (generator *SudokuGenerator) Generate(ctx context.Context, request *models.GenerateSudokuRequest) (
+	*models.SudokuDTO, *models.SudokuDTO, error) {
+
+	random := rand.New(rand.NewSource(request.Seed))
+
+	sudoku, err := generator.initializeSudoku(models.NewEmptySudokuDTO(
+		request.BoxSize, request.LayoutWidth, request.LayoutHeight))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var solution *models.SudokuDTO
+	search := newSolutionsSearch(ctx, sudoku, 1, func(solvedSudoku *models.Sudoku) bool {
+		solution = solvedSudoku.ToSudokuDto()
+		return false
+	})
+	search.shuffle = func(values []int) {
+		random.Shuffle(len(values), func(i, j int) {
+			values[i], values[j] = values[j], values[i]
+		})
+	}
+
+	_, err = search.run()
+	if err != nil {
+		return nil, nil, models.WithKind(models.ErrSolverFailure, err)
+	}
+
+	if solution == nil {
+		return nil, nil, models.WithKind(models.ErrUnsolvable,
+			errors.New("failed to find any solution of empty sudoku with requested layout"))
+	}
+
+	puzzle, err := generator.initializeSudoku(solution)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cells := []*models.SudokuCell{}
+	for _, box := range puzzle.Boxes {
+		cells = append(cells, box.Cells...)
+	}
+
+	random.Shuffle(len(cells), func(i, j int) {
+		cells[i], cells[j] = cells[j], cells[i]
+	})
+
+	for _, cell := range cells {
+		value := cell.Value
+		cell.Value = nil
+
+		solutionsCount, err := newSolutionsSearch(ctx, puzzle, 2, func(*models.Sudoku) bool {
+			return true
+		}).run()
+		if err != nil {
+			return nil, nil, models.WithKind(models.ErrSolverFailure, err)
+		}
+
+		if solutionsCount != 1 {
+			cell.Value = value
+		}
+	}
+
+	return puzzle.ToSudokuDto(), solution, nil
+}
+
+// initializeSudoku creates sudoku object from the DTO and initializes it
+func (generator *SudokuGenerator) initializeSudoku(sudokuDto *models.SudokuDTO) (*models.Sudoku, error) {
+	sudoku := sudokuDto.ToSudoku()
+	_, errs := generator.SudokuInit.InitializeSudoku(sudoku)
+	if len(errs) >= 1 {
+		return nil, models.WithKind(models.ErrInvalidConfiguration, errors.Join(errs...))
+	}
+
+	return sudoku, nil
+}
@@ -0,0 +1,197 @@
+package sudokuGenerator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestGenerate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		request *models.GenerateSudokuRequest
+	}{
+		{
+			name:    "Classic sudoku",
+			request: &models.GenerateSudokuRequest{BoxSize: 3, LayoutWidth: 3, LayoutHeight: 3, Seed: 1},
+		},
+		{
+			name:    "Small box size",
+			request: &models.GenerateSudokuRequest{BoxSize: 2, LayoutWidth: 2, LayoutHeight: 2, Seed: 2},
+		},
+		{
+			name:    "Overlapping sub-sudokus",
+			request: &models.GenerateSudokuRequest{BoxSize: 2, LayoutWidth: 3, LayoutHeight: 2, Seed: 3},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		generator := GetNewSudokuGenerator(settings, sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()))
+
+		puzzle, solution, err := generator.Generate(context.Background(), testCase.request)
+		if err != nil {
+			t.Errorf("%s: Unexpected error: %s", testCase.name, err)
+			continue
+		}
+
+		puzzleSudoku := puzzle.ToSudoku()
+		_, errs := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()).InitializeSudoku(puzzleSudoku)
+		if len(errs) >= 1 {
+			t.Errorf("%s: Generated puzzle is invalid: %v", testCase.name, errs)
+			continue
+		}
+
+		solutions := []*models.SudokuDTO{}
+		count, err := generator.FindSolutions(context.Background(), puzzleSudoku, 0,
+			func(found *models.SudokuDTO) bool {
+				solutions = append(solutions, found)
+				return true
+			})
+
+		if err != nil || count != 1 {
+			t.Errorf("%s: Generated puzzle should have single solution, found %d (%v)",
+				testCase.name, count, err)
+			continue
+		}
+
+		if !hasSameValues(solutions[0], solution) {
+			t.Errorf("%s: Found solution is different than generated one", testCase.name)
+		}
+
+		repeatedPuzzle, _, _ := generator.Generate(context.Background(), testCase.request)
+		if !hasSameValues(puzzle, repeatedPuzzle) {
+			t.Errorf("%s: The same seed should result in the same puzzle", testCase.name)
+		}
+	}
+}
+
+func TestGenerate_InvalidConfiguration(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	generator := GetNewSudokuGenerator(settings, sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()))
+
+	_, _, err := generator.Generate(context.Background(),
+		&models.GenerateSudokuRequest{BoxSize: 3, LayoutWidth: 2, LayoutHeight: 3})
+
+	if !errors.Is(err, models.ErrInvalidConfiguration) {
+		t.Errorf("Expected invalid configuration error, got %v", err)
+	}
+}
+
+func TestFindSolutions(t *testing.T) {
+	testCases := []struct {
+		name          string
+		filePath      string
+		removedValues int
+		limit         int
+		expectedCount int
+	}{
+		{
+			name:          "Unique solution",
+			filePath:      "../../testConfigs/simple1.json",
+			expectedCount: 1,
+		},
+		{
+			name:          "Variant constraints",
+			filePath:      "../../testConfigs/thermoArrow1.json",
+			expectedCount: 1,
+		},
+		{
+			name:          "Multiple solutions with limit",
+			filePath:      "../../testConfigs/simple1.json",
+			removedValues: 30,
+			limit:         5,
+			expectedCount: 5,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		generator := GetNewSudokuGenerator(settings, sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()))
+
+		sudokuDto := readSudokuDto(t, testCase.filePath)
+		removed := 0
+		for _, box := range sudokuDto.Boxes {
+			for _, cell := range box.Cells {
+				if removed < testCase.removedValues && cell.Value != nil {
+					cell.Value = nil
+					removed++
+				}
+			}
+		}
+
+		sudoku := sudokuDto.ToSudoku()
+		sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()).InitializeSudoku(sudoku)
+
+		count, err := generator.FindSolutions(context.Background(), sudoku, testCase.limit,
+			func(*models.SudokuDTO) bool { return true })
+
+		if err != nil || count != testCase.expectedCount {
+			t.Errorf("%s: Expected %d solutions, found %d (%v)",
+				testCase.name, testCase.expectedCount, count, err)
+		}
+
+		if !hasSameValues(sudokuDto, sudoku.ToSudokuDto()) {
+			t.Errorf("%s: Sudoku values should not be changed by the search", testCase.name)
+		}
+	}
+}
+
+func TestFindSolutions_Cancelled(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	generator := GetNewSudokuGenerator(settings, sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()))
+
+	sudoku := models.NewEmptySudokuDTO(3, 3, 3).ToSudoku()
+	sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger()).InitializeSudoku(sudoku)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := generator.FindSolutions(ctx, sudoku, 0, func(*models.SudokuDTO) bool { return true })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected search to be cancelled, got %v", err)
+	}
+}
+
+func readSudokuDto(t *testing.T, path string) *models.SudokuDTO {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sudokuDto := &models.SudokuDTO{}
+	err = json.Unmarshal(data, sudokuDto)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sudokuDto
+}
+
+func hasSameValues(first *models.SudokuDTO, second *models.SudokuDTO) bool {
+	if len(first.Boxes) != len(second.Boxes) {
+		return false
+	}
+
+	for boxIndex, box := range first.Boxes {
+		for cellIndex, cell := range box.Cells {
+			otherCell := second.Boxes[boxIndex].Cells[cellIndex]
+			if (cell.Value == nil) != (otherCell.Value == nil) {
+				return false
+			}
+
+			if cell.Value != nil && *cell.Value != *otherCell.Value {
+				return false
+			}
+		}
+	}
+
+	return true
+}
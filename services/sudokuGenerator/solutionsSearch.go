@@ -0,0 +1,229 @@
+package sudokuGenerator
+
+import (
+	"context"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// amount of visited search nodes between checks of context cancellation
+const cancellationCheckInterval = 1024
+
+// searchCell is an empty cell of searched sudoku with cells that cannot have
+// the same value (peers) and additional constraints the cell is a part of
+type searchCell struct {
+	cell        *models.SudokuCell
+	peers       []*models.SudokuCell
+	constraints []func() bool
+}
+
+// solutionsSearch finds solutions of a sudoku with backtracking, every time
+// filling the empty cell with the lowest amount of possible values
+type solutionsSearch struct {
+	ctx          context.Context
+	sudoku       *models.Sudoku
+	cells        []*searchCell
+	maximumValue int
+	limit        int
+	found        int
+	visitedNodes int
+	err          error
+	shuffle      func(values []int)
+	onSolution   func(sudoku *models.Sudoku) bool
+}
+
+// FindSolutions finds solutions of initialized sudoku, respecting additional constraints
+// (thermometers, arrows, comparisons). Every found solution is passed to onSolution
+// function, which can stop the search by returning false. Search is also stopped when
+// the limit of solutions is reached (0 means no limit) or the context is done.
+// Returns amount of found solutions. Sudoku values are not changed.
+func (generator *SudokuGenerator) FindSolutions(ctx context.Context, sudoku *models.Sudoku, limit int,
+	onSolution func(solution *models.SudokuDTO) bool) (int, error) {
+
+	search := newSolutionsSearch(ctx, sudoku, limit, func(solvedSudoku *models.Sudoku) bool {
+		return onSolution(solvedSudoku.ToSudokuDto())
+	})
+
+	return search.run()
+}
+
+// newSolutionsSearch prepares search of solutions of initialized sudoku
+func newSolutionsSearch(ctx context.Context, sudoku *models.Sudoku, limit int,
+	onSolution func(sudoku *models.Sudoku) bool) *solutionsSearch {
+
+	search := &solutionsSearch{
+		ctx:          ctx,
+		sudoku:       sudoku,
+		cells:        []*searchCell{},
+		maximumValue: int(sudoku.BoxSize) * int(sudoku.BoxSize),
+		limit:        limit,
+		onSolution:   onSolution,
+	}
+
+	constraints := map[*models.SudokuCell][]func() bool{}
+	addConstraint := func(check func() bool, cells ...*models.SudokuCell) {
+		for _, cell := range cells {
+			constraints[cell] = append(constraints[cell], check)
+		}
+	}
+
+	for _, thermometer := range sudoku.Thermometers {
+		addConstraint(thermometer.HasRuleViolation, thermometer.Cells...)
+	}
+
+	for _, arrow := range sudoku.Arrows {
+		addConstraint(arrow.HasRuleViolation, append([]*models.SudokuCell{arrow.Circle}, arrow.Cells...)...)
+	}
+
+	for _, comparison := range sudoku.Comparisons {
+		addConstraint(comparison.HasRuleViolation, comparison.Greater, comparison.Lesser)
+	}
+
+	for _, box := range sudoku.Boxes {
+		if box.Disabled {
+			continue
+		}
+
+		for _, cell := range box.Cells {
+			if cell.Value != nil {
+				continue
+			}
+
+			search.cells = append(search.cells, &searchCell{
+				cell:        cell,
+				peers:       getCellPeers(cell),
+				constraints: constraints[cell],
+			})
+		}
+	}
+
+	return search
+}
+
+// getCellPeers returns all cells of the box and lines of the cell, without the cell itself
+func getCellPeers(cell *models.SudokuCell) []*models.SudokuCell {
+	peers := []*models.SudokuCell{}
+	added := map[*models.SudokuCell]bool{cell: true}
+
+	addPeers := func(cells []*models.SudokuCell) {
+		for _, peer := range cells {
+			if !added[peer] {
+				added[peer] = true
+				peers = append(peers, peer)
+			}
+		}
+	}
+
+	if cell.Box != nil {
+		addPeers(cell.Box.Cells)
+	}
+
+	for _, line := range cell.MemberOfLines {
+		addPeers(line.Cells)
+	}
+
+	return peers
+}
+
+// run executes the search and returns amount of found solutions
+func (search *solutionsSearch) run() (int, error) {
+	search.searchNextCell()
+	return search.found, search.err
+}
+
+// searchNextCell fills the most constrained empty cell with all possible values one
+// by one and continues the search recursively. Returns true if the search is stopped.
+func (search *solutionsSearch) searchNextCell() bool {
+	search.visitedNodes++
+	if search.visitedNodes%cancellationCheckInterval == 0 && search.ctx.Err() != nil {
+		search.err = search.ctx.Err()
+		return true
+	}
+
+	var nextCell *searchCell
+	var nextCellValues []int
+
+	for _, cell := range search.cells {
+		if cell.cell.Value != nil {
+			continue
+		}
+
+		values := search.getPossibleValues(cell)
+		if len(values) < 1 {
+			return false
+		}
+
+		if nextCell == nil || len(values) < len(nextCellValues) {
+			nextCell = cell
+			nextCellValues = values
+		}
+	}
+
+	if nextCell == nil {
+		search.found++
+		return !search.onSolution(search.sudoku) || (search.limit > 0 && search.found >= search.limit)
+	}
+
+	if search.shuffle != nil {
+		search.shuffle(nextCellValues)
+	}
+
+	defer func() {
+		nextCell.cell.Value = nil
+	}()
+
+	for _, value := range nextCellValues {
+		cellValue := value
+		nextCell.cell.Value = &cellValue
+
+		if search.searchNextCell() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getPossibleValues returns values not used by any of the peers of the cell,
+// which do not break additional constraints of the cell
+func (search *solutionsSearch) getPossibleValues(cell *searchCell) []int {
+	usedValues := make([]bool, search.maximumValue+1)
+	for _, peer := range cell.peers {
+		if peer.Value != nil && *peer.Value >= 1 && *peer.Value <= search.maximumValue {
+			usedValues[*peer.Value] = true
+		}
+	}
+
+	values := []int{}
+	for value := 1; value <= search.maximumValue; value++ {
+		if usedValues[value] {
+			continue
+		}
+
+		if len(cell.constraints) >= 1 {
+			cellValue := value
+			cell.cell.Value = &cellValue
+			violated := cell.violatesConstraints()
+			cell.cell.Value = nil
+
+			if violated {
+				continue
+			}
+		}
+
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// violatesConstraints checks if any additional constraint of the cell is broken
+func (cell *searchCell) violatesConstraints() bool {
+	for _, violated := range cell.constraints {
+		if violated() {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,19 @@
+package sudokuGrader
+
+import (
+	"github.com/Michu8258/kangaroo/models"
+)
+
+type SudokuGrader struct {
+	Settings *models.Settings
+}
+
+type ISudokuGrader interface {
+	Grade(sudoku *models.Sudoku) models.SudokuDifficulty
+}
+
+func GetNewSudokuGrader(settings *models.Settings) ISudokuGrader {
+	return &SudokuGrader{
+		Settings: settings,
+	}
+}
@@ -0,0 +1,143 @@
+package sudokuGrader
+
+import (
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// gradingState keeps values placed while grading, so the graded sudoku is not changed
+type gradingState struct {
+	values       map[*models.SudokuCell]int
+	houses       [][]*models.SudokuCell
+	cellHouses   map[*models.SudokuCell][][]*models.SudokuCell
+	maximumValue int
+}
+
+// Grade rates difficulty of initialized sudoku by techniques a person needs to
+// solve it. Hidden singles are tried first, naked singles when there are no hidden
+// singles. Sudoku which can not be solved with singles is hard. Variant constraints
+// are not used, so sudokus relying on them are rated as hard.
+func (grader *SudokuGrader) Grade(sudoku *models.Sudoku) models.SudokuDifficulty {
+	state := newGradingState(sudoku)
+	difficulty := models.EasyDifficulty
+
+	for !state.isSolved() {
+		if state.placeHiddenSingles() {
+			continue
+		}
+
+		if state.placeNakedSingles() {
+			difficulty = models.MediumDifficulty
+			continue
+		}
+
+		return models.HardDifficulty
+	}
+
+	return difficulty
+}
+
+// newGradingState collects houses of the sudoku - enabled boxes and lines
+// of all sub-sudokus - and values of the cells
+func newGradingState(sudoku *models.Sudoku) *gradingState {
+	state := &gradingState{
+		values:       map[*models.SudokuCell]int{},
+		cellHouses:   map[*models.SudokuCell][][]*models.SudokuCell{},
+		maximumValue: int(sudoku.BoxSize) * int(sudoku.BoxSize),
+	}
+
+	for _, box := range sudoku.Boxes {
+		if !box.Disabled {
+			state.addHouse(box.Cells)
+		}
+	}
+
+	for _, subSudoku := range sudoku.SubSudokus {
+		for _, line := range subSudoku.ChildLines {
+			state.addHouse(line.Cells)
+		}
+	}
+
+	return state
+}
+
+// addHouse registers cells which have to contain different values
+func (state *gradingState) addHouse(cells []*models.SudokuCell) {
+	state.houses = append(state.houses, cells)
+	for _, cell := range cells {
+		state.cellHouses[cell] = append(state.cellHouses[cell], cells)
+		if cell.Value != nil {
+			state.values[cell] = *cell.Value
+		}
+	}
+}
+
+// isSolved checks if all cells have values
+func (state *gradingState) isSolved() bool {
+	return len(state.values) == len(state.cellHouses)
+}
+
+// getCandidates returns values not used in houses of the cell
+func (state *gradingState) getCandidates(cell *models.SudokuCell) []int {
+	used := make([]bool, state.maximumValue+1)
+	for _, house := range state.cellHouses[cell] {
+		for _, otherCell := range house {
+			if value, ok := state.values[otherCell]; ok && value <= state.maximumValue {
+				used[value] = true
+			}
+		}
+	}
+
+	candidates := []int{}
+	for value := 1; value <= state.maximumValue; value++ {
+		if !used[value] {
+			candidates = append(candidates, value)
+		}
+	}
+
+	return candidates
+}
+
+// placeHiddenSingles places values which fit only one cell of a house.
+// Returns true if at least one value was placed.
+func (state *gradingState) placeHiddenSingles() bool {
+	placed := false
+	for _, house := range state.houses {
+		cellsByValue := map[int][]*models.SudokuCell{}
+		for _, cell := range house {
+			if _, ok := state.values[cell]; ok {
+				continue
+			}
+
+			for _, candidate := range state.getCandidates(cell) {
+				cellsByValue[candidate] = append(cellsByValue[candidate], cell)
+			}
+		}
+
+		for value, cells := range cellsByValue {
+			if _, ok := state.values[cells[0]]; len(cells) == 1 && !ok {
+				state.values[cells[0]] = value
+				placed = true
+			}
+		}
+	}
+
+	return placed
+}
+
+// placeNakedSingles places values of cells with a single candidate.
+// Returns true if at least one value was placed.
+func (state *gradingState) placeNakedSingles() bool {
+	placed := false
+	for cell := range state.cellHouses {
+		if _, ok := state.values[cell]; ok {
+			continue
+		}
+
+		if candidates := state.getCandidates(cell); len(candidates) == 1 {
+			state.values[cell] = candidates[0]
+			placed = true
+		}
+	}
+
+	return placed
+}
@@ -0,0 +1,75 @@
+package sudokuGrader
+
+import (
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/services/sudokuInit"
+	"github.com/Michu8258/kangaroo/services/textSudokuParser"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestGrade(t *testing.T) {
+	testCases := []struct {
+		name               string
+		puzzle             string
+		expectedDifficulty models.SudokuDifficulty
+	}{
+		{
+			name:               "Solved sudoku",
+			puzzle:             "123456789456789123789123456214365897365897214897214365531642978642978531978531642",
+			expectedDifficulty: models.EasyDifficulty,
+		},
+		{
+			name:               "Hidden singles",
+			puzzle:             "000004310309270560410050000900527001500006000170000852032700146600348000004000003",
+			expectedDifficulty: models.EasyDifficulty,
+		},
+		{
+			name:               "Naked singles",
+			puzzle:             "600003400010205070007000001000180500900000080040607000006000003080030020200560700",
+			expectedDifficulty: models.MediumDifficulty,
+		},
+		{
+			name:               "Advanced techniques",
+			puzzle:             "000000000000074005470005060005800006032000890700002010000000000001600309600120000",
+			expectedDifficulty: models.HardDifficulty,
+		},
+		{
+			name:               "Empty sudoku",
+			puzzle:             "000000000000000000000000000000000000000000000000000000000000000000000000000000000",
+			expectedDifficulty: models.HardDifficulty,
+		},
+	}
+
+	settings := testHelpers.GetTestSettings()
+	parser := textSudokuParser.GetNewTextSudokuParser(settings)
+	initializer := sudokuInit.GetNewSudokuInit(settings, logger.NewDiscardLogger())
+	grader := GetNewSudokuGrader(settings)
+
+	for _, testCase := range testCases {
+		sudokuDto, err := parser.ParseSudoku(testCase.puzzle, "")
+		if err != nil {
+			t.Fatalf("%s: Unexpected parse error %s", testCase.name, err)
+		}
+
+		sudoku := sudokuDto.ToSudoku()
+		if _, errs := initializer.InitializeSudoku(sudoku); len(errs) > 0 {
+			t.Fatalf("%s: Unexpected initialization errors %v", testCase.name, errs)
+		}
+
+		difficulty := grader.Grade(sudoku)
+		if difficulty != testCase.expectedDifficulty {
+			t.Errorf("%s: Expected difficulty %s, got %s", testCase.name, testCase.expectedDifficulty, difficulty)
+		}
+
+		for _, box := range sudoku.Boxes {
+			for _, cell := range box.Cells {
+				if cell.Value != nil && !cell.IsInputValue {
+					t.Errorf("%s: Graded sudoku was changed", testCase.name)
+				}
+			}
+		}
+	}
+}
@@ -1,17 +1,23 @@
 package sudokuInit
 
-import "github.com/Michu8258/kangaroo/models"
+import (
+	"log/slog"
+
+	"github.com/Michu8258/kangaroo/models"
+)
 
 type SudokuInit struct {
 	Settings *models.Settings
+	Logger   *slog.Logger
 }
 
 type ISudokuInit interface {
 	InitializeSudoku(sudoku *models.Sudoku) (bool, []error)
 }
 
-func GetNewSudokuInit(settings *models.Settings) ISudokuInit {
+func GetNewSudokuInit(settings *models.Settings, logger *slog.Logger) ISudokuInit {
 	return &SudokuInit{
 		Settings: settings,
+		Logger:   logger,
 	}
 }
@@ -0,0 +1,176 @@
+package sudokuInit
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Michu8258/kangaroo/helpers"
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// initializeConstraints validates paths of line shaped constraints (thermometers
+// and arrows) and comparisons, then assigns references to the cells the constraints
+// are built of. Every path must consist of existing cells (in not disabled boxes)
+// that are orthogonally or diagonally connected. Compared cells must be orthogonally
+// adjacent and placed in the same box.
+func (init *SudokuInit) initializeConstraints(sudoku *models.Sudoku) []error {
+	errs := []error{}
+
+	for thermometerIndex, thermometer := range sudoku.Thermometers {
+		description := fmt.Sprintf("thermometer %d", thermometerIndex+1)
+
+		if len(thermometer.Path) < 2 {
+			errs = append(errs, fmt.Errorf("%s must consist of at least 2 cells", description))
+			continue
+		}
+
+		if len(thermometer.Path) > int(sudoku.BoxSize)*int(sudoku.BoxSize) {
+			errs = append(errs, fmt.Errorf(
+				"%s consists of %d cells, but it cannot be longer than %d cells",
+				description, len(thermometer.Path), int(sudoku.BoxSize)*int(sudoku.BoxSize)))
+			continue
+		}
+
+		cells, err := init.resolveConstraintPath(sudoku, thermometer.Path, description)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		thermometer.Cells = cells
+	}
+
+	for arrowIndex, arrow := range sudoku.Arrows {
+		description := fmt.Sprintf("arrow %d", arrowIndex+1)
+
+		if len(arrow.Path) < 1 {
+			errs = append(errs, fmt.Errorf("%s must have at least 1 cell on its path", description))
+			continue
+		}
+
+		// circle is the beginning of the arrow, so it is validated as a part of the path
+		fullPath := append([]models.SudokuCellPosition{arrow.CirclePosition}, arrow.Path...)
+		cells, err := init.resolveConstraintPath(sudoku, fullPath, description)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		arrow.Circle = cells[0]
+		arrow.Cells = cells[1:]
+	}
+
+	for comparisonIndex, comparison := range sudoku.Comparisons {
+		description := fmt.Sprintf("comparison %d", comparisonIndex+1)
+		cells, err := init.resolveConstraintPath(sudoku,
+			[]models.SudokuCellPosition{comparison.GreaterPosition, comparison.LesserPosition},
+			description)
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if !comparison.GreaterPosition.IsOrthogonallyAdjacentTo(comparison.LesserPosition) {
+			errs = append(errs, fmt.Errorf("%s must compare horizontally or vertically adjacent cells",
+				description))
+			continue
+		}
+
+		if cells[0].Box != cells[1].Box {
+			errs = append(errs, fmt.Errorf("%s must compare cells of the same box", description))
+			continue
+		}
+
+		duplicateIndex := slices.IndexFunc(sudoku.Comparisons[:comparisonIndex],
+			func(c *models.SudokuComparison) bool {
+				return (c.GreaterPosition == comparison.GreaterPosition && c.LesserPosition == comparison.LesserPosition) ||
+					(c.GreaterPosition == comparison.LesserPosition && c.LesserPosition == comparison.GreaterPosition)
+			})
+
+		if duplicateIndex >= 0 {
+			errs = append(errs, fmt.Errorf("%s compares the same cells as comparison %d",
+				description, duplicateIndex+1))
+			continue
+		}
+
+		comparison.Greater = cells[0]
+		comparison.Lesser = cells[1]
+	}
+
+	return errs
+}
+
+// resolveConstraintPath finds cells described by positions of the constraint path
+// and validates that the path is connected and does not visit any cell twice
+func (init *SudokuInit) resolveConstraintPath(sudoku *models.Sudoku,
+	path []models.SudokuCellPosition, description string) (models.GenericSlice[*models.SudokuCell], error) {
+
+	cells := models.GenericSlice[*models.SudokuCell]{}
+
+	for positionIndex, position := range path {
+		coordinates := helpers.GetCoordinatesString(position.Row+1, position.Column+1, true)
+
+		box, cell := sudoku.GetCellByPosition(position)
+		if box == nil || cell == nil {
+			return nil, fmt.Errorf("%s contains a cell %s that does not exist in the sudoku",
+				description, coordinates)
+		}
+
+		if box.Disabled {
+			return nil, fmt.Errorf("%s contains a cell %s that is placed in disabled box %s",
+				description, coordinates, helpers.GetBoxCoordinatesString(box, true))
+		}
+
+		if cells.Any(func(c *models.SudokuCell) bool { return c == cell }) {
+			return nil, fmt.Errorf("%s visits a cell %s more than once", description, coordinates)
+		}
+
+		if positionIndex > 0 && !path[positionIndex-1].IsAdjacentTo(position) {
+			return nil, fmt.Errorf(
+				"%s is not connected - cell %s does not touch previous cell of the path %s",
+				description,
+				coordinates,
+				helpers.GetCoordinatesString(path[positionIndex-1].Row+1, path[positionIndex-1].Column+1, true))
+		}
+
+		cells = append(cells, cell)
+	}
+
+	return cells, nil
+}
+
+// validateConstraintsValues checks if input values do not break
+// rules of line shaped constraints
+func (init *SudokuInit) validateConstraintsValues(sudoku *models.Sudoku) []error {
+	errs := []error{}
+
+	for thermometerIndex, thermometer := range sudoku.Thermometers {
+		if thermometer.HasRuleViolation() {
+			thermometer.ViolatesRule = true
+			errs = append(errs, fmt.Errorf(
+				"values on thermometer %d do not strictly increase from the bulb",
+				thermometerIndex+1))
+		}
+	}
+
+	for arrowIndex, arrow := range sudoku.Arrows {
+		if arrow.HasRuleViolation() {
+			arrow.ViolatesRule = true
+			errs = append(errs, fmt.Errorf(
+				"values on arrow %d do not sum up to the value in the circle",
+				arrowIndex+1))
+		}
+	}
+
+	for comparisonIndex, comparison := range sudoku.Comparisons {
+		if comparison.HasRuleViolation() {
+			comparison.ViolatesRule = true
+			errs = append(errs, fmt.Errorf(
+				"values compared by comparison %d do not respect the inequality sign",
+				comparisonIndex+1))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,276 @@
+package sudokuInit
+
+import (
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestInitializeConstraints_Error(t *testing.T) {
+	testCases := []struct {
+		name         string
+		thermometers models.GenericSlice[*models.SudokuThermometer]
+		arrows       models.GenericSlice[*models.SudokuArrow]
+		comparisons  models.GenericSlice[*models.SudokuComparison]
+	}{
+		{
+			name: "Too short thermometer",
+			thermometers: models.GenericSlice[*models.SudokuThermometer]{
+				{Path: []models.SudokuCellPosition{{Row: 0, Column: 0}}},
+			},
+		},
+		{
+			name: "Too long thermometer",
+			thermometers: models.GenericSlice[*models.SudokuThermometer]{
+				{Path: []models.SudokuCellPosition{
+					{Row: 0, Column: 0}, {Row: 0, Column: 1}, {Row: 0, Column: 2},
+					{Row: 0, Column: 3}, {Row: 0, Column: 4}, {Row: 0, Column: 5},
+					{Row: 0, Column: 6}, {Row: 0, Column: 7}, {Row: 0, Column: 8},
+					{Row: 1, Column: 8},
+				}},
+			},
+		},
+		{
+			name: "Thermometer not connected",
+			thermometers: models.GenericSlice[*models.SudokuThermometer]{
+				{Path: []models.SudokuCellPosition{{Row: 0, Column: 0}, {Row: 0, Column: 2}}},
+			},
+		},
+		{
+			name: "Thermometer visiting cell twice",
+			thermometers: models.GenericSlice[*models.SudokuThermometer]{
+				{Path: []models.SudokuCellPosition{
+					{Row: 0, Column: 0}, {Row: 0, Column: 1}, {Row: 0, Column: 0},
+				}},
+			},
+		},
+		{
+			name: "Thermometer outside of sudoku",
+			thermometers: models.GenericSlice[*models.SudokuThermometer]{
+				{Path: []models.SudokuCellPosition{{Row: 8, Column: 8}, {Row: 9, Column: 9}}},
+			},
+		},
+		{
+			name: "Arrow without path",
+			arrows: models.GenericSlice[*models.SudokuArrow]{
+				{CirclePosition: models.SudokuCellPosition{Row: 4, Column: 4}},
+			},
+		},
+		{
+			name: "Arrow path not touching circle",
+			arrows: models.GenericSlice[*models.SudokuArrow]{
+				{
+					CirclePosition: models.SudokuCellPosition{Row: 4, Column: 4},
+					Path:           []models.SudokuCellPosition{{Row: 6, Column: 4}},
+				},
+			},
+		},
+		{
+			name: "Arrow path going through circle",
+			arrows: models.GenericSlice[*models.SudokuArrow]{
+				{
+					CirclePosition: models.SudokuCellPosition{Row: 4, Column: 4},
+					Path: []models.SudokuCellPosition{
+						{Row: 4, Column: 5}, {Row: 4, Column: 4},
+					},
+				},
+			},
+		},
+		{
+			name: "Comparison of diagonal cells",
+			comparisons: models.GenericSlice[*models.SudokuComparison]{
+				{
+					GreaterPosition: models.SudokuCellPosition{Row: 0, Column: 0},
+					LesserPosition:  models.SudokuCellPosition{Row: 1, Column: 1},
+				},
+			},
+		},
+		{
+			name: "Comparison of cells from different boxes",
+			comparisons: models.GenericSlice[*models.SudokuComparison]{
+				{
+					GreaterPosition: models.SudokuCellPosition{Row: 0, Column: 2},
+					LesserPosition:  models.SudokuCellPosition{Row: 0, Column: 3},
+				},
+			},
+		},
+		{
+			name: "Comparison of the same cells twice",
+			comparisons: models.GenericSlice[*models.SudokuComparison]{
+				{
+					GreaterPosition: models.SudokuCellPosition{Row: 0, Column: 0},
+					LesserPosition:  models.SudokuCellPosition{Row: 0, Column: 1},
+				},
+				{
+					GreaterPosition: models.SudokuCellPosition{Row: 0, Column: 1},
+					LesserPosition:  models.SudokuCellPosition{Row: 0, Column: 0},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
+
+		sudoku := getTestSudoku(t)
+		init.initializeSubSudokus(sudoku)
+		init.assignSudokuReferences(sudoku)
+		sudoku.Thermometers = testCase.thermometers
+		sudoku.Arrows = testCase.arrows
+		sudoku.Comparisons = testCase.comparisons
+		errs := init.initializeConstraints(sudoku)
+
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 validation error, but got %d", testCase.name, len(errs))
+		}
+	}
+}
+
+func TestInitializeConstraints_Success(t *testing.T) {
+	settings := testHelpers.GetTestSettings()
+	init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
+
+	sudoku := getTestSudoku(t)
+	init.initializeSubSudokus(sudoku)
+	init.assignSudokuReferences(sudoku)
+	sudoku.Thermometers = models.GenericSlice[*models.SudokuThermometer]{
+		{Path: []models.SudokuCellPosition{
+			{Row: 0, Column: 0}, {Row: 1, Column: 1}, {Row: 1, Column: 2}, {Row: 2, Column: 3},
+		}},
+	}
+	sudoku.Arrows = models.GenericSlice[*models.SudokuArrow]{
+		{
+			CirclePosition: models.SudokuCellPosition{Row: 4, Column: 4},
+			Path:           []models.SudokuCellPosition{{Row: 5, Column: 5}, {Row: 6, Column: 5}},
+		},
+	}
+	sudoku.Comparisons = models.GenericSlice[*models.SudokuComparison]{
+		{
+			GreaterPosition: models.SudokuCellPosition{Row: 7, Column: 7},
+			LesserPosition:  models.SudokuCellPosition{Row: 8, Column: 7},
+		},
+	}
+
+	errs := init.initializeConstraints(sudoku)
+
+	if len(errs) >= 1 {
+		t.Errorf("Successfull constraints initialization should not return any error. "+
+			"But %d errors were returned.", len(errs))
+	}
+
+	_, expectedCell := sudoku.GetCellByPosition(models.SudokuCellPosition{Row: 2, Column: 3})
+	if sudoku.Thermometers[0].Cells[3] != expectedCell {
+		t.Error("Thermometer cells references not assigned correctly.")
+	}
+
+	_, expectedCircle := sudoku.GetCellByPosition(models.SudokuCellPosition{Row: 4, Column: 4})
+	if sudoku.Arrows[0].Circle != expectedCircle || len(sudoku.Arrows[0].Cells) != 2 {
+		t.Error("Arrow cells references not assigned correctly.")
+	}
+
+	_, expectedLesser := sudoku.GetCellByPosition(models.SudokuCellPosition{Row: 8, Column: 7})
+	if sudoku.Comparisons[0].Lesser != expectedLesser || sudoku.Comparisons[0].Greater == nil {
+		t.Error("Comparison cells references not assigned correctly.")
+	}
+}
+
+func TestValidateConstraintsValues(t *testing.T) {
+	testCases := []struct {
+		name           string
+		thermoValues   []int
+		circleValue    int
+		arrowValues    []int
+		compareValues  []int
+		expectedErrors int
+	}{
+		{
+			name:           "Valid values",
+			thermoValues:   []int{1, 0, 3},
+			circleValue:    7,
+			arrowValues:    []int{3, 4},
+			compareValues:  []int{5, 2},
+			expectedErrors: 0,
+		},
+		{
+			name:           "Thermometer not increasing",
+			thermoValues:   []int{4, 0, 5},
+			circleValue:    0,
+			arrowValues:    []int{0, 0},
+			compareValues:  []int{0, 0},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Arrow sum different than circle",
+			thermoValues:   []int{0, 0, 0},
+			circleValue:    8,
+			arrowValues:    []int{3, 4},
+			compareValues:  []int{0, 0},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Arrow partial sum exceeding circle",
+			thermoValues:   []int{0, 0, 0},
+			circleValue:    5,
+			arrowValues:    []int{5, 0},
+			compareValues:  []int{0, 0},
+			expectedErrors: 1,
+		},
+		{
+			name:           "Comparison not respected",
+			thermoValues:   []int{0, 0, 0},
+			circleValue:    0,
+			arrowValues:    []int{0, 0},
+			compareValues:  []int{2, 5},
+			expectedErrors: 1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
+
+		thermometer := &models.SudokuThermometer{Cells: buildConstraintCells(testCase.thermoValues)}
+		arrow := &models.SudokuArrow{
+			Circle: buildConstraintCells([]int{testCase.circleValue})[0],
+			Cells:  buildConstraintCells(testCase.arrowValues),
+		}
+
+		comparedCells := buildConstraintCells(testCase.compareValues)
+		comparison := &models.SudokuComparison{
+			Greater: comparedCells[0],
+			Lesser:  comparedCells[1],
+		}
+
+		sudoku := &models.Sudoku{
+			Thermometers: models.GenericSlice[*models.SudokuThermometer]{thermometer},
+			Arrows:       models.GenericSlice[*models.SudokuArrow]{arrow},
+			Comparisons:  models.GenericSlice[*models.SudokuComparison]{comparison},
+		}
+
+		errs := init.validateConstraintsValues(sudoku)
+
+		if len(errs) != testCase.expectedErrors {
+			t.Errorf("%s: expected %d validation errors, but got %d",
+				testCase.name, testCase.expectedErrors, len(errs))
+		}
+	}
+}
+
+// buildConstraintCells creates cells with provided values, zero means empty cell
+func buildConstraintCells(values []int) models.GenericSlice[*models.SudokuCell] {
+	cells := models.GenericSlice[*models.SudokuCell]{}
+	for _, value := range values {
+		cell := &models.SudokuCell{}
+		if value != 0 {
+			cellValue := value
+			cell.Value = &cellValue
+		}
+
+		cells = append(cells, cell)
+	}
+
+	return cells
+}
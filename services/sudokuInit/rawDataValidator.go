@@ -20,6 +20,11 @@ func (init *SudokuInit) validateRawData(sudoku *models.Sudoku) []error {
 		return errs
 	}
 
+	err := init.validateAlphabet(sudoku)
+	if err != nil {
+		return []error{err}
+	}
+
 	errs = init.validateCellsInitialValues(sudoku)
 	if len(errs) >= 1 {
 		return errs
@@ -74,10 +79,21 @@ func (init *SudokuInit) validateLayout(sudoku *models.Sudoku) []error {
 	return errs
 }
 
+// validateAlphabet checks if symbol alphabet of the sudoku (if provided)
+// has exactly as many unique symbols as there are possible values
+func (init *SudokuInit) validateAlphabet(sudoku *models.Sudoku) error {
+	if len(sudoku.Alphabet) < 1 {
+		return nil
+	}
+
+	_, err := models.ParseSymbolAlphabet(sudoku.Alphabet, sudoku.BoxSize)
+	return err
+}
+
 // validateBoxesCount check if sudoku object contains exactly as many
 // sudoku boxes as required by provided sudoku layout settings
 func (init *SudokuInit) validateBoxesCount(sudoku *models.Sudoku) error {
-	expectedBoxesCount := int(sudoku.Layout.Height * sudoku.Layout.Width)
+	expectedBoxesCount := int(sudoku.Layout.Height) * int(sudoku.Layout.Width)
 	actualBoxesCount := len(sudoku.Boxes)
 	if expectedBoxesCount != actualBoxesCount {
 		return fmt.Errorf(
@@ -129,7 +145,7 @@ func (init *SudokuInit) validateBoxesPresence(sudoku *models.Sudoku) []error {
 func (init *SudokuInit) validateCellsPresence(sudoku *models.Sudoku,
 	box *models.SudokuBox) error {
 
-	expectedCellsCount := int(sudoku.BoxSize * sudoku.BoxSize)
+	expectedCellsCount := int(sudoku.BoxSize) * int(sudoku.BoxSize)
 	actualCellsCount := len(box.Cells)
 
 	if actualCellsCount != expectedCellsCount {
@@ -166,7 +182,7 @@ func (init *SudokuInit) validateCellsPresence(sudoku *models.Sudoku,
 func (init *SudokuInit) validateCellsInitialValues(sudoku *models.Sudoku) []error {
 	errs := []error{}
 	minimumValue := 1
-	maximumValue := int(sudoku.BoxSize * sudoku.BoxSize)
+	maximumValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
 	var boxRowIndex, boxColumnIndex int8
 
 	for boxRowIndex = 0; boxRowIndex < sudoku.Layout.Height; boxRowIndex++ {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -102,6 +103,30 @@ func TestValidateRawData_Error(t *testing.T) {
 				sudoku.Boxes[0].Cells[4].IndexColumnInBox = -1
 			},
 		},
+		{
+			name: "Alphabet with too little symbols",
+			sudokuInvalidator: func(sudoku *models.Sudoku) {
+				sudoku.Alphabet = "A-H"
+			},
+		},
+		{
+			name: "Alphabet with duplicated symbols",
+			sudokuInvalidator: func(sudoku *models.Sudoku) {
+				sudoku.Alphabet = "A-HA"
+			},
+		},
+		{
+			name: "Alphabet with forbidden symbol",
+			sudokuInvalidator: func(sudoku *models.Sudoku) {
+				sudoku.Alphabet = "A-H."
+			},
+		},
+		{
+			name: "Alphabet with reversed range",
+			sudokuInvalidator: func(sudoku *models.Sudoku) {
+				sudoku.Alphabet = "I-A"
+			},
+		},
 		{
 			name: "Invalid cell value",
 			sudokuInvalidator: func(sudoku *models.Sudoku) {
@@ -113,7 +138,7 @@ func TestValidateRawData_Error(t *testing.T) {
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
-		init := SudokuInit{Settings: settings}
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 		sudoku := getTestSudoku(t)
 		testCase.sudokuInvalidator(sudoku)
@@ -128,7 +153,7 @@ func TestValidateRawData_Error(t *testing.T) {
 
 func TestValidateRawData_Success(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
-	init := SudokuInit{Settings: settings}
+	init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 	sudoku := getTestSudoku(t)
 	errs := init.validateRawData(sudoku)
@@ -139,6 +164,24 @@ func TestValidateRawData_Success(t *testing.T) {
 	}
 }
 
+func TestValidateRawData_Alphabet(t *testing.T) {
+	alphabets := []string{"1-9", "A-I", "!@#$%^&*(", "a-e5-7X"}
+
+	for _, alphabet := range alphabets {
+		settings := testHelpers.GetTestSettings()
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
+
+		sudoku := getTestSudoku(t)
+		sudoku.Alphabet = alphabet
+		errs := init.validateRawData(sudoku)
+
+		if len(errs) >= 1 {
+			t.Errorf("Alphabet '%s' should be valid, but %d errors were returned.",
+				alphabet, len(errs))
+		}
+	}
+}
+
 func getTestSudoku(t *testing.T) *models.Sudoku {
 	filePaths := "../../testConfigs/simple1.json"
 	bytesData, err := os.ReadFile(filePaths)
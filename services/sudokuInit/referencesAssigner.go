@@ -2,17 +2,11 @@ package sudokuInit
 
 import (
 	"fmt"
-	"math"
 
 	"github.com/Michu8258/kangaroo/helpers"
 	"github.com/Michu8258/kangaroo/models"
 )
 
-type cellSearchParams struct {
-	overallRowIndex    int8
-	overallColumnIndex int8
-}
-
 // assignSudokuReferences assigns box references inside cells references so
 // there is always a possibility to reference box having a cell reference.
 // It also builds up sudoku lines object so it is possible to reference
@@ -37,40 +31,22 @@ func (init *SudokuInit) assignBoxReferencesInCells(sudoku *models.Sudoku) {
 // references within all cells of the line - so we can perform ease checks if
 // any sudoku rule is being violated
 func (init *SudokuInit) buildMembersOfLines(sudoku *models.Sudoku) error {
-	cellsInLineCount := sudoku.BoxSize * sudoku.BoxSize
-
 	for _, subSudoku := range sudoku.SubSudokus {
+		area := models.SubSudokuArea{
+			TopBoxRowIndex:     subSudoku.TopLeftBoxRowIndex,
+			LeftBoxColumnIndex: subSudoku.TopLeftBoxColumnIndex,
+		}
 
 		// first, we iterate through columns
-		err := init.iterateRowsColumnsLines(
-			sudoku,
-			subSudoku,
-			cellsInLineCount,
-			models.SudokuLineTypeColumn,
-			func(firstDimensionIndex, secondDimensionIndex int8) cellSearchParams {
-				return cellSearchParams{
-					overallRowIndex:    secondDimensionIndex,
-					overallColumnIndex: firstDimensionIndex,
-				}
-			})
-
+		err := init.addSubSudokuLines(sudoku, subSudoku, area.Columns(sudoku.BoxSize),
+			models.SudokuLineTypeColumn)
 		if err != nil {
 			return err
 		}
 
 		// second, we iterate through rows
-		err = init.iterateRowsColumnsLines(
-			sudoku,
-			subSudoku,
-			cellsInLineCount,
-			models.SudokuLineTypeRow,
-			func(firstDimensionIndex, secondDimensionIndex int8) cellSearchParams {
-				return cellSearchParams{
-					overallRowIndex:    firstDimensionIndex,
-					overallColumnIndex: secondDimensionIndex,
-				}
-			})
-
+		err = init.addSubSudokuLines(sudoku, subSudoku, area.Rows(sudoku.BoxSize),
+			models.SudokuLineTypeRow)
 		if err != nil {
 			return err
 		}
@@ -79,28 +55,26 @@ func (init *SudokuInit) buildMembersOfLines(sudoku *models.Sudoku) error {
 	return nil
 }
 
-// iterateRowsColumnsLines iterates through sudoku cells belonging to the same sudoku line, finds
-// sibling cells and created sudoku line objects
-func (init *SudokuInit) iterateRowsColumnsLines(sudoku *models.Sudoku,
-	subSudoku *models.SubSudoku, cellsInLineCount int8, lineType string,
-	searchParamsProvider func(firstDimensionIndex, secondDimensionIndex int8) cellSearchParams) error {
+// addSubSudokuLines creates sudoku line objects of cells with provided positions
+// and stores the line references within the cells and the sub-sudoku
+func (init *SudokuInit) addSubSudokuLines(sudoku *models.Sudoku, subSudoku *models.SubSudoku,
+	lines [][]models.SudokuCellPosition, lineType string) error {
 
-	var firstDimensionIndex int8 = 0
-	for firstDimensionIndex = 0; firstDimensionIndex < cellsInLineCount; firstDimensionIndex++ {
+	for _, line := range lines {
 		sudokuLine := &models.SudokuLine{
 			Cells:        models.GenericSlice[*models.SudokuCell]{},
 			LineType:     lineType,
 			ViolatesRule: false,
 			SubsudokuId:  subSudoku.Id,
 		}
-		var secondDimensionIndex int8 = 0
-
-		for secondDimensionIndex = 0; secondDimensionIndex < cellsInLineCount; secondDimensionIndex++ {
-			cellSearchParams := searchParamsProvider(firstDimensionIndex, secondDimensionIndex)
 
-			cellReference, err := init.getSudokuCellReference(sudoku, subSudoku, cellSearchParams, lineType)
-			if err != nil {
-				return err
+		for _, position := range line {
+			_, cellReference := sudoku.GetCellByPosition(position)
+			if cellReference == nil {
+				return fmt.Errorf(
+					"could not find sudoku cell %s when constructing a sudoku line (%s)",
+					helpers.GetCoordinatesString(position.Row+1, position.Column+1, true),
+					lineType)
 			}
 
 			// adding cell to the line
@@ -114,49 +88,3 @@ func (init *SudokuInit) iterateRowsColumnsLines(sudoku *models.Sudoku,
 
 	return nil
 }
-
-// iterateRowsColumnsLines locates cells required to build sudoku line object, by converting
-// local (sub-sudoku) specific indexes to absolute indexes (in the context of entire sudoku).
-func (init *SudokuInit) getSudokuCellReference(sudoku *models.Sudoku,
-	subSudoku *models.SubSudoku, searchaParams cellSearchParams,
-	lineType string) (*models.SudokuCell, error) {
-
-	containingBoxAbsoluteRowIndex := subSudoku.TopLeftBoxRowIndex
-	containingBoxAbsoluteColumnIndex := subSudoku.TopLeftBoxColumnIndex
-
-	containingBoxRowIndexOffset := int8(math.Floor(float64(searchaParams.overallRowIndex) / float64(sudoku.BoxSize)))
-	containingBoxColumnIndexOffset := int8(math.Floor(float64(searchaParams.overallColumnIndex) / float64(sudoku.BoxSize)))
-
-	containingBoxCellRowIndex := searchaParams.overallRowIndex - (sudoku.BoxSize * containingBoxRowIndexOffset)
-	containingBoxCellColumnIndex := searchaParams.overallColumnIndex - (sudoku.BoxSize * containingBoxColumnIndexOffset)
-	containingBoxAbsoluteRowIndex += containingBoxRowIndexOffset
-	containingBoxAbsoluteColumnIndex += containingBoxColumnIndexOffset
-
-	sudokuBox := sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBox) bool {
-		return box.IndexRow == containingBoxAbsoluteRowIndex && box.IndexColumn == containingBoxAbsoluteColumnIndex
-	})
-
-	if sudokuBox == nil {
-		return nil, fmt.Errorf(
-			"could not find sudoku box when constructing a sudoku line (%s). Was looking for box %s",
-			lineType,
-			helpers.GetCoordinatesString(containingBoxAbsoluteRowIndex+1, containingBoxAbsoluteColumnIndex+1, true))
-	}
-
-	sudokuCell := sudokuBox.Cells.FirstOrDefault(nil, func(cell *models.SudokuCell) bool {
-		return cell.IndexRowInBox == containingBoxCellRowIndex && cell.IndexColumnInBox == containingBoxCellColumnIndex
-	})
-
-	if sudokuCell == nil {
-		return nil, fmt.Errorf(
-			"sudoku box %s was found when constructing a sudoku line (%s). The box does not contain a cell %s",
-			helpers.GetCoordinatesString(containingBoxAbsoluteRowIndex+1, containingBoxAbsoluteColumnIndex+1, true),
-			lineType,
-			helpers.GetCoordinatesString(
-				helpers.GetCellNumber(sudoku.BoxSize, containingBoxAbsoluteRowIndex, containingBoxCellRowIndex),
-				helpers.GetCellNumber(sudoku.BoxSize, containingBoxAbsoluteColumnIndex, containingBoxCellColumnIndex),
-				true))
-	}
-
-	return sudokuCell, nil
-}
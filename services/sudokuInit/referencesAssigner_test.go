@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -30,7 +31,7 @@ func TestAssignSudokuReferences_Error(t *testing.T) {
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
-		init := SudokuInit{Settings: settings}
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 		sudoku := getTestSudoku(t)
 		init.initializeSubSudokus(sudoku)
@@ -45,7 +46,7 @@ func TestAssignSudokuReferences_Error(t *testing.T) {
 
 func TestAssignSudokuReferences_Success(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
-	init := SudokuInit{Settings: settings}
+	init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 	sudoku := getTestSudoku(t)
 	init.initializeSubSudokus(sudoku)
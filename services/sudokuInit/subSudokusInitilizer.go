@@ -20,16 +20,7 @@ func (init *SudokuInit) initializeSubSudokus(sudoku *models.Sudoku) []error {
 	// boxes with this size.
 	expectedSize := sudoku.BoxSize
 
-	// Since sudoku puzzle may contain many sub-sudokus (and every sub-sudoku)
-	// is a square of sudoku boxes, we are marking minimum and maximum box
-	// absolute indexes for the top left box of a subsudoku. This will help us
-	// check if rest of required boxes for potential sub sudoku are in place.
-	var minimumRowIndex int8 = 0
-	maximumRowIndex := sudoku.Layout.Height - expectedSize
-	var minimumColumnIndex int8 = 0
-	maximumColumnIndex := sudoku.Layout.Width - expectedSize
-
-	if maximumRowIndex < 0 || maximumColumnIndex < 0 {
+	if sudoku.Layout.Height < expectedSize || sudoku.Layout.Width < expectedSize {
 		errs = append(errs, fmt.Errorf(
 			"no possibility to designate any sub-sudoku. Sub-sudoku cannot "+
 				"be designated when box size is set to %d and sudoku layout "+
@@ -41,12 +32,12 @@ func (init *SudokuInit) initializeSubSudokus(sudoku *models.Sudoku) []error {
 		return errs
 	}
 
-	for minimumRowIndex = 0; minimumRowIndex <= maximumRowIndex; minimumRowIndex++ {
-		for minimumColumnIndex = 0; minimumColumnIndex <= maximumColumnIndex; minimumColumnIndex++ {
-			err := init.addSubSudoku(sudoku, minimumRowIndex, minimumColumnIndex)
-			if err != nil {
-				errs = append(errs, err)
-			}
+	// sudoku puzzle may contain many sub-sudokus - every square of enabled
+	// sudoku boxes is a sub-sudoku
+	for _, area := range sudoku.GetSubSudokuAreas() {
+		err := init.addSubSudoku(sudoku, area)
+		if err != nil {
+			errs = append(errs, err)
 		}
 	}
 
@@ -62,60 +53,35 @@ func (init *SudokuInit) initializeSubSudokus(sudoku *models.Sudoku) []error {
 	return errs
 }
 
-// addSubSudoku creates a sub0sudoku object and adds it to sudoku object
-func (init *SudokuInit) addSubSudoku(sudoku *models.Sudoku, startRowIndex, startColumnIndex int8) error {
-	topLeftSubSudokuBox := sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBox) bool {
-		return box.IndexRow == startRowIndex && box.IndexColumn == startColumnIndex
-	})
-
-	if topLeftSubSudokuBox == nil {
-		return fmt.Errorf(
-			"cannot locate sudoku box %s when attempting to build sub-sudoku. "+
-				"Those are coordinates of top left sudoku box of potential sub-sudoku",
-			helpers.GetCoordinatesString(startRowIndex+1, startColumnIndex+1, true))
-	}
-
-	if topLeftSubSudokuBox.Disabled {
-		return nil
-	}
-
-	// now we have starting and ending index of sudoku boxes that should be a part
-	// of considered sub-sudoku
-	endRowIndex := startRowIndex + sudoku.BoxSize - 1
-	endColumnIndex := startColumnIndex + sudoku.BoxSize - 1
+// addSubSudoku creates a sub-sudoku object of the area and adds it to sudoku object
+func (init *SudokuInit) addSubSudoku(sudoku *models.Sudoku, area models.SubSudokuArea) error {
+	endRowIndex := area.TopBoxRowIndex + sudoku.BoxSize - 1
+	endColumnIndex := area.LeftBoxColumnIndex + sudoku.BoxSize - 1
 
 	subSudokuBoxes := []*models.SudokuBox{}
 
-	for boxRowIndex := startRowIndex; boxRowIndex <= endRowIndex; boxRowIndex++ {
-		for boxColumnIndex := startColumnIndex; boxColumnIndex <= endColumnIndex; boxColumnIndex++ {
-			potentialSubSudokuBox := sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBox) bool {
+	for boxRowIndex := area.TopBoxRowIndex; boxRowIndex <= endRowIndex; boxRowIndex++ {
+		for boxColumnIndex := area.LeftBoxColumnIndex; boxColumnIndex <= endColumnIndex; boxColumnIndex++ {
+			subSudokuBox := sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBox) bool {
 				return box.IndexRow == boxRowIndex && box.IndexColumn == boxColumnIndex
 			})
 
-			if potentialSubSudokuBox == nil {
+			if subSudokuBox == nil {
 				return fmt.Errorf(
-					"cannot locate sudoku box %s when attempting to find "+
-						"potential sub-sudoku box",
-					helpers.GetCoordinatesString(startRowIndex+1, startColumnIndex+1, true))
-			}
-
-			if potentialSubSudokuBox.Disabled {
-				// if any of required boxes is disabled, it means this is not a possible
-				// sub sudoku area, but this does not mean an error
-				return nil
+					"cannot locate sudoku box %s when attempting to build sub-sudoku",
+					helpers.GetCoordinatesString(boxRowIndex+1, boxColumnIndex+1, true))
 			}
 
-			subSudokuBoxes = append(subSudokuBoxes, potentialSubSudokuBox)
+			subSudokuBoxes = append(subSudokuBoxes, subSudokuBox)
 		}
 	}
 
-	// we found all required boxes to build a sub-sudoku and all of them are enabled (NOT disabled)
 	subsudokuId, _ := guid.NewV4()
 	sudoku.SubSudokus = append(sudoku.SubSudokus, &models.SubSudoku{
 		Id:                    *subsudokuId,
 		Boxes:                 subSudokuBoxes,
-		TopLeftBoxRowIndex:    startRowIndex,
-		TopLeftBoxColumnIndex: startColumnIndex,
+		TopLeftBoxRowIndex:    area.TopBoxRowIndex,
+		TopLeftBoxColumnIndex: area.LeftBoxColumnIndex,
 		ChildLines:            []*models.SudokuLine{},
 	})
 	return nil
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 	guid "github.com/nu7hatch/gouuid"
 )
@@ -46,7 +47,7 @@ func TestInitializeSubSudokus_Error(t *testing.T) {
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
-		init := SudokuInit{Settings: settings}
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 		sudoku := getTestSudoku(t)
 		testCase.sudokuInvalidator(sudoku)
@@ -61,7 +62,7 @@ func TestInitializeSubSudokus_Error(t *testing.T) {
 
 func TestInitializeSubSudokus_Success(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
-	init := SudokuInit{Settings: settings}
+	init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 	sudoku := getTestSudoku(t)
 	errs := init.initializeSubSudokus(sudoku)
@@ -6,26 +6,42 @@ import (
 
 // InitializeSudoku executes initialization of sudoku puzzle describing object.
 // That includes: Precomputing initial data, assigning circular references in
-// sudoku object, constructing subsudokus and validation of input data.
+// sudoku object, constructing subsudokus, resolving line shaped constraints
+// (thermometers, arrows) and validation of input data.
 // Returns boolean flag indicating that sudoku is printable and collection of errors
 func (init *SudokuInit) InitializeSudoku(sudoku *models.Sudoku) (bool, []error) {
 	errs := []error{}
 
+	init.Logger.Debug("sudoku initialization started",
+		"boxSize", sudoku.BoxSize,
+		"layoutWidth", sudoku.Layout.Width,
+		"layoutHeight", sudoku.Layout.Height)
+
 	errs = append(errs, init.validateRawData(sudoku)...)
 	if len(errs) >= 1 {
+		init.Logger.Debug("sudoku raw data validation failed", "errors", len(errs))
 		return false, errs
 	}
 
 	err := init.assignSudokuReferences(sudoku)
 	if err != nil {
+		init.Logger.Debug("sudoku references assignment failed", "error", err)
 		errs = append(errs, err)
 		return false, errs
 	}
 
-	errs = append(errs, init.validateSudokuValues(sudoku)...)
+	errs = append(errs, init.initializeConstraints(sudoku)...)
 	if len(errs) >= 1 {
+		init.Logger.Debug("sudoku constraints initialization failed", "errors", len(errs))
 		return true, errs
 	}
 
+	errs = append(errs, init.validateSudokuValues(sudoku)...)
+	errs = append(errs, init.validateConstraintsValues(sudoku)...)
+
+	init.Logger.Debug("sudoku initialization finished",
+		"subSudokus", len(sudoku.SubSudokus),
+		"errors", len(errs))
+
 	return true, errs
 }
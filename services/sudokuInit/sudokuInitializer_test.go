@@ -3,13 +3,14 @@ package sudokuInit
 import (
 	"testing"
 
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
 func TestInitializeSudoku(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
 	sudoku := getTestSudoku(t)
-	init := GetNewSudokuInit(settings)
+	init := GetNewSudokuInit(settings, logger.NewDiscardLogger())
 	succes, errs := init.InitializeSudoku(sudoku)
 
 	if len(errs) >= 1 {
@@ -50,7 +50,7 @@ func (init *SudokuInit) validateCellsCollection(sudoku *models.Sudoku, boxSize i
 
 	errs := []error{}
 	minimumCellValue := 1
-	maximumCellValue := int(boxSize * boxSize)
+	maximumCellValue := int(boxSize) * int(boxSize)
 	alreadyExistingValues := []int{}
 
 	for _, cell := range cells {
@@ -66,6 +66,8 @@ func (init *SudokuInit) validateCellsCollection(sudoku *models.Sudoku, boxSize i
 				*value,
 				minimumCellValue, maximumCellValue))
 
+			init.Logger.Debug("cell value out of range", "value", *value,
+				helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 			cellsErrorSetter()
 		}
 
@@ -75,6 +77,9 @@ func (init *SudokuInit) validateCellsCollection(sudoku *models.Sudoku, boxSize i
 				helpers.GetCellCoordinatesString(sudoku, cell.Box, cell, true),
 				*value,
 				collectionType))
+
+			init.Logger.Debug("duplicated cell value", "value", *value, "collection", collectionType,
+				helpers.GetCellLogAttr(sudoku, cell.Box, cell))
 			cellsErrorSetter()
 		}
 
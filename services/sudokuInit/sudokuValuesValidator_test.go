@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/logger"
 	"github.com/Michu8258/kangaroo/testHelpers"
 )
 
@@ -31,7 +32,7 @@ func TestValidateSudokuValues_Error(t *testing.T) {
 
 	for _, testCase := range testCases {
 		settings := testHelpers.GetTestSettings()
-		init := SudokuInit{Settings: settings}
+		init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 		sudoku := getTestSudoku(t)
 		init.initializeSubSudokus(sudoku)
@@ -47,7 +48,7 @@ func TestValidateSudokuValues_Error(t *testing.T) {
 
 func TestValidateSudokuValues_Success(t *testing.T) {
 	settings := testHelpers.GetTestSettings()
-	init := SudokuInit{Settings: settings}
+	init := SudokuInit{Settings: settings, Logger: logger.NewDiscardLogger()}
 
 	sudoku := getTestSudoku(t)
 	init.initializeSubSudokus(sudoku)
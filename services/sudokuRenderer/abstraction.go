@@ -0,0 +1,35 @@
+package sudokuRenderer
+
+import (
+	"io"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+type SudokuRenderer struct {
+	Settings *models.Settings
+}
+
+// RenderOptions changes content of the drawing
+type RenderOptions struct {
+	// Candidates enables drawing of values which can be placed in empty cells
+	Candidates bool
+	// Solution embeds values of cells other than givens in HTML page as the solution,
+	// which enables checking of entered values. Every cell has to have a value then.
+	Solution bool
+}
+
+type ISudokuRenderer interface {
+	GetGeometry(sudoku *models.Sudoku, cellSize float64, options RenderOptions) *SudokuGeometry
+	RenderSvg(writer io.Writer, sudoku *models.Sudoku, options RenderOptions) error
+	RenderPng(writer io.Writer, sudoku *models.Sudoku, options RenderOptions) error
+	RenderHtml(writer io.Writer, sudoku *models.Sudoku, options RenderOptions) error
+	RenderPdfBooklet(writer io.Writer, puzzles []*BookletEntry, solutions []*BookletEntry,
+		options BookletOptions) error
+}
+
+func GetNewSudokuRenderer(settings *models.Settings) ISudokuRenderer {
+	return &SudokuRenderer{
+		Settings: settings,
+	}
+}
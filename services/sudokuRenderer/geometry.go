@@ -0,0 +1,278 @@
+package sudokuRenderer
+
+import (
+	"math"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// Point is a position on the drawing, the origin is top left corner of the grid
+type Point struct {
+	X float64
+	Y float64
+}
+
+// SudokuGeometry describes positions of all elements of the sudoku drawing.
+// Only cells of enabled boxes are included, so disabled boxes of multi-grid
+// layouts (e.g. samurai sudoku) stay blank.
+type SudokuGeometry struct {
+	CellSize     float64
+	Width        float64
+	Height       float64
+	Cells        []*CellGeometry
+	Borders      []*BorderGeometry
+	Thermometers [][]Point
+	Arrows       []*ArrowGeometry
+	Comparisons  []*ComparisonGeometry
+}
+
+// CellGeometry describes a cell of enabled box. Symbol is empty for empty cells,
+// candidates are provided only for empty cells and only if they are requested.
+type CellGeometry struct {
+	Position     models.SudokuCellPosition
+	TopLeft      Point
+	Center       Point
+	Symbol       string
+	IsGiven      bool
+	ViolatesRule bool
+	Candidates   []*CandidateGeometry
+}
+
+// CandidateGeometry describes a value which can be placed in an empty cell.
+// Candidates are placed on a box size by box size sub-grid of the cell,
+// size is the size of the sub-grid square.
+type CandidateGeometry struct {
+	Symbol string
+	Center Point
+	Size   float64
+}
+
+// BorderGeometry describes a line between cells. Thick lines are box borders.
+type BorderGeometry struct {
+	From  Point
+	To    Point
+	Thick bool
+}
+
+// ArrowGeometry describes an arrow - circle, line starting at the edge of the
+// circle and going through centers of path cells, and the head of the arrow
+type ArrowGeometry struct {
+	Circle Point
+	Radius float64
+	Path   []Point
+	Head   [3]Point
+}
+
+// ComparisonGeometry describes an inequality sign between two cells, the
+// middle point of the sign points at the lesser cell
+type ComparisonGeometry struct {
+	Points       [3]Point
+	ViolatesRule bool
+}
+
+// GetGeometry calculates positions of sudoku elements on a drawing where
+// every cell is a square of provided size
+func (renderer *SudokuRenderer) GetGeometry(sudoku *models.Sudoku, cellSize float64,
+	options RenderOptions) *SudokuGeometry {
+
+	boxSize := int(sudoku.BoxSize)
+	geometry := &SudokuGeometry{
+		CellSize: cellSize,
+		Width:    float64(int(sudoku.Layout.Width)*boxSize) * cellSize,
+		Height:   float64(int(sudoku.Layout.Height)*boxSize) * cellSize,
+	}
+
+	alphabet := sudoku.GetSymbolAlphabet()
+	thinBorders := []*BorderGeometry{}
+	thickBorders := []*BorderGeometry{}
+
+	for _, box := range sudoku.Boxes {
+		if box.Disabled {
+			continue
+		}
+
+		left := float64(int(box.IndexColumn)*boxSize) * cellSize
+		top := float64(int(box.IndexRow)*boxSize) * cellSize
+		size := float64(boxSize) * cellSize
+
+		for lineIndex := 1; lineIndex < boxSize; lineIndex++ {
+			offset := float64(lineIndex) * cellSize
+			thinBorders = append(thinBorders,
+				&BorderGeometry{From: Point{left + offset, top}, To: Point{left + offset, top + size}},
+				&BorderGeometry{From: Point{left, top + offset}, To: Point{left + size, top + offset}})
+		}
+
+		thickBorders = append(thickBorders,
+			&BorderGeometry{From: Point{left, top}, To: Point{left + size, top}, Thick: true},
+			&BorderGeometry{From: Point{left + size, top}, To: Point{left + size, top + size}, Thick: true},
+			&BorderGeometry{From: Point{left, top + size}, To: Point{left + size, top + size}, Thick: true},
+			&BorderGeometry{From: Point{left, top}, To: Point{left, top + size}, Thick: true})
+
+		for _, cell := range box.Cells {
+			geometry.Cells = append(geometry.Cells,
+				getCellGeometry(sudoku, box, cell, alphabet, cellSize, options))
+		}
+	}
+
+	// box borders are drawn over cell borders
+	geometry.Borders = append(thinBorders, thickBorders...)
+
+	for _, thermometer := range sudoku.Thermometers {
+		geometry.Thermometers = append(geometry.Thermometers, getCenters(thermometer.Path, cellSize))
+	}
+
+	for _, arrow := range sudoku.Arrows {
+		geometry.Arrows = append(geometry.Arrows, getArrowGeometry(arrow, cellSize))
+	}
+
+	for _, comparison := range sudoku.Comparisons {
+		geometry.Comparisons = append(geometry.Comparisons, getComparisonGeometry(comparison, cellSize))
+	}
+
+	return geometry
+}
+
+// getCellGeometry calculates position and content of the cell
+func getCellGeometry(sudoku *models.Sudoku, box *models.SudokuBox, cell *models.SudokuCell,
+	alphabet *models.SymbolAlphabet, cellSize float64, options RenderOptions) *CellGeometry {
+
+	boxSize := int(sudoku.BoxSize)
+	position := models.SudokuCellPosition{
+		Row:    int(box.IndexRow)*boxSize + int(cell.IndexRowInBox),
+		Column: int(box.IndexColumn)*boxSize + int(cell.IndexColumnInBox),
+	}
+
+	cellGeometry := &CellGeometry{
+		Position:     position,
+		TopLeft:      Point{float64(position.Column) * cellSize, float64(position.Row) * cellSize},
+		Center:       getCenter(position, cellSize),
+		IsGiven:      cell.IsInputValue,
+		ViolatesRule: cell.HasViolationError(),
+	}
+
+	if cell.Value != nil {
+		cellGeometry.Symbol = alphabet.GetSymbol(*cell.Value)
+		return cellGeometry
+	}
+
+	if options.Candidates {
+		subCellSize := cellSize / float64(boxSize)
+		for _, value := range getCandidates(box, cell, boxSize*boxSize) {
+			cellGeometry.Candidates = append(cellGeometry.Candidates, &CandidateGeometry{
+				Symbol: alphabet.GetSymbol(value),
+				Center: Point{
+					X: cellGeometry.TopLeft.X + (float64((value-1)%boxSize)+0.5)*subCellSize,
+					Y: cellGeometry.TopLeft.Y + (float64((value-1)/boxSize)+0.5)*subCellSize,
+				},
+				Size: subCellSize,
+			})
+		}
+	}
+
+	return cellGeometry
+}
+
+// getCandidates returns values not used in the box and lines of the cell.
+// Lines are known only for initialized sudoku, otherwise only the box is used.
+func getCandidates(box *models.SudokuBox, cell *models.SudokuCell, maximumValue int) []int {
+	used := make([]bool, maximumValue+1)
+	markUsed := func(cells models.GenericSlice[*models.SudokuCell]) {
+		for _, otherCell := range cells {
+			if otherCell.Value != nil && *otherCell.Value >= 1 && *otherCell.Value <= maximumValue {
+				used[*otherCell.Value] = true
+			}
+		}
+	}
+
+	markUsed(box.Cells)
+	for _, line := range cell.MemberOfLines {
+		markUsed(line.Cells)
+	}
+
+	candidates := []int{}
+	for value := 1; value <= maximumValue; value++ {
+		if !used[value] {
+			candidates = append(candidates, value)
+		}
+	}
+
+	return candidates
+}
+
+// getComparisonGeometry calculates inequality sign placed on the border between
+// compared cells, pointing from the greater cell to the lesser cell
+func getComparisonGeometry(comparison *models.SudokuComparison, cellSize float64) *ComparisonGeometry {
+	greater := getCenter(comparison.GreaterPosition, cellSize)
+	lesser := getCenter(comparison.LesserPosition, cellSize)
+	middle := Point{(greater.X + lesser.X) / 2, (greater.Y + lesser.Y) / 2}
+
+	length := math.Max(math.Hypot(lesser.X-greater.X, lesser.Y-greater.Y), 1)
+	direction := Point{(lesser.X - greater.X) / length, (lesser.Y - greater.Y) / length}
+	size := cellSize * 0.12
+
+	return &ComparisonGeometry{
+		Points: [3]Point{
+			{middle.X - direction.X*size - direction.Y*size*1.5, middle.Y - direction.Y*size + direction.X*size*1.5},
+			{middle.X + direction.X*size, middle.Y + direction.Y*size},
+			{middle.X - direction.X*size + direction.Y*size*1.5, middle.Y - direction.Y*size - direction.X*size*1.5},
+		},
+		ViolatesRule: comparison.ViolatesRule,
+	}
+}
+
+// getArrowGeometry calculates circle, line and head of the arrow
+func getArrowGeometry(arrow *models.SudokuArrow, cellSize float64) *ArrowGeometry {
+	arrowGeometry := &ArrowGeometry{
+		Circle: getCenter(arrow.CirclePosition, cellSize),
+		Radius: cellSize * 0.4,
+	}
+
+	path := getCenters(arrow.Path, cellSize)
+	if len(path) < 1 {
+		return arrowGeometry
+	}
+
+	circle := arrowGeometry.Circle
+	length := math.Max(math.Hypot(path[0].X-circle.X, path[0].Y-circle.Y), 1)
+	start := Point{
+		X: circle.X + (path[0].X-circle.X)/length*arrowGeometry.Radius,
+		Y: circle.Y + (path[0].Y-circle.Y)/length*arrowGeometry.Radius,
+	}
+
+	arrowGeometry.Path = append([]Point{start}, path...)
+	arrowGeometry.Head = getArrowHead(arrowGeometry.Path, cellSize)
+	return arrowGeometry
+}
+
+// getArrowHead returns points of the arrow head at the last point of the path
+func getArrowHead(path []Point, cellSize float64) [3]Point {
+	end := path[len(path)-1]
+	previous := path[len(path)-2]
+	length := math.Max(math.Hypot(end.X-previous.X, end.Y-previous.Y), 1)
+	directionX, directionY := (end.X-previous.X)/length, (end.Y-previous.Y)/length
+	size := cellSize * 0.18
+
+	return [3]Point{
+		{end.X - directionX*size - directionY*size, end.Y - directionY*size + directionX*size},
+		end,
+		{end.X - directionX*size + directionY*size, end.Y - directionY*size - directionX*size},
+	}
+}
+
+// getCenters returns centers of cells with provided positions
+func getCenters(positions []models.SudokuCellPosition, cellSize float64) []Point {
+	centers := []Point{}
+	for _, position := range positions {
+		centers = append(centers, getCenter(position, cellSize))
+	}
+
+	return centers
+}
+
+// getCenter returns center of the cell with provided position
+func getCenter(position models.SudokuCellPosition, cellSize float64) Point {
+	return Point{
+		X: (float64(position.Column) + 0.5) * cellSize,
+		Y: (float64(position.Row) + 0.5) * cellSize,
+	}
+}
@@ -0,0 +1,300 @@
+package sudokuRenderer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// htmlPuzzle is the data of the puzzle used by the script of HTML page. Cells
+// are listed in the order of the inputs, houses are lists of indexes of cells
+// which have to contain different values.
+type htmlPuzzle struct {
+	Rows      int      `json:"rows"`
+	Columns   int      `json:"columns"`
+	Symbols   []string `json:"symbols"`
+	Positions [][2]int `json:"positions"`
+	Houses    [][]int  `json:"houses"`
+	Solution  []string `json:"solution"`
+}
+
+// RenderHtml writes the sudoku as a self-contained HTML page, where the puzzle
+// can be played in a web browser. Givens are locked and values breaking row,
+// column or box rules of any sub-sudoku are highlighted while typing. With solution
+// option values other than givens are embedded as the solution, which enables the
+// check button. Otherwise they are prefilled as entered values.
+func (renderer *SudokuRenderer) RenderHtml(writer io.Writer, sudoku *models.Sudoku,
+	options RenderOptions) error {
+
+	geometry := renderer.GetGeometry(sudoku, svgCellSize, options)
+	width := geometry.Width + 2*svgMargin
+	height := geometry.Height + 2*svgMargin
+
+	puzzle := &htmlPuzzle{
+		Rows:    int(sudoku.Layout.Height) * int(sudoku.BoxSize),
+		Columns: int(sudoku.Layout.Width) * int(sudoku.BoxSize),
+	}
+
+	alphabet := sudoku.GetSymbolAlphabet()
+	maximumValue := int(sudoku.BoxSize) * int(sudoku.BoxSize)
+	for value := 1; value <= maximumValue; value++ {
+		puzzle.Symbols = append(puzzle.Symbols, alphabet.GetSymbol(value))
+	}
+
+	cellIndexes := map[models.SudokuCellPosition]int{}
+	isSolved, hasSolution := true, false
+	for index, cell := range geometry.Cells {
+		cellIndexes[cell.Position] = index
+		puzzle.Positions = append(puzzle.Positions, [2]int{cell.Position.Row, cell.Position.Column})
+		isSolved = isSolved && len(cell.Symbol) > 0
+		hasSolution = hasSolution || !cell.IsGiven
+	}
+
+	if options.Solution && !isSolved {
+		return models.WithKind(models.ErrInvalidInput,
+			errors.New("the solution cannot be embedded in the page, because not every cell has a value"))
+	}
+
+	if options.Solution && hasSolution {
+		for _, cell := range geometry.Cells {
+			puzzle.Solution = append(puzzle.Solution, cell.Symbol)
+		}
+	}
+
+	puzzle.Houses = getRuleHouses(sudoku, cellIndexes)
+	puzzleData, err := json.Marshal(puzzle)
+	if err != nil {
+		return err
+	}
+
+	builder := &strings.Builder{}
+	builder.WriteString("<!DOCTYPE html>\n<html lang=\"en\">\n<head>\n<meta charset=\"utf-8\">\n")
+	builder.WriteString("<meta name=\"viewport\" content=\"width=device-width, initial-scale=1\">\n")
+	builder.WriteString("<title>Kangaroo sudoku</title>\n<style>\n" + htmlStyle + "</style>\n</head>\n<body>\n<main>\n")
+	fmt.Fprintf(builder, `<div class="board" style="width: %spx; height: %spx">`+"\n",
+		formatNumber(width), formatNumber(height))
+	fmt.Fprintf(builder, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		formatNumber(width), formatNumber(height), formatNumber(width), formatNumber(height))
+	fmt.Fprintf(builder, `<g transform="translate(%s %s)">`+"\n", formatNumber(svgMargin), formatNumber(svgMargin))
+	writeSvgGrid(builder, geometry)
+	builder.WriteString("</g>\n</svg>\n")
+
+	maximumLength := alphabet.GetSymbolLength(maximumValue)
+	fontSize := geometry.CellSize * 0.6 / math.Max(1, float64(maximumLength)*0.6)
+	for index, cell := range geometry.Cells {
+		attributes := fmt.Sprintf(`maxlength="%d" autocomplete="off" inputmode="text"`, maximumLength)
+		value := cell.Symbol
+		if cell.IsGiven {
+			attributes = `class="given" readonly tabindex="-1"`
+		} else if puzzle.Solution != nil {
+			value = ""
+		}
+
+		fmt.Fprintf(builder, `<input data-index="%d" %s value="%s" aria-label="row %d, column %d" `+
+			`style="left: %spx; top: %spx; width: %spx; height: %spx; font-size: %spx">`+"\n",
+			index, attributes, html.EscapeString(value), cell.Position.Row+1, cell.Position.Column+1,
+			formatNumber(svgMargin+cell.TopLeft.X), formatNumber(svgMargin+cell.TopLeft.Y),
+			formatNumber(geometry.CellSize), formatNumber(geometry.CellSize), formatNumber(fontSize))
+	}
+
+	builder.WriteString("</div>\n<div class=\"controls\">\n")
+	if puzzle.Solution != nil {
+		builder.WriteString("<button type=\"button\" id=\"check\">Check</button>\n")
+	}
+
+	builder.WriteString("<button type=\"button\" id=\"clear\">Clear</button>\n</div>\n")
+	builder.WriteString("<p id=\"status\" role=\"status\"></p>\n</main>\n")
+	builder.WriteString("<script type=\"application/json\" id=\"puzzle\">" + string(puzzleData) + "</script>\n")
+	builder.WriteString("<script>\n" + htmlScript + "</script>\n</body>\n</html>\n")
+
+	if _, err := io.WriteString(writer, builder.String()); err != nil {
+		return models.WithKind(models.ErrIO, err)
+	}
+
+	return nil
+}
+
+// getRuleHouses returns indexes of cells which have to contain different values -
+// enabled boxes and rows and columns of every sub-sudoku, so overlapping sub-sudokus
+// are checked like by the solver
+func getRuleHouses(sudoku *models.Sudoku, cellIndexes map[models.SudokuCellPosition]int) [][]int {
+	boxSize := int(sudoku.BoxSize)
+	houses := [][]int{}
+
+	for _, box := range sudoku.Boxes {
+		if box.Disabled {
+			continue
+		}
+
+		house := []int{}
+		for _, cell := range box.Cells {
+			house = append(house, cellIndexes[models.SudokuCellPosition{
+				Row:    int(box.IndexRow)*boxSize + int(cell.IndexRowInBox),
+				Column: int(box.IndexColumn)*boxSize + int(cell.IndexColumnInBox),
+			}])
+		}
+
+		houses = append(houses, house)
+	}
+
+	getIndexes := func(positions []models.SudokuCellPosition) []int {
+		indexes := []int{}
+		for _, position := range positions {
+			indexes = append(indexes, cellIndexes[position])
+		}
+
+		return indexes
+	}
+
+	for _, area := range sudoku.GetSubSudokuAreas() {
+		rows, columns := area.Rows(sudoku.BoxSize), area.Columns(sudoku.BoxSize)
+		for line := range rows {
+			houses = append(houses, getIndexes(rows[line]), getIndexes(columns[line]))
+		}
+	}
+
+	return houses
+}
+
+// htmlStyle is the style sheet of HTML page
+const htmlStyle = `body { margin: 0; font-family: Helvetica, Arial, sans-serif; background: #f4f4f4; }
+main { display: flex; flex-direction: column; align-items: center; padding: 24px; }
+.board { position: relative; background: #ffffff; box-shadow: 0 1px 4px rgba(0, 0, 0, 0.2); }
+.board svg { position: absolute; left: 0; top: 0; }
+.board input { position: absolute; box-sizing: border-box; margin: 0; padding: 0; border: none;
+  background: transparent; text-align: center; color: ` + solvedColor + `; font-family: inherit;
+  caret-color: transparent; }
+.board input:focus { outline: none; background: rgba(28, 95, 176, 0.15); }
+.board input.given { color: ` + givenColor + `; font-weight: bold; }
+.board input.conflict { color: ` + violationColor + `; background: rgba(192, 28, 40, 0.12); }
+.board input.wrong { background: rgba(192, 28, 40, 0.25); }
+.controls { margin-top: 16px; display: flex; gap: 8px; }
+button { font: inherit; padding: 6px 18px; cursor: pointer; }
+#status { min-height: 1.2em; }
+`
+
+// htmlScript is the script of HTML page, which checks rules and the solution
+const htmlScript = `(function () {
+  const puzzle = JSON.parse(document.getElementById("puzzle").textContent);
+  const inputs = Array.from(document.querySelectorAll(".board input"));
+  const status = document.getElementById("status");
+  const indexes = new Map(puzzle.positions.map((position, index) => [position.join(","), index]));
+  const moves = { ArrowUp: [-1, 0], ArrowDown: [1, 0], ArrowLeft: [0, -1], ArrowRight: [0, 1] };
+
+  function normalize(text) {
+    const value = text.trim().toUpperCase();
+    const symbol = puzzle.symbols.find((symbol) => symbol.toUpperCase() === value);
+    return symbol === undefined ? text.trim() : symbol;
+  }
+
+  function update() {
+    const conflicts = new Set();
+    inputs.forEach((input, index) => {
+      input.classList.remove("conflict", "wrong");
+      if (input.value !== "" && !puzzle.symbols.includes(input.value)) {
+        conflicts.add(index);
+      }
+    });
+
+    puzzle.houses.forEach((house) => {
+      const seen = new Map();
+      house.forEach((index) => {
+        const value = inputs[index].value;
+        if (value === "") {
+          return;
+        }
+
+        if (seen.has(value)) {
+          conflicts.add(index);
+          conflicts.add(seen.get(value));
+        } else {
+          seen.set(value, index);
+        }
+      });
+    });
+
+    conflicts.forEach((index) => inputs[index].classList.add("conflict"));
+    const empty = inputs.filter((input) => input.value === "").length;
+    if (conflicts.size > 0) {
+      status.textContent = conflicts.size + " cell(s) break the rules";
+    } else if (empty > 0) {
+      status.textContent = empty + " cell(s) left";
+    } else {
+      status.textContent = "All cells are filled";
+    }
+  }
+
+  function move(index, direction) {
+    let [row, column] = puzzle.positions[index];
+    for (;;) {
+      row += direction[0];
+      column += direction[1];
+      if (row < 0 || column < 0 || row >= puzzle.rows || column >= puzzle.columns) {
+        return;
+      }
+
+      const next = indexes.get(row + "," + column);
+      if (next !== undefined) {
+        inputs[next].focus();
+        return;
+      }
+    }
+  }
+
+  inputs.forEach((input, index) => {
+    input.addEventListener("focus", () => input.select());
+    input.addEventListener("input", () => {
+      input.value = normalize(input.value);
+      update();
+    });
+    input.addEventListener("keydown", (event) => {
+      if (moves[event.key]) {
+        event.preventDefault();
+        move(index, moves[event.key]);
+      }
+    });
+  });
+
+  document.getElementById("clear").addEventListener("click", () => {
+    inputs.forEach((input) => {
+      if (!input.readOnly) {
+        input.value = "";
+      }
+    });
+    update();
+  });
+
+  const check = document.getElementById("check");
+  if (check) {
+    check.addEventListener("click", () => {
+      update();
+      let wrong = 0;
+      let empty = 0;
+      inputs.forEach((input, index) => {
+        if (input.value === "") {
+          empty++;
+        } else if (input.value !== puzzle.solution[index]) {
+          wrong++;
+          input.classList.add("wrong");
+        }
+      });
+
+      if (wrong > 0) {
+        status.textContent = wrong + " value(s) are wrong";
+      } else if (empty > 0) {
+        status.textContent = "No mistakes so far, " + empty + " cell(s) left";
+      } else {
+        status.textContent = "Solved, congratulations!";
+      }
+    });
+  }
+
+  update();
+})();
+`
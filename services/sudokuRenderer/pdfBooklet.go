@@ -0,0 +1,242 @@
+package sudokuRenderer
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sizes of booklet page elements, in points
+const (
+	pdfMargin       = 40.0
+	pdfHeaderHeight = 30.0
+	pdfFooterHeight = 20.0
+	pdfSlotGap      = 20.0
+	pdfTitleHeight  = 20.0
+)
+
+// BookletEntry is a sudoku of the booklet, drawn with a title and
+// a label (e.g. difficulty) above the grid
+type BookletEntry struct {
+	Title  string
+	Label  string
+	Sudoku *models.Sudoku
+}
+
+// BookletOptions changes layout of the booklet
+type BookletOptions struct {
+	// Title is printed at the top of puzzle pages
+	Title string
+	// PerPage is the number of sudokus on a single page
+	PerPage int
+}
+
+// bookletSlot is an area of the page where a single sudoku is drawn
+type bookletSlot struct {
+	topLeft Point
+	width   float64
+	height  float64
+}
+
+// RenderPdfBooklet draws puzzles as a PDF document with several puzzles per page.
+// Solutions, if provided, are drawn in an answer section after the puzzles.
+// Grids are drawn with the same geometry as images, so disabled boxes of
+// multi-grid layouts stay blank.
+func (renderer *SudokuRenderer) RenderPdfBooklet(writer io.Writer, puzzles []*BookletEntry,
+	solutions []*BookletEntry, options BookletOptions) error {
+
+	perPage := max(options.PerPage, 1)
+	slots := getBookletSlots(perPage)
+	document := &pdfDocument{}
+
+	addSection := func(header string, entries []*BookletEntry) {
+		var page *pdfPage
+		for index, entry := range entries {
+			if index%perPage == 0 {
+				page = document.addPage()
+				drawBookletPageFrame(page, header, len(document.pages))
+			}
+
+			renderer.drawBookletEntry(page, entry, slots[index%perPage])
+		}
+	}
+
+	addSection(options.Title, puzzles)
+	if len(solutions) > 0 {
+		addSection("Solutions", solutions)
+	}
+
+	if len(document.pages) < 1 {
+		document.addPage()
+	}
+
+	if err := document.write(writer); err != nil {
+		return models.WithKind(models.ErrIO, err)
+	}
+
+	return nil
+}
+
+// getBookletSlots splits content area of the page into the number of slots,
+// using number of columns which gives the biggest square grids
+func getBookletSlots(count int) []*bookletSlot {
+	areaWidth := pdfPageWidth - 2*pdfMargin
+	areaHeight := pdfPageHeight - 2*pdfMargin - pdfHeaderHeight - pdfFooterHeight
+
+	bestColumns, bestSize := 1, 0.0
+	for columns := 1; columns <= count; columns++ {
+		rows := (count + columns - 1) / columns
+		slotWidth := (areaWidth - float64(columns-1)*pdfSlotGap) / float64(columns)
+		slotHeight := (areaHeight - float64(rows-1)*pdfSlotGap) / float64(rows)
+		if size := math.Min(slotWidth, slotHeight-pdfTitleHeight); size > bestSize {
+			bestColumns, bestSize = columns, size
+		}
+	}
+
+	rows := (count + bestColumns - 1) / bestColumns
+	slotWidth := (areaWidth - float64(bestColumns-1)*pdfSlotGap) / float64(bestColumns)
+	slotHeight := (areaHeight - float64(rows-1)*pdfSlotGap) / float64(rows)
+
+	slots := []*bookletSlot{}
+	for index := 0; index < count; index++ {
+		slots = append(slots, &bookletSlot{
+			topLeft: Point{
+				X: pdfMargin + float64(index%bestColumns)*(slotWidth+pdfSlotGap),
+				Y: pdfMargin + pdfHeaderHeight + float64(index/bestColumns)*(slotHeight+pdfSlotGap),
+			},
+			width:  slotWidth,
+			height: slotHeight,
+		})
+	}
+
+	return slots
+}
+
+// drawBookletPageFrame draws header and page number of the page
+func drawBookletPageFrame(page *pdfPage, header string, pageNumber int) {
+	page.setColors(borderColor, borderColor)
+	if len(header) > 0 {
+		page.drawText(header, pdfBoldFont, 16, Point{pdfMargin, pdfMargin + 16})
+	}
+
+	page.drawCenteredText(fmt.Sprintf("%d", pageNumber), pdfRegularFont, 9,
+		Point{pdfPageWidth / 2, pdfPageHeight - pdfMargin})
+}
+
+// drawBookletEntry draws title, label and grid of the sudoku in the slot
+func (renderer *SudokuRenderer) drawBookletEntry(page *pdfPage, entry *BookletEntry, slot *bookletSlot) {
+	sudoku := entry.Sudoku
+	gridColumns := float64(int(sudoku.Layout.Width) * int(sudoku.BoxSize))
+	gridRows := float64(int(sudoku.Layout.Height) * int(sudoku.BoxSize))
+	cellSize := math.Min(slot.width/gridColumns, (slot.height-pdfTitleHeight)/gridRows)
+
+	geometry := renderer.GetGeometry(sudoku, cellSize, RenderOptions{})
+	origin := Point{
+		X: slot.topLeft.X + (slot.width-geometry.Width)/2,
+		Y: slot.topLeft.Y + pdfTitleHeight,
+	}
+
+	page.setColors(borderColor, borderColor)
+	page.drawText(entry.Title, pdfBoldFont, 11, Point{origin.X, origin.Y - 7})
+	page.drawText(entry.Label, pdfRegularFont, 10, Point{
+		X: origin.X + geometry.Width - getPdfTextWidth(entry.Label, 10),
+		Y: origin.Y - 7,
+	})
+
+	drawPdfConstraints(page, geometry, origin)
+
+	for _, border := range geometry.Borders {
+		if border.Thick {
+			page.setColors(borderColor, borderColor)
+			page.setLineWidth(math.Max(1, cellSize*0.06))
+		} else {
+			page.setColors(thinBorderColor, thinBorderColor)
+			page.setLineWidth(math.Max(0.25, cellSize*0.02))
+		}
+
+		page.strokePolyline(offsetPoints(origin, border.From, border.To))
+	}
+
+	page.setLineWidth(math.Max(0.5, cellSize*0.04))
+	for _, comparison := range geometry.Comparisons {
+		color := borderColor
+		if comparison.ViolatesRule {
+			color = violationColor
+		}
+
+		page.setColors(color, color)
+		page.strokePolyline(offsetPoints(origin, comparison.Points[:]...))
+	}
+
+	for _, cell := range geometry.Cells {
+		drawPdfCell(page, cell, cellSize, origin)
+	}
+}
+
+// drawPdfConstraints draws thermometers and arrows, below the grid lines
+func drawPdfConstraints(page *pdfPage, geometry *SudokuGeometry, origin Point) {
+	cellSize := geometry.CellSize
+
+	page.setColors(thermometerColor, thermometerColor)
+	page.setLineWidth(cellSize * 0.28)
+	for _, thermometer := range geometry.Thermometers {
+		if len(thermometer) < 1 {
+			continue
+		}
+
+		page.drawCircle(offsetPoints(origin, thermometer[0])[0], cellSize*0.38, true)
+		page.strokePolyline(offsetPoints(origin, thermometer...))
+	}
+
+	page.setColors(arrowColor, arrowColor)
+	page.setLineWidth(math.Max(0.5, cellSize*0.04))
+	for _, arrow := range geometry.Arrows {
+		page.drawCircle(offsetPoints(origin, arrow.Circle)[0], arrow.Radius, false)
+		page.strokePolyline(offsetPoints(origin, arrow.Path...))
+		if len(arrow.Path) > 1 {
+			page.strokePolyline(offsetPoints(origin, arrow.Head[:]...))
+		}
+	}
+}
+
+// drawPdfCell draws value of the cell, or its candidates if the cell is empty
+func drawPdfCell(page *pdfPage, cell *CellGeometry, cellSize float64, origin Point) {
+	center := offsetPoints(origin, cell.Center)[0]
+	if len(cell.Symbol) > 0 {
+		color, font := solvedColor, pdfRegularFont
+		if cell.IsGiven {
+			color, font = givenColor, pdfBoldFont
+		}
+
+		if cell.ViolatesRule {
+			color = violationColor
+		}
+
+		size := cellSize * 0.6
+		if width := getPdfTextWidth(cell.Symbol, size); width > cellSize*0.8 {
+			size = size * cellSize * 0.8 / width
+		}
+
+		page.setColors(color, color)
+		page.drawCenteredText(cell.Symbol, font, size, center)
+		return
+	}
+
+	page.setColors(candidateColor, candidateColor)
+	for _, candidate := range cell.Candidates {
+		page.drawCenteredText(candidate.Symbol, pdfRegularFont, candidate.Size*0.7,
+			offsetPoints(origin, candidate.Center)[0])
+	}
+}
+
+// offsetPoints moves points of the grid drawing to the position of the grid on the page
+func offsetPoints(origin Point, points ...Point) []Point {
+	moved := []Point{}
+	for _, point := range points {
+		moved = append(moved, Point{point.X + origin.X, point.Y + origin.Y})
+	}
+
+	return moved
+}
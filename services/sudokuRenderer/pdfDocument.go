@@ -0,0 +1,238 @@
+package sudokuRenderer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// size of A4 page, in points
+const (
+	pdfPageWidth  = 595.0
+	pdfPageHeight = 842.0
+)
+
+// fonts of the document, standard PDF fonts are used so no font files are embedded
+const (
+	pdfRegularFont = "F1"
+	pdfBoldFont    = "F2"
+)
+
+// height of capital letters of Helvetica font, relative to the font size
+const pdfCapHeight = 0.718
+
+// helveticaWidths are widths of ASCII characters (from space to tilde) of
+// Helvetica font, in thousandths of the font size. Bold font is measured with
+// the same widths, which is close enough to center symbols and titles.
+var helveticaWidths = []int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+// pdfDocument is a minimal PDF writer - A4 pages with vector drawings and text
+type pdfDocument struct {
+	pages []*pdfPage
+}
+
+// pdfPage collects drawing operators of a single page. Coordinates of drawing
+// methods have the origin in top left corner of the page, y axis goes down.
+type pdfPage struct {
+	content strings.Builder
+	// current state of the graphics, so unchanged state is not written again
+	colors    string
+	lineWidth string
+}
+
+// addPage starts a new page of the document
+func (document *pdfDocument) addPage() *pdfPage {
+	page := &pdfPage{}
+	document.pages = append(document.pages, page)
+	return page
+}
+
+// write writes the whole document in PDF format
+func (document *pdfDocument) write(writer io.Writer) error {
+	buffer := &bytes.Buffer{}
+	offsets := []int{}
+	startObject := func() int {
+		offsets = append(offsets, buffer.Len())
+		fmt.Fprintf(buffer, "%d 0 obj\n", len(offsets))
+		return len(offsets)
+	}
+
+	buffer.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// catalog and pages tree are the first objects, fonts follow them and then
+	// every page is followed by its content stream
+	pageIds := []string{}
+	for index := range document.pages {
+		pageIds = append(pageIds, fmt.Sprintf("%d 0 R", 5+2*index))
+	}
+
+	startObject()
+	buffer.WriteString("<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	startObject()
+	fmt.Fprintf(buffer, "<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n",
+		strings.Join(pageIds, " "), len(document.pages))
+
+	for _, fontName := range []string{"Helvetica", "Helvetica-Bold"} {
+		startObject()
+		fmt.Fprintf(buffer, "<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>\nendobj\n",
+			fontName)
+	}
+
+	for _, page := range document.pages {
+		pageId := startObject()
+		fmt.Fprintf(buffer, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] "+
+			"/Resources << /Font << /%s 3 0 R /%s 4 0 R >> >> /Contents %d 0 R >>\nendobj\n",
+			formatNumber(pdfPageWidth), formatNumber(pdfPageHeight), pdfRegularFont, pdfBoldFont, pageId+1)
+
+		compressed := &bytes.Buffer{}
+		compressor := zlib.NewWriter(compressed)
+		compressor.Write([]byte(page.content.String()))
+		compressor.Close()
+
+		startObject()
+		fmt.Fprintf(buffer, "<< /Length %d /Filter /FlateDecode >>\nstream\n", compressed.Len())
+		buffer.Write(compressed.Bytes())
+		buffer.WriteString("\nendstream\nendobj\n")
+	}
+
+	crossReferenceOffset := buffer.Len()
+	fmt.Fprintf(buffer, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(buffer, "%010d 00000 n \n", offset)
+	}
+
+	fmt.Fprintf(buffer, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(offsets)+1, crossReferenceOffset)
+
+	_, err := writer.Write(buffer.Bytes())
+	return err
+}
+
+// setColors changes stroke and fill color, in #rrggbb notation
+func (page *pdfPage) setColors(strokeColor string, fillColor string) {
+	stroke, fill := parseColor(strokeColor), parseColor(fillColor)
+	colors := fmt.Sprintf("%s %s %s RG %s %s %s rg\n",
+		formatColorComponent(stroke.R), formatColorComponent(stroke.G), formatColorComponent(stroke.B),
+		formatColorComponent(fill.R), formatColorComponent(fill.G), formatColorComponent(fill.B))
+	if colors != page.colors {
+		page.colors = colors
+		page.content.WriteString(colors)
+	}
+}
+
+// setLineWidth changes width of lines, lines have rounded ends and joins
+func (page *pdfPage) setLineWidth(width float64) {
+	lineWidth := fmt.Sprintf("%s w 1 J 1 j\n", formatNumber(width))
+	if lineWidth != page.lineWidth {
+		page.lineWidth = lineWidth
+		page.content.WriteString(lineWidth)
+	}
+}
+
+// strokePolyline draws connected lines
+func (page *pdfPage) strokePolyline(points []Point) {
+	if len(points) < 2 {
+		return
+	}
+
+	for index, point := range points {
+		operator := "l"
+		if index == 0 {
+			operator = "m"
+		}
+
+		fmt.Fprintf(&page.content, "%s %s %s\n",
+			formatNumber(point.X), formatNumber(pdfPageHeight-point.Y), operator)
+	}
+
+	page.content.WriteString("S\n")
+}
+
+// drawCircle draws a circle with four Bezier curves, the circle is
+// stroked with current line and filled if requested
+func (page *pdfPage) drawCircle(center Point, radius float64, fill bool) {
+	control := radius * 0.5523
+	x, y := center.X, pdfPageHeight-center.Y
+	fmt.Fprintf(&page.content, "%s %s m\n", formatNumber(x+radius), formatNumber(y))
+	curves := [][6]float64{
+		{x + radius, y + control, x + control, y + radius, x, y + radius},
+		{x - control, y + radius, x - radius, y + control, x - radius, y},
+		{x - radius, y - control, x - control, y - radius, x, y - radius},
+		{x + control, y - radius, x + radius, y - control, x + radius, y},
+	}
+
+	for _, curve := range curves {
+		fmt.Fprintf(&page.content, "%s %s %s %s %s %s c\n", formatNumber(curve[0]), formatNumber(curve[1]),
+			formatNumber(curve[2]), formatNumber(curve[3]), formatNumber(curve[4]), formatNumber(curve[5]))
+	}
+
+	if fill {
+		page.content.WriteString("f\n")
+	} else {
+		page.content.WriteString("S\n")
+	}
+}
+
+// drawText draws text with its left end of the baseline at provided point
+func (page *pdfPage) drawText(text string, font string, size float64, position Point) {
+	fmt.Fprintf(&page.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n", font, formatNumber(size),
+		formatNumber(position.X), formatNumber(pdfPageHeight-position.Y), encodePdfText(text))
+}
+
+// drawCenteredText draws text with capital letters centered at provided point
+func (page *pdfPage) drawCenteredText(text string, font string, size float64, center Point) {
+	page.drawText(text, font, size, Point{
+		X: center.X - getPdfTextWidth(text, size)/2,
+		Y: center.Y + pdfCapHeight*size/2,
+	})
+}
+
+// getPdfTextWidth returns width of the text written with provided font size
+func getPdfTextWidth(text string, size float64) float64 {
+	width := 0
+	for _, character := range text {
+		if character >= ' ' && character <= '~' {
+			width += helveticaWidths[character-' ']
+		} else {
+			width += 556
+		}
+	}
+
+	return float64(width) * size / 1000
+}
+
+// encodePdfText converts text to PDF string content. Characters outside of
+// Latin-1 range can not be written with standard fonts and are replaced.
+func encodePdfText(text string) string {
+	builder := &strings.Builder{}
+	for _, character := range text {
+		switch {
+		case character == '\\' || character == '(' || character == ')':
+			builder.WriteRune('\\')
+			builder.WriteRune(character)
+		case character >= ' ' && character <= '~':
+			builder.WriteRune(character)
+		case character >= 0xa0 && character <= 0xff:
+			fmt.Fprintf(builder, "\\%03o", character)
+		default:
+			builder.WriteRune('?')
+		}
+	}
+
+	return builder.String()
+}
+
+// formatColorComponent formats color component as a number from 0 to 1
+func formatColorComponent(component uint8) string {
+	return formatNumber(math.Round(float64(component)/255*1000) / 1000)
+}
@@ -0,0 +1,234 @@
+package sudokuRenderer
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sizes of the drawing elements, in pixels
+const (
+	pngCellSize       = 48.0
+	pngMargin         = 12.0
+	pngThinLineWidth  = 1.0
+	pngThickLineWidth = 3.0
+)
+
+// pngCanvas is an RGBA image with anti-aliased drawing of lines and circles.
+// Coordinates are moved by the offset, so the drawing has margins.
+type pngCanvas struct {
+	image  *image.RGBA
+	offset Point
+}
+
+// RenderPng draws the sudoku as PNG image, using the same layout and colors
+// as the SVG image. Text is drawn with built-in stroke font, so no font files
+// are needed. Returns an error if symbols of the sudoku alphabet are not supported
+// by the font.
+func (renderer *SudokuRenderer) RenderPng(writer io.Writer, sudoku *models.Sudoku,
+	options RenderOptions) error {
+
+	if err := validateStrokeFontSymbols(sudoku.GetSymbolAlphabet()); err != nil {
+		return err
+	}
+
+	geometry := renderer.GetGeometry(sudoku, pngCellSize, options)
+	width := int(math.Ceil(geometry.Width + 2*pngMargin))
+	height := int(math.Ceil(geometry.Height + 2*pngMargin))
+
+	canvas := &pngCanvas{
+		image:  image.NewRGBA(image.Rect(0, 0, width, height)),
+		offset: Point{pngMargin, pngMargin},
+	}
+
+	canvas.fill(parseColor("#ffffff"))
+	drawPngConstraints(canvas, geometry)
+
+	for _, border := range geometry.Borders {
+		color, width := parseColor(thinBorderColor), pngThinLineWidth
+		if border.Thick {
+			color, width = parseColor(borderColor), pngThickLineWidth
+		}
+
+		canvas.drawLine(border.From, border.To, width, color)
+	}
+
+	for _, comparison := range geometry.Comparisons {
+		color := parseColor(borderColor)
+		if comparison.ViolatesRule {
+			color = parseColor(violationColor)
+		}
+
+		canvas.drawPolyline(comparison.Points[:], 2, color)
+	}
+
+	for _, cell := range geometry.Cells {
+		drawPngCell(canvas, cell, geometry.CellSize)
+	}
+
+	if err := png.Encode(writer, canvas.image); err != nil {
+		return models.WithKind(models.ErrIO, err)
+	}
+
+	return nil
+}
+
+// drawPngConstraints draws thermometers and arrows, below the grid lines
+func drawPngConstraints(canvas *pngCanvas, geometry *SudokuGeometry) {
+	cellSize := geometry.CellSize
+
+	for _, thermometer := range geometry.Thermometers {
+		if len(thermometer) < 1 {
+			continue
+		}
+
+		canvas.fillCircle(thermometer[0], cellSize*0.38, parseColor(thermometerColor))
+		canvas.drawPolyline(thermometer, cellSize*0.28, parseColor(thermometerColor))
+	}
+
+	for _, arrow := range geometry.Arrows {
+		canvas.strokeCircle(arrow.Circle, arrow.Radius, 2, parseColor(arrowColor))
+		if len(arrow.Path) < 2 {
+			continue
+		}
+
+		canvas.drawPolyline(arrow.Path, 2, parseColor(arrowColor))
+		canvas.drawPolyline(arrow.Head[:], 2, parseColor(arrowColor))
+	}
+}
+
+// drawPngCell draws value of the cell, or its candidates if the cell is empty
+func drawPngCell(canvas *pngCanvas, cell *CellGeometry, cellSize float64) {
+	if len(cell.Symbol) > 0 {
+		color, width := parseColor(solvedColor), cellSize*0.045
+		if cell.IsGiven {
+			color, width = parseColor(givenColor), cellSize*0.075
+		}
+
+		if cell.ViolatesRule {
+			color = parseColor(violationColor)
+		}
+
+		for _, stroke := range getTextStrokes(cell.Symbol, cell.Center, cellSize*0.45, cellSize*0.7) {
+			canvas.drawPolyline(stroke, width, color)
+		}
+
+		return
+	}
+
+	for _, candidate := range cell.Candidates {
+		strokes := getTextStrokes(candidate.Symbol, candidate.Center, candidate.Size*0.55, candidate.Size*0.8)
+		for _, stroke := range strokes {
+			canvas.drawPolyline(stroke, math.Max(1, candidate.Size*0.08), parseColor(candidateColor))
+		}
+	}
+}
+
+// fill paints the whole image with provided color
+func (canvas *pngCanvas) fill(fillColor color.RGBA) {
+	bounds := canvas.image.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			canvas.image.SetRGBA(x, y, fillColor)
+		}
+	}
+}
+
+// drawPolyline draws connected lines with rounded joins
+func (canvas *pngCanvas) drawPolyline(points []Point, width float64, lineColor color.RGBA) {
+	if len(points) == 1 {
+		canvas.fillCircle(points[0], width/2, lineColor)
+	}
+
+	for index := 1; index < len(points); index++ {
+		canvas.drawLine(points[index-1], points[index], width, lineColor)
+	}
+}
+
+// drawLine draws a line with rounded ends
+func (canvas *pngCanvas) drawLine(from Point, to Point, width float64, lineColor color.RGBA) {
+	canvas.paint(math.Min(from.X, to.X), math.Min(from.Y, to.Y),
+		math.Max(from.X, to.X), math.Max(from.Y, to.Y), width/2+1, lineColor,
+		func(point Point) float64 {
+			return width/2 - getSegmentDistance(point, from, to)
+		})
+}
+
+// fillCircle draws a filled circle
+func (canvas *pngCanvas) fillCircle(center Point, radius float64, fillColor color.RGBA) {
+	canvas.paint(center.X, center.Y, center.X, center.Y, radius+1, fillColor,
+		func(point Point) float64 {
+			return radius - math.Hypot(point.X-center.X, point.Y-center.Y)
+		})
+}
+
+// strokeCircle draws an outline of a circle
+func (canvas *pngCanvas) strokeCircle(center Point, radius float64, width float64, lineColor color.RGBA) {
+	canvas.paint(center.X, center.Y, center.X, center.Y, radius+width/2+1, lineColor,
+		func(point Point) float64 {
+			return width/2 - math.Abs(math.Hypot(point.X-center.X, point.Y-center.Y)-radius)
+		})
+}
+
+// paint blends the color into pixels of the area extended by the margin. Coverage
+// function returns distance of the pixel center inside the shape, pixels closer
+// than half a pixel to the edge of the shape are partially covered.
+func (canvas *pngCanvas) paint(left float64, top float64, right float64, bottom float64,
+	margin float64, paintColor color.RGBA, coverage func(point Point) float64) {
+
+	bounds := canvas.image.Bounds()
+	minimumX := max(bounds.Min.X, int(math.Floor(left+canvas.offset.X-margin)))
+	maximumX := min(bounds.Max.X-1, int(math.Ceil(right+canvas.offset.X+margin)))
+	minimumY := max(bounds.Min.Y, int(math.Floor(top+canvas.offset.Y-margin)))
+	maximumY := min(bounds.Max.Y-1, int(math.Ceil(bottom+canvas.offset.Y+margin)))
+
+	for y := minimumY; y <= maximumY; y++ {
+		for x := minimumX; x <= maximumX; x++ {
+			point := Point{float64(x) + 0.5 - canvas.offset.X, float64(y) + 0.5 - canvas.offset.Y}
+			alpha := math.Min(1, coverage(point)+0.5)
+			if alpha <= 0 {
+				continue
+			}
+
+			canvas.image.SetRGBA(x, y, blendColors(canvas.image.RGBAAt(x, y), paintColor, alpha))
+		}
+	}
+}
+
+// getSegmentDistance returns distance of the point from the line segment
+func getSegmentDistance(point Point, from Point, to Point) float64 {
+	deltaX, deltaY := to.X-from.X, to.Y-from.Y
+	lengthSquared := deltaX*deltaX + deltaY*deltaY
+	if lengthSquared == 0 {
+		return math.Hypot(point.X-from.X, point.Y-from.Y)
+	}
+
+	ratio := ((point.X-from.X)*deltaX + (point.Y-from.Y)*deltaY) / lengthSquared
+	ratio = math.Max(0, math.Min(1, ratio))
+	return math.Hypot(point.X-(from.X+ratio*deltaX), point.Y-(from.Y+ratio*deltaY))
+}
+
+// blendColors mixes the color into the background color with provided opacity
+func blendColors(background color.RGBA, foreground color.RGBA, alpha float64) color.RGBA {
+	mix := func(backgroundValue uint8, foregroundValue uint8) uint8 {
+		return uint8(math.Round(float64(backgroundValue)*(1-alpha) + float64(foregroundValue)*alpha))
+	}
+
+	return color.RGBA{
+		R: mix(background.R, foreground.R),
+		G: mix(background.G, foreground.G),
+		B: mix(background.B, foreground.B),
+		A: 255,
+	}
+}
+
+// parseColor converts color in #rrggbb notation to RGBA color
+func parseColor(hexColor string) color.RGBA {
+	value, _ := strconv.ParseUint(hexColor[1:], 16, 32)
+	return color.RGBA{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value), A: 255}
+}
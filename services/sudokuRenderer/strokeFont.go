@@ -0,0 +1,150 @@
+package sudokuRenderer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sizes of the stroke font glyph grid
+const (
+	glyphWidth   = 4.0
+	glyphHeight  = 6.0
+	glyphSpacing = 2.0
+)
+
+// strokeGlyphs defines glyphs of the font used for PNG images as polylines on
+// glyph grid. Polylines are separated with semicolons, points with spaces.
+var strokeGlyphs = map[rune]string{
+	'0': "0,0 4,0 4,6 0,6 0,0;4,0 0,6",
+	'1': "1,1 2,0 2,6;1,6 3,6",
+	'2': "0,0 4,0 4,3 0,3 0,6 4,6",
+	'3': "0,0 4,0 4,6 0,6;0,3 4,3",
+	'4': "0,0 0,3 4,3;4,0 4,6",
+	'5': "4,0 0,0 0,3 4,3 4,6 0,6",
+	'6': "4,0 0,0 0,6 4,6 4,3 0,3",
+	'7': "0,0 4,0 2,6",
+	'8': "0,0 4,0 4,6 0,6 0,0;0,3 4,3",
+	'9': "4,3 0,3 0,0 4,0 4,6 0,6",
+	'A': "0,6 0,2 2,0 4,2 4,6;0,3 4,3",
+	'B': "0,0 3,0 4,1 4,2 3,3 0,3;3,3 4,4 4,5 3,6 0,6 0,0",
+	'C': "4,0 0,0 0,6 4,6",
+	'D': "0,0 3,0 4,1 4,5 3,6 0,6 0,0",
+	'E': "4,0 0,0 0,6 4,6;0,3 3,3",
+	'F': "4,0 0,0 0,6;0,3 3,3",
+	'G': "4,0 0,0 0,6 4,6 4,3 2,3",
+	'H': "0,0 0,6;4,0 4,6;0,3 4,3",
+	'I': "1,0 3,0;2,0 2,6;1,6 3,6",
+	'J': "4,0 4,6 0,6 0,4",
+	'K': "0,0 0,6;4,0 0,3 4,6",
+	'L': "0,0 0,6 4,6",
+	'M': "0,6 0,0 2,3 4,0 4,6",
+	'N': "0,6 0,0 4,6 4,0",
+	'O': "0,0 4,0 4,6 0,6 0,0",
+	'P': "0,6 0,0 4,0 4,3 0,3",
+	'Q': "0,0 4,0 4,6 0,6 0,0;2,4 4,6",
+	'R': "0,6 0,0 4,0 4,3 0,3 4,6",
+	'S': "4,0 1,0 0,1 0,2 1,3 3,3 4,4 4,5 3,6 0,6",
+	'T': "0,0 4,0;2,0 2,6",
+	'U': "0,0 0,6 4,6 4,0",
+	'V': "0,0 2,6 4,0",
+	'W': "0,0 1,6 2,3 3,6 4,0",
+	'X': "0,0 4,6;4,0 0,6",
+	'Y': "0,0 2,3 4,0;2,3 2,6",
+	'Z': "0,0 4,0 0,6 4,6",
+	'+': "0,3 4,3;2,1 2,5",
+	'-': "0,3 4,3",
+	'*': "0,1 4,5;4,1 0,5;2,0 2,6",
+	'#': "1,0 1,6;3,0 3,6;0,2 4,2;0,4 4,4",
+	'?': "0,1 0,0 4,0 4,3 2,3 2,4;2,5 2,6",
+}
+
+// validateStrokeFontSymbols checks if every symbol of the alphabet can be drawn with
+// the stroke font and no two symbols are drawn with the same glyph. Lowercase and
+// uppercase letters share glyphs. Nil alphabet (decimal numbers) is always valid.
+func validateStrokeFontSymbols(alphabet *models.SymbolAlphabet) error {
+	if alphabet == nil {
+		return nil
+	}
+
+	glyphSymbols := map[rune]rune{}
+	for _, symbol := range alphabet.Symbols {
+		glyph := unicode.ToUpper(symbol)
+		if _, ok := strokeGlyphs[glyph]; !ok || symbol > unicode.MaxASCII {
+			return models.WithKind(models.ErrInvalidInput, fmt.Errorf(
+				"symbol '%c' of alphabet '%s' cannot be drawn in PNG image, supported symbols "+
+					"are digits, Latin letters and + * # ?", symbol, alphabet.Specification))
+		}
+
+		if otherSymbol, ok := glyphSymbols[glyph]; ok {
+			return models.WithKind(models.ErrInvalidInput, fmt.Errorf(
+				"symbols '%c' and '%c' of alphabet '%s' are drawn the same way in PNG image",
+				otherSymbol, symbol, alphabet.Specification))
+		}
+
+		glyphSymbols[glyph] = symbol
+	}
+
+	return nil
+}
+
+// getGlyphStrokes returns polylines of the character glyph, positioned on the
+// glyph grid. Lowercase letters are drawn as uppercase letters and characters
+// not defined in the font are drawn as question mark.
+func getGlyphStrokes(character rune) [][]Point {
+	definition, ok := strokeGlyphs[unicode.ToUpper(character)]
+	if !ok {
+		definition = strokeGlyphs['?']
+	}
+
+	strokes := [][]Point{}
+	for _, strokeDefinition := range strings.Split(definition, ";") {
+		stroke := []Point{}
+		for _, pointDefinition := range strings.Fields(strokeDefinition) {
+			coordinates := strings.Split(pointDefinition, ",")
+			x, _ := strconv.ParseFloat(coordinates[0], 64)
+			y, _ := strconv.ParseFloat(coordinates[1], 64)
+			stroke = append(stroke, Point{x, y})
+		}
+
+		strokes = append(strokes, stroke)
+	}
+
+	return strokes
+}
+
+// getTextStrokes returns polylines of the text centered at provided point. Text
+// is scaled to the provided height, or smaller if it does not fit maximum width.
+func getTextStrokes(text string, center Point, height float64, maximumWidth float64) [][]Point {
+	characters := []rune(text)
+	if len(characters) < 1 {
+		return nil
+	}
+
+	textWidth := float64(len(characters))*glyphWidth + float64(len(characters)-1)*glyphSpacing
+	scale := height / glyphHeight
+	if textWidth*scale > maximumWidth {
+		scale = maximumWidth / textWidth
+	}
+
+	left := center.X - textWidth*scale/2
+	top := center.Y - glyphHeight*scale/2
+
+	strokes := [][]Point{}
+	for index, character := range characters {
+		offset := left + float64(index)*(glyphWidth+glyphSpacing)*scale
+		for _, stroke := range getGlyphStrokes(character) {
+			scaledStroke := []Point{}
+			for _, point := range stroke {
+				scaledStroke = append(scaledStroke, Point{offset + point.X*scale, top + point.Y*scale})
+			}
+
+			strokes = append(strokes, scaledStroke)
+		}
+	}
+
+	return strokes
+}
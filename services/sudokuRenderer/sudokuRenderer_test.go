@@ -0,0 +1,487 @@
+package sudokuRenderer
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestGetGeometry(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		sudoku               *models.SudokuDTO
+		expectedWidth        float64
+		expectedCells        int
+		expectedThickBorders int
+		expectedThinBorders  int
+	}{
+		{
+			name:                 "Classic sudoku",
+			sudoku:               models.NewEmptySudokuDTO(3, 3, 3),
+			expectedWidth:        90,
+			expectedCells:        81,
+			expectedThickBorders: 36,
+			expectedThinBorders:  36,
+		},
+		{
+			name:                 "Samurai sudoku",
+			sudoku:               newSamuraiSudoku(),
+			expectedWidth:        210,
+			expectedCells:        369,
+			expectedThickBorders: 164,
+			expectedThinBorders:  164,
+		},
+		{
+			name:                 "Rectangular layout",
+			sudoku:               models.NewEmptySudokuDTO(2, 3, 1),
+			expectedWidth:        60,
+			expectedCells:        12,
+			expectedThickBorders: 12,
+			expectedThinBorders:  6,
+		},
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		geometry := renderer.GetGeometry(testCase.sudoku.ToSudoku(), 10, RenderOptions{})
+
+		if geometry.Width != testCase.expectedWidth {
+			t.Errorf("%s: Expected width %v, got %v", testCase.name, testCase.expectedWidth, geometry.Width)
+		}
+
+		if len(geometry.Cells) != testCase.expectedCells {
+			t.Errorf("%s: Expected %d cells, got %d", testCase.name, testCase.expectedCells, len(geometry.Cells))
+		}
+
+		thickBorders, thinBorders := 0, 0
+		for _, border := range geometry.Borders {
+			if border.Thick {
+				thickBorders++
+			} else {
+				thinBorders++
+			}
+		}
+
+		if thickBorders != testCase.expectedThickBorders || thinBorders != testCase.expectedThinBorders {
+			t.Errorf("%s: Expected %d thick and %d thin borders, got %d and %d", testCase.name,
+				testCase.expectedThickBorders, testCase.expectedThinBorders, thickBorders, thinBorders)
+		}
+
+		for _, cell := range geometry.Cells {
+			box := testCase.sudoku.Boxes.FirstOrDefault(nil, func(box *models.SudokuBoxDTO) bool {
+				return int(box.IndexRow) == cell.Position.Row/int(testCase.sudoku.BoxSize) &&
+					int(box.IndexColumn) == cell.Position.Column/int(testCase.sudoku.BoxSize)
+			})
+
+			if box == nil || box.Disabled {
+				t.Errorf("%s: Cell %v is not a cell of enabled box", testCase.name, cell.Position)
+				break
+			}
+		}
+	}
+}
+
+func TestGetGeometry_Candidates(t *testing.T) {
+	sudoku := models.NewEmptySudokuDTO(3, 3, 3)
+	for index, cell := range sudoku.Boxes[0].Cells[:7] {
+		value := index + 1
+		cell.Value = &value
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	geometry := renderer.GetGeometry(sudoku.ToSudoku(), 30, RenderOptions{Candidates: true})
+	candidates := []string{}
+	for _, candidate := range geometry.Cells[7].Candidates {
+		candidates = append(candidates, candidate.Symbol)
+	}
+
+	if strings.Join(candidates, ",") != "8,9" {
+		t.Errorf("Expected candidates 8,9, got %v", candidates)
+	}
+
+	if len(geometry.Cells[0].Candidates) > 0 {
+		t.Errorf("Expected no candidates of the cell with value, got %d", len(geometry.Cells[0].Candidates))
+	}
+
+	geometry = renderer.GetGeometry(sudoku.ToSudoku(), 30, RenderOptions{})
+	if len(geometry.Cells[7].Candidates) > 0 {
+		t.Errorf("Expected no candidates if not requested, got %d", len(geometry.Cells[7].Candidates))
+	}
+}
+
+func TestRenderSvg(t *testing.T) {
+	sudokuDto := models.NewEmptySudokuDTO(3, 3, 3)
+	given, solved := 5, 7
+	sudokuDto.Boxes[0].Cells[0].Value = &given
+	sudoku := sudokuDto.ToSudoku()
+	sudoku.Boxes[0].Cells[1].Value = &solved
+
+	buffer := &bytes.Buffer{}
+	err := GetNewSudokuRenderer(testHelpers.GetTestSettings()).RenderSvg(buffer, sudoku, RenderOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error %s", err)
+	}
+
+	expectedContent := []string{
+		`<svg xmlns="http://www.w3.org/2000/svg" width="456" height="456"`,
+		`font-weight="bold" fill="#000000">5</text>`,
+		`font-weight="normal" fill="#1c5fb0">7</text>`,
+		`stroke-width="3"`,
+	}
+
+	for _, expected := range expectedContent {
+		if !strings.Contains(buffer.String(), expected) {
+			t.Errorf("SVG image is missing the following: '%s'", expected)
+		}
+	}
+}
+
+func TestRenderPng(t *testing.T) {
+	testCases := []struct {
+		name          string
+		sudoku        *models.SudokuDTO
+		expectedWidth int
+		darkPixels    [][2]int
+		brightPixels  [][2]int
+	}{
+		{
+			name:          "Classic sudoku",
+			sudoku:        models.NewEmptySudokuDTO(3, 3, 3),
+			expectedWidth: 456,
+			darkPixels:    [][2]int{{12, 100}, {156, 300}, {300, 156}},
+			brightPixels:  [][2]int{{5, 5}, {40, 40}},
+		},
+		{
+			name:          "Samurai sudoku",
+			sudoku:        newSamuraiSudoku(),
+			expectedWidth: 1032,
+			darkPixels:    [][2]int{{444, 100}, {588, 100}},
+			brightPixels:  [][2]int{{516, 60}, {60, 516}},
+		},
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		buffer := &bytes.Buffer{}
+		if err := renderer.RenderPng(buffer, testCase.sudoku.ToSudoku(), RenderOptions{}); err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		image, err := png.Decode(buffer)
+		if err != nil {
+			t.Errorf("%s: Invalid PNG image %s", testCase.name, err)
+			continue
+		}
+
+		if image.Bounds().Dx() != testCase.expectedWidth || image.Bounds().Dy() != testCase.expectedWidth {
+			t.Errorf("%s: Expected image size %d, got %v", testCase.name, testCase.expectedWidth, image.Bounds())
+		}
+
+		for _, pixel := range testCase.darkPixels {
+			if red, _, _, _ := image.At(pixel[0], pixel[1]).RGBA(); red > 0x4000 {
+				t.Errorf("%s: Expected dark pixel at %v", testCase.name, pixel)
+			}
+		}
+
+		for _, pixel := range testCase.brightPixels {
+			if red, _, _, _ := image.At(pixel[0], pixel[1]).RGBA(); red < 0xf000 {
+				t.Errorf("%s: Expected bright pixel at %v", testCase.name, pixel)
+			}
+		}
+	}
+}
+
+func TestRenderPng_Alphabets(t *testing.T) {
+	testCases := []struct {
+		name         string
+		alphabet     string
+		expectsError bool
+	}{
+		{name: "Default values", alphabet: ""},
+		{name: "Uppercase letters", alphabet: "A-I"},
+		{name: "Lowercase letters", alphabet: "a-i"},
+		{name: "Symbols", alphabet: "1-6+*#"},
+		{name: "Non-Latin letters", alphabet: "α-ι", expectsError: true},
+		{name: "Unsupported symbols", alphabet: "!@#$%^&*(", expectsError: true},
+		{name: "Letters of both cases", alphabet: "A-Ea-d", expectsError: true},
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		sudoku := models.NewEmptySudokuDTO(3, 3, 3).ToSudoku()
+		sudoku.Alphabet = testCase.alphabet
+
+		err := renderer.RenderPng(&bytes.Buffer{}, sudoku, RenderOptions{})
+		if testCase.expectsError && !errors.Is(err, models.ErrInvalidInput) {
+			t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+		}
+
+		if !testCase.expectsError && err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+		}
+	}
+}
+
+func TestRenderHtml(t *testing.T) {
+	solvedSudoku := models.NewEmptySudokuDTO(3, 3, 3)
+	for boxIndex, box := range solvedSudoku.Boxes {
+		for cellIndex, cell := range box.Cells {
+			row := (boxIndex/3)*3 + cellIndex/3
+			column := (boxIndex%3)*3 + cellIndex%3
+			value := (row*3+row/3+column)%9 + 1
+			cell.Value = &value
+		}
+	}
+
+	testCases := []struct {
+		name           string
+		sudoku         *models.Sudoku
+		options        RenderOptions
+		expectedInputs int
+		expectedHouses int
+		expectsCheck   bool
+		expectsError   bool
+	}{
+		{
+			name:           "Classic sudoku",
+			sudoku:         models.NewEmptySudokuDTO(3, 3, 3).ToSudoku(),
+			expectedInputs: 81,
+			expectedHouses: 27,
+		},
+		{
+			name: "Solved sudoku",
+			sudoku: func() *models.Sudoku {
+				sudoku := solvedSudoku.ToSudoku()
+				for _, box := range sudoku.Boxes[1:] {
+					for _, cell := range box.Cells {
+						cell.IsInputValue = false
+					}
+				}
+
+				return sudoku
+			}(),
+			options:        RenderOptions{Solution: true},
+			expectedInputs: 81,
+			expectedHouses: 27,
+			expectsCheck:   true,
+		},
+		{
+			name:           "Solved sudoku without solution option",
+			sudoku:         solvedSudoku.ToSudoku(),
+			expectedInputs: 81,
+			expectedHouses: 27,
+		},
+		{
+			name:         "Solution option of not solved sudoku",
+			sudoku:       models.NewEmptySudokuDTO(3, 3, 3).ToSudoku(),
+			options:      RenderOptions{Solution: true},
+			expectsError: true,
+		},
+		{
+			name:           "Samurai sudoku",
+			sudoku:         newSamuraiSudoku().ToSudoku(),
+			expectedInputs: 41 * 9,
+			expectedHouses: 41 + 5*18,
+		},
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		buffer := &bytes.Buffer{}
+		err := renderer.RenderHtml(buffer, testCase.sudoku, testCase.options)
+		if testCase.expectsError {
+			if !errors.Is(err, models.ErrInvalidInput) {
+				t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		page := buffer.String()
+		if inputs := strings.Count(page, "<input "); inputs != testCase.expectedInputs {
+			t.Errorf("%s: Expected %d inputs, got %d", testCase.name, testCase.expectedInputs, inputs)
+		}
+
+		data := regexp.MustCompile(`id="puzzle">(.*)</script>`).FindStringSubmatch(page)
+		puzzle := &htmlPuzzle{}
+		if len(data) < 2 || json.Unmarshal([]byte(data[1]), puzzle) != nil {
+			t.Errorf("%s: Page is missing puzzle data", testCase.name)
+			continue
+		}
+
+		if len(puzzle.Houses) != testCase.expectedHouses {
+			t.Errorf("%s: Expected %d houses, got %d", testCase.name, testCase.expectedHouses, len(puzzle.Houses))
+		}
+
+		hasCheck := strings.Contains(page, `id="check"`)
+		if hasCheck != testCase.expectsCheck || (puzzle.Solution != nil) != testCase.expectsCheck {
+			t.Errorf("%s: Expected check button %v, got %v", testCase.name, testCase.expectsCheck, hasCheck)
+		}
+
+		if testCase.expectsCheck && strings.Count(page, `value=""`) != 72 {
+			t.Errorf("%s: Expected values of the solution to be hidden", testCase.name)
+		}
+	}
+}
+
+func TestRenderPdfBooklet(t *testing.T) {
+	testCases := []struct {
+		name            string
+		puzzlesCount    int
+		solutionsCount  int
+		perPage         int
+		expectedPages   int
+		expectedContent []string
+	}{
+		{
+			name:            "Empty booklet",
+			perPage:         4,
+			expectedPages:   1,
+			expectedContent: []string{},
+		},
+		{
+			name:            "Puzzles only",
+			puzzlesCount:    5,
+			perPage:         4,
+			expectedPages:   2,
+			expectedContent: []string{"(Puzzles) Tj", "(puzzle 5) Tj", "(Difficulty: easy) Tj"},
+		},
+		{
+			name:            "Puzzles and solutions",
+			puzzlesCount:    3,
+			solutionsCount:  3,
+			perPage:         2,
+			expectedPages:   4,
+			expectedContent: []string{"(Puzzles) Tj", "(Solutions) Tj", "(puzzle 3) Tj", "(4) Tj"},
+		},
+	}
+
+	renderer := GetNewSudokuRenderer(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		newEntries := func(count int) []*BookletEntry {
+			entries := []*BookletEntry{}
+			for index := 0; index < count; index++ {
+				sudoku := newSamuraiSudoku()
+				if index%2 == 0 {
+					sudoku = models.NewEmptySudokuDTO(3, 3, 3)
+				}
+
+				entries = append(entries, &BookletEntry{
+					Title:  fmt.Sprintf("puzzle %d", index+1),
+					Label:  "Difficulty: easy",
+					Sudoku: sudoku.ToSudoku(),
+				})
+			}
+
+			return entries
+		}
+
+		buffer := &bytes.Buffer{}
+		err := renderer.RenderPdfBooklet(buffer, newEntries(testCase.puzzlesCount),
+			newEntries(testCase.solutionsCount), BookletOptions{Title: "Puzzles", PerPage: testCase.perPage})
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		document := buffer.String()
+		if !strings.HasPrefix(document, "%PDF-1.4") || !strings.HasSuffix(document, "%%EOF\n") {
+			t.Errorf("%s: Invalid PDF document header or trailer", testCase.name)
+		}
+
+		if !strings.Contains(document, fmt.Sprintf("/Count %d", testCase.expectedPages)) {
+			t.Errorf("%s: Expected %d pages in the document", testCase.name, testCase.expectedPages)
+		}
+
+		content := &strings.Builder{}
+		for _, stream := range regexp.MustCompile(`(?s)stream\n(.*?)\nendstream`).FindAllStringSubmatch(document, -1) {
+			reader, err := zlib.NewReader(strings.NewReader(stream[1]))
+			if err != nil {
+				t.Errorf("%s: Invalid page content stream %s", testCase.name, err)
+				continue
+			}
+
+			data, _ := io.ReadAll(reader)
+			content.Write(data)
+		}
+
+		for _, expectedContent := range testCase.expectedContent {
+			if !strings.Contains(content.String(), expectedContent) {
+				t.Errorf("%s: Document content is missing the following: '%s'", testCase.name, expectedContent)
+			}
+		}
+	}
+}
+
+func TestGetBookletSlots(t *testing.T) {
+	testCases := []struct {
+		count           int
+		expectedColumns int
+	}{
+		{count: 1, expectedColumns: 1},
+		{count: 2, expectedColumns: 1},
+		{count: 4, expectedColumns: 2},
+		{count: 6, expectedColumns: 2},
+		{count: 16, expectedColumns: 4},
+	}
+
+	for _, testCase := range testCases {
+		slots := getBookletSlots(testCase.count)
+		if len(slots) != testCase.count {
+			t.Errorf("%d slots: Expected %d slots, got %d", testCase.count, testCase.count, len(slots))
+			continue
+		}
+
+		columns := 0
+		for _, slot := range slots {
+			if slot.topLeft.Y == slots[0].topLeft.Y {
+				columns++
+			}
+
+			if slot.topLeft.X+slot.width > pdfPageWidth-pdfMargin+0.001 ||
+				slot.topLeft.Y+slot.height > pdfPageHeight-pdfMargin-pdfFooterHeight+0.001 {
+				t.Errorf("%d slots: Slot %v exceeds page content area", testCase.count, slot)
+			}
+		}
+
+		if columns != testCase.expectedColumns {
+			t.Errorf("%d slots: Expected %d columns, got %d", testCase.count, testCase.expectedColumns, columns)
+		}
+	}
+}
+
+// newSamuraiSudoku returns empty samurai sudoku - five classic grids
+// overlapping at corner boxes, with disabled boxes between the grids
+func newSamuraiSudoku() *models.SudokuDTO {
+	sudoku := models.NewEmptySudokuDTO(3, 7, 7)
+	for _, box := range sudoku.Boxes {
+		if (box.IndexColumn == 3 && (box.IndexRow < 2 || box.IndexRow > 4)) ||
+			(box.IndexRow == 3 && (box.IndexColumn < 2 || box.IndexColumn > 4)) {
+			box.Disabled = true
+		}
+	}
+
+	return sudoku
+}
@@ -0,0 +1,170 @@
+package sudokuRenderer
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// sizes of the drawing elements, in pixels
+const (
+	svgCellSize       = 48.0
+	svgMargin         = 12.0
+	svgThinLineWidth  = 1.0
+	svgThickLineWidth = 3.0
+)
+
+// colors of the drawing elements
+const (
+	borderColor      = "#000000"
+	thinBorderColor  = "#8c8c8c"
+	givenColor       = "#000000"
+	solvedColor      = "#1c5fb0"
+	violationColor   = "#c01c28"
+	candidateColor   = "#6e6e6e"
+	thermometerColor = "#d0d0d0"
+	arrowColor       = "#8c8c8c"
+)
+
+// RenderSvg draws the sudoku as SVG image. Givens are bold and black, solved
+// values are blue and values breaking the rules are red. Disabled boxes are blank.
+func (renderer *SudokuRenderer) RenderSvg(writer io.Writer, sudoku *models.Sudoku,
+	options RenderOptions) error {
+
+	geometry := renderer.GetGeometry(sudoku, svgCellSize, options)
+	width := geometry.Width + 2*svgMargin
+	height := geometry.Height + 2*svgMargin
+
+	builder := &strings.Builder{}
+	fmt.Fprintf(builder, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		formatNumber(width), formatNumber(height), formatNumber(width), formatNumber(height))
+	fmt.Fprintf(builder, `<rect width="100%%" height="100%%" fill="#ffffff"/>`+"\n")
+	fmt.Fprintf(builder, `<g transform="translate(%s %s)" font-family="Helvetica, Arial, sans-serif" text-anchor="middle" dominant-baseline="central">`+"\n",
+		formatNumber(svgMargin), formatNumber(svgMargin))
+
+	writeSvgGrid(builder, geometry)
+
+	for _, cell := range geometry.Cells {
+		writeSvgCell(builder, cell, geometry.CellSize)
+	}
+
+	builder.WriteString("</g>\n</svg>\n")
+
+	if _, err := io.WriteString(writer, builder.String()); err != nil {
+		return models.WithKind(models.ErrIO, err)
+	}
+
+	return nil
+}
+
+// writeSvgGrid draws constraints, borders of cells and boxes and comparison signs
+func writeSvgGrid(builder *strings.Builder, geometry *SudokuGeometry) {
+	writeSvgConstraints(builder, geometry)
+
+	for _, border := range geometry.Borders {
+		color, width := thinBorderColor, svgThinLineWidth
+		if border.Thick {
+			color, width = borderColor, svgThickLineWidth
+		}
+
+		fmt.Fprintf(builder, `<line x1="%s" y1="%s" x2="%s" y2="%s" stroke="%s" stroke-width="%s" stroke-linecap="square"/>`+"\n",
+			formatNumber(border.From.X), formatNumber(border.From.Y), formatNumber(border.To.X),
+			formatNumber(border.To.Y), color, formatNumber(width))
+	}
+
+	for _, comparison := range geometry.Comparisons {
+		color := borderColor
+		if comparison.ViolatesRule {
+			color = violationColor
+		}
+
+		fmt.Fprintf(builder, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+			formatPoints(comparison.Points[:]), color)
+	}
+}
+
+// writeSvgConstraints draws thermometers and arrows, below the grid lines
+func writeSvgConstraints(builder *strings.Builder, geometry *SudokuGeometry) {
+	cellSize := geometry.CellSize
+
+	for _, thermometer := range geometry.Thermometers {
+		if len(thermometer) < 1 {
+			continue
+		}
+
+		fmt.Fprintf(builder, `<circle cx="%s" cy="%s" r="%s" fill="%s"/>`+"\n",
+			formatNumber(thermometer[0].X), formatNumber(thermometer[0].Y),
+			formatNumber(cellSize*0.38), thermometerColor)
+		fmt.Fprintf(builder, `<polyline points="%s" fill="none" stroke="%s" stroke-width="%s" stroke-linecap="round" stroke-linejoin="round"/>`+"\n",
+			formatPoints(thermometer), thermometerColor, formatNumber(cellSize*0.28))
+	}
+
+	for _, arrow := range geometry.Arrows {
+		fmt.Fprintf(builder, `<circle cx="%s" cy="%s" r="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+			formatNumber(arrow.Circle.X), formatNumber(arrow.Circle.Y), formatNumber(arrow.Radius), arrowColor)
+
+		if len(arrow.Path) < 2 {
+			continue
+		}
+
+		fmt.Fprintf(builder, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2" stroke-linejoin="round"/>`+"\n",
+			formatPoints(arrow.Path), arrowColor)
+		fmt.Fprintf(builder, `<polyline points="%s" fill="none" stroke="%s" stroke-width="2"/>`+"\n",
+			formatPoints(arrow.Head[:]), arrowColor)
+	}
+}
+
+// writeSvgCell draws value of the cell, or its candidates if the cell is empty
+func writeSvgCell(builder *strings.Builder, cell *CellGeometry, cellSize float64) {
+	if len(cell.Symbol) > 0 {
+		color, weight := solvedColor, "normal"
+		if cell.IsGiven {
+			color, weight = givenColor, "bold"
+		}
+
+		if cell.ViolatesRule {
+			color = violationColor
+		}
+
+		fontSize := cellSize * 0.6 / math.Max(1, float64(len(cell.Symbol))*0.6)
+		fmt.Fprintf(builder, `<text x="%s" y="%s" font-size="%s" font-weight="%s" fill="%s">%s</text>`+"\n",
+			formatNumber(cell.Center.X), formatNumber(cell.Center.Y), formatNumber(fontSize),
+			weight, color, escapeXml(cell.Symbol))
+		return
+	}
+
+	for _, candidate := range cell.Candidates {
+		fontSize := candidate.Size * 0.75 / math.Max(1, float64(len(candidate.Symbol))*0.6)
+		fmt.Fprintf(builder, `<text x="%s" y="%s" font-size="%s" fill="%s">%s</text>`+"\n",
+			formatNumber(candidate.Center.X), formatNumber(candidate.Center.Y), formatNumber(fontSize),
+			candidateColor, escapeXml(candidate.Symbol))
+	}
+}
+
+// formatPoints formats points as a list of SVG coordinates
+func formatPoints(points []Point) string {
+	formatted := []string{}
+	for _, point := range points {
+		formatted = append(formatted, formatNumber(point.X)+","+formatNumber(point.Y))
+	}
+
+	return strings.Join(formatted, " ")
+}
+
+// formatNumber formats a coordinate with up to two decimal places
+func formatNumber(number float64) string {
+	formatted := strings.TrimRight(fmt.Sprintf("%.2f", number), "0")
+	return strings.TrimSuffix(formatted, ".")
+}
+
+// escapeXml escapes the text, so symbols of custom alphabets can be used as SVG text
+func escapeXml(text string) string {
+	buffer := &bytes.Buffer{}
+	xml.EscapeText(buffer, []byte(text))
+	return buffer.String()
+}
@@ -0,0 +1,17 @@
+package textSudokuParser
+
+import "github.com/Michu8258/kangaroo/models"
+
+type TextSudokuParser struct {
+	Settings *models.Settings
+}
+
+type ITextSudokuParser interface {
+	ParseSudoku(text string, alphabet string) (*models.SudokuDTO, error)
+}
+
+func GetNewTextSudokuParser(settings *models.Settings) ITextSudokuParser {
+	return &TextSudokuParser{
+		Settings: settings,
+	}
+}
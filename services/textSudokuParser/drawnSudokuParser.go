@@ -0,0 +1,185 @@
+package textSudokuParser
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// drawnSudokuGrid describes geometry of a sudoku drawn with box-drawing characters
+type drawnSudokuGrid struct {
+	lines         [][]rune
+	boxBoundaries []int
+	boxRows       [][][]rune
+	boxSize       int8
+	cellWidth     int
+}
+
+// isDrawnSudoku checks if the text is a sudoku drawn with box-drawing
+// characters (format of sudoku printouts and TXT output files)
+func isDrawnSudoku(text string) bool {
+	return strings.HasPrefix(strings.TrimSpace(text), "╔")
+}
+
+// parseDrawnSudoku reads sudoku drawn with box-drawing characters. Box size and layout
+// are inferred from the borders, boxes without cell separators are disabled, and
+// inequality signs between cells are read as comparisons.
+func (parser *TextSudokuParser) parseDrawnSudoku(text string, alphabet string) (*models.SudokuDTO, error) {
+	grid, err := parser.readDrawnSudokuGrid(text)
+	if err != nil {
+		return nil, err
+	}
+
+	layoutWidth := len(grid.boxBoundaries) - 1
+	layoutHeight := len(grid.boxRows)
+	if layoutWidth < int(parser.Settings.MinimumLayoutSizeInclusive) ||
+		layoutWidth > int(parser.Settings.MaximumLayoutSizeInclusive) ||
+		layoutHeight < int(parser.Settings.MinimumLayoutSizeInclusive) ||
+		layoutHeight > int(parser.Settings.MaximumLayoutSizeInclusive) {
+		return nil, fmt.Errorf("layout %dx%d is not supported (%d - %d)", layoutWidth, layoutHeight,
+			parser.Settings.MinimumLayoutSizeInclusive, parser.Settings.MaximumLayoutSizeInclusive)
+	}
+
+	var symbolAlphabet *models.SymbolAlphabet
+	if len(alphabet) > 0 {
+		symbolAlphabet, err = models.ParseSymbolAlphabet(alphabet, grid.boxSize)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	boxSize := int(grid.boxSize)
+	sudokuDto := models.NewEmptySudokuDTO(grid.boxSize, int8(layoutWidth), int8(layoutHeight))
+	sudokuDto.Alphabet = alphabet
+
+	for _, box := range sudokuDto.Boxes {
+		boxLines := grid.boxRows[box.IndexRow]
+		boxStart := grid.boxBoundaries[box.IndexColumn] + 1
+
+		if boxLines[0][boxStart+grid.cellWidth] == ' ' {
+			box.Disabled = true
+			continue
+		}
+
+		for _, cell := range box.Cells {
+			cellStart := boxStart + int(cell.IndexColumnInBox)*(grid.cellWidth+1)
+			valuesLine := boxLines[2*int(cell.IndexRowInBox)]
+			position := models.SudokuCellPositionDTO{
+				Row:    int(box.IndexRow)*boxSize + int(cell.IndexRowInBox),
+				Column: int(box.IndexColumn)*boxSize + int(cell.IndexColumnInBox),
+			}
+
+			symbol := strings.TrimSpace(string(valuesLine[cellStart : cellStart+grid.cellWidth]))
+			if len(symbol) > 0 {
+				value, err := getValue(symbol, symbolAlphabet, boxSize*boxSize)
+				if err != nil {
+					return nil, fmt.Errorf("invalid value in row %d, column %d: %s",
+						position.Row+1, position.Column+1, err)
+				}
+
+				cell.Value = value
+			}
+
+			if int(cell.IndexColumnInBox) < boxSize-1 {
+				right := models.SudokuCellPositionDTO{Row: position.Row, Column: position.Column + 1}
+				appendDrawnComparison(sudokuDto, valuesLine[cellStart+grid.cellWidth], '>', '<', position, right)
+			}
+
+			if int(cell.IndexRowInBox) < boxSize-1 {
+				midCellsLine := boxLines[2*int(cell.IndexRowInBox)+1]
+				lower := models.SudokuCellPositionDTO{Row: position.Row + 1, Column: position.Column}
+				for _, sign := range midCellsLine[cellStart : cellStart+grid.cellWidth] {
+					appendDrawnComparison(sudokuDto, sign, 'v', '^', position, lower)
+				}
+			}
+		}
+	}
+
+	sudokuDto.AssignSymbols()
+	return sudokuDto, nil
+}
+
+// readDrawnSudokuGrid splits the drawn sudoku into lines of box rows
+// and computes size of boxes and width of cells
+func (parser *TextSudokuParser) readDrawnSudokuGrid(text string) (*drawnSudokuGrid, error) {
+	grid := &drawnSudokuGrid{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			grid.lines = append(grid.lines, []rune(line))
+		}
+	}
+
+	top, bottom := grid.lines[0], grid.lines[len(grid.lines)-1]
+	if top[len(top)-1] != '╗' || bottom[0] != '╚' {
+		return nil, errors.New("sudoku drawing is not closed with borders")
+	}
+
+	for index, character := range top {
+		if slices.Contains([]rune{'╔', '╦', '╗'}, character) {
+			grid.boxBoundaries = append(grid.boxBoundaries, index)
+		}
+	}
+
+	boxLines := [][]rune{}
+	for lineIndex, line := range grid.lines[1:] {
+		if len(line) != len(top) || line[0] == '╔' || (line[0] != '║' && line[0] != '╚') {
+			return nil, fmt.Errorf("line %d of sudoku drawing has invalid format", lineIndex+2)
+		}
+
+		if line[0] == '║' && line[1] != '═' {
+			boxLines = append(boxLines, line)
+			continue
+		}
+
+		if len(boxLines) < 1 {
+			return nil, fmt.Errorf("line %d of sudoku drawing has invalid format", lineIndex+2)
+		}
+
+		grid.boxRows = append(grid.boxRows, boxLines)
+		boxLines = [][]rune{}
+	}
+
+	grid.boxSize = int8((len(grid.boxRows[0]) + 1) / 2)
+	boxWidth := grid.boxBoundaries[1] - grid.boxBoundaries[0] - 1
+	grid.cellWidth = (boxWidth - int(grid.boxSize) + 1) / int(grid.boxSize)
+
+	if grid.boxSize < parser.Settings.MinimumBoxSizeInclusive ||
+		grid.boxSize > parser.Settings.MaximumBoxSizeInclusive {
+		return nil, fmt.Errorf("box size %d is not supported (%d - %d)", grid.boxSize,
+			parser.Settings.MinimumBoxSizeInclusive, parser.Settings.MaximumBoxSizeInclusive)
+	}
+
+	for _, boxRow := range grid.boxRows {
+		if len(boxRow) != 2*int(grid.boxSize)-1 {
+			return nil, errors.New("rows of boxes in sudoku drawing have different heights")
+		}
+	}
+
+	for index := 1; index < len(grid.boxBoundaries); index++ {
+		if grid.boxBoundaries[index]-grid.boxBoundaries[index-1]-1 != boxWidth ||
+			grid.cellWidth < 1 || grid.cellWidth*int(grid.boxSize)+int(grid.boxSize)-1 != boxWidth {
+			return nil, errors.New("boxes in sudoku drawing have different widths")
+		}
+	}
+
+	return grid, nil
+}
+
+// appendDrawnComparison adds comparison of the cells to the sudoku if the sign
+// is an inequality sign, first of the signs means the first cell is greater
+func appendDrawnComparison(sudokuDto *models.SudokuDTO, sign rune, firstGreaterSign rune,
+	firstLesserSign rune, first models.SudokuCellPositionDTO, second models.SudokuCellPositionDTO) {
+
+	switch sign {
+	case firstGreaterSign:
+		sudokuDto.Comparisons = append(sudokuDto.Comparisons,
+			&models.SudokuComparisonDTO{Greater: first, Lesser: second})
+	case firstLesserSign:
+		sudokuDto.Comparisons = append(sudokuDto.Comparisons,
+			&models.SudokuComparisonDTO{Greater: second, Lesser: first})
+	}
+}
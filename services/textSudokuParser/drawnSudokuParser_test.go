@@ -0,0 +1,131 @@
+package textSudokuParser
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/services/dataPrinters"
+	"github.com/Michu8258/kangaroo/services/printer"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestParseSudoku_Drawn(t *testing.T) {
+	testCases := []struct {
+		name     string
+		padding  int8
+		alphabet string
+		sudoku   func() *models.SudokuDTO
+	}{
+		{
+			name:    "Disabled boxes",
+			padding: 1,
+			sudoku: func() *models.SudokuDTO {
+				sudokuDto := models.NewEmptySudokuDTO(3, 3, 2)
+				sudokuDto.Boxes[1].Disabled = true
+				sudokuDto.Boxes[0].Cells[0].Value = intPointer(5)
+				sudokuDto.Boxes[5].Cells[8].Value = intPointer(9)
+				return sudokuDto
+			},
+		},
+		{
+			name:     "Alphabet and comparisons",
+			padding:  0,
+			alphabet: "A-D",
+			sudoku: func() *models.SudokuDTO {
+				sudokuDto := models.NewEmptySudokuDTO(2, 2, 2)
+				sudokuDto.Boxes[1].Cells[1].Value = intPointer(3)
+				sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{
+					{Greater: models.SudokuCellPositionDTO{Row: 0, Column: 0}, Lesser: models.SudokuCellPositionDTO{Row: 0, Column: 1}},
+					{Greater: models.SudokuCellPositionDTO{Row: 1, Column: 0}, Lesser: models.SudokuCellPositionDTO{Row: 0, Column: 0}},
+					{Greater: models.SudokuCellPositionDTO{Row: 3, Column: 3}, Lesser: models.SudokuCellPositionDTO{Row: 3, Column: 2}},
+				}
+				return sudokuDto
+			},
+		},
+		{
+			name:    "Multi character values",
+			padding: 2,
+			sudoku: func() *models.SudokuDTO {
+				sudokuDto := models.NewEmptySudokuDTO(4, 2, 3)
+				sudokuDto.Boxes[0].Cells[0].Value = intPointer(16)
+				sudokuDto.Boxes[3].Cells[5].Value = intPointer(7)
+				sudokuDto.Comparisons = models.GenericSlice[*models.SudokuComparisonDTO]{
+					{Greater: models.SudokuCellPositionDTO{Row: 4, Column: 1}, Lesser: models.SudokuCellPositionDTO{Row: 5, Column: 1}},
+				}
+				return sudokuDto
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		settings := testHelpers.GetTestSettings()
+		settings.SudokuPrintoutValuePaddingLength = testCase.padding
+		sudokuDto := testCase.sudoku()
+		sudokuDto.Alphabet = testCase.alphabet
+
+		expectedText := printSudoku(settings, sudokuDto)
+		parsedSudoku, err := GetNewTextSudokuParser(settings).ParseSudoku("\n"+expectedText, testCase.alphabet)
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		if parsedSudoku.BoxSize != sudokuDto.BoxSize || parsedSudoku.Layout != sudokuDto.Layout {
+			t.Errorf("%s: Invalid size of parsed sudoku", testCase.name)
+			continue
+		}
+
+		if len(parsedSudoku.Comparisons) != len(sudokuDto.Comparisons) {
+			t.Errorf("%s: Expected %d comparisons, got %d", testCase.name,
+				len(sudokuDto.Comparisons), len(parsedSudoku.Comparisons))
+		}
+
+		parsedText := printSudoku(settings, parsedSudoku)
+		if parsedText != expectedText {
+			t.Errorf("%s: Parsed sudoku\n%s\ndiffers from the original\n%s", testCase.name, parsedText, expectedText)
+		}
+	}
+}
+
+func TestParseSudoku_DrawnError(t *testing.T) {
+	validText := printSudoku(testHelpers.GetTestSettings(), models.NewEmptySudokuDTO(2, 2, 2))
+	lines := strings.Split(validText, "\n")
+
+	testCases := []struct {
+		name     string
+		text     string
+		alphabet string
+	}{
+		{name: "Not closed", text: strings.Join(lines[:len(lines)-2], "\n")},
+		{name: "Different line lengths", text: strings.Replace(validText, "│   ║", "│  ║", 1)},
+		{name: "Unknown line", text: strings.Replace(validText, "║───────║───────║", "-----------------", 1)},
+		{name: "Different box heights", text: strings.Replace(validText, lines[3]+"\n", "", 1)},
+		{name: "Invalid value", text: strings.Replace(validText, "║   │", "║ 7 │", 1)},
+		{name: "Symbol out of alphabet", text: strings.Replace(validText, "║   │", "║ 1 │", 1), alphabet: "A-D"},
+		{name: "Unsupported layout", text: printSudoku(testHelpers.GetTestSettings(), models.NewEmptySudokuDTO(2, 1, 2))},
+	}
+
+	parser := GetNewTextSudokuParser(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		_, err := parser.ParseSudoku(testCase.text, testCase.alphabet)
+		if !errors.Is(err, models.ErrInvalidInput) {
+			t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+		}
+	}
+}
+
+// printSudoku returns the sudoku drawn the same way as in TXT files
+func printSudoku(settings *models.Settings, sudokuDto *models.SudokuDTO) string {
+	buffer := &bytes.Buffer{}
+	txtPrinter := printer.NewTxtFilePrinter(buffer)
+	dataPrinters.GetNewDataPrinter(settings, txtPrinter).PrintSudoku(sudokuDto.ToSudoku(), txtPrinter)
+	return buffer.String()
+}
+
+func intPointer(value int) *int {
+	return &value
+}
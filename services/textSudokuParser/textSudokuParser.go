@@ -0,0 +1,170 @@
+package textSudokuParser
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Michu8258/kangaroo/models"
+)
+
+// symbols representing empty cells
+var blankSymbols = []string{".", "_", "0"}
+
+// ParseSudoku reads sudoku from a text. The text is either a sudoku drawn with box-drawing
+// characters (as printed by the cli and saved to TXT files, including disabled boxes and
+// comparisons), a compact string with all values, or a grid with one row of values per line.
+// Values in a line are separated with whitespaces or written without separators. Empty
+// cells are marked with '.', '_' or '0'. Values are decimal numbers, or symbols of the
+// alphabet if the alphabet is provided. Box size is inferred from amount of cells, and
+// compact strings and grids are read as classic sudoku (square grid of boxes, e.g. 9x9).
+func (parser *TextSudokuParser) ParseSudoku(text string, alphabet string) (*models.SudokuDTO, error) {
+	if isDrawnSudoku(text) {
+		sudokuDto, err := parser.parseDrawnSudoku(text, alphabet)
+		if err != nil {
+			return nil, models.WithKind(models.ErrInvalidInput, err)
+		}
+
+		return sudokuDto, nil
+	}
+
+	rows, err := splitRows(text)
+	if err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput, err)
+	}
+
+	boxSize, err := parser.getBoxSize(len(rows))
+	if err != nil {
+		return nil, models.WithKind(models.ErrInvalidInput, err)
+	}
+
+	var symbolAlphabet *models.SymbolAlphabet
+	if len(alphabet) > 0 {
+		symbolAlphabet, err = models.ParseSymbolAlphabet(alphabet, boxSize)
+		if err != nil {
+			return nil, models.WithKind(models.ErrInvalidInput, err)
+		}
+	}
+
+	sudokuDto := models.NewEmptySudokuDTO(boxSize, boxSize, boxSize)
+	sudokuDto.Alphabet = alphabet
+
+	for rowIndex, row := range rows {
+		for columnIndex, symbol := range row {
+			value, err := getValue(symbol, symbolAlphabet, len(rows))
+			if err != nil {
+				return nil, models.WithKind(models.ErrInvalidInput,
+					fmt.Errorf("invalid value in row %d, column %d: %s", rowIndex+1, columnIndex+1, err))
+			}
+
+			if value != nil {
+				box := sudokuDto.Boxes[(rowIndex/int(boxSize))*int(boxSize)+columnIndex/int(boxSize)]
+				box.Cells[(rowIndex%int(boxSize))*int(boxSize)+columnIndex%int(boxSize)].Value = value
+			}
+		}
+	}
+
+	sudokuDto.AssignSymbols()
+	return sudokuDto, nil
+}
+
+// splitRows splits the text into rows of symbols. Returns an error
+// if the rows do not form a square grid.
+func splitRows(text string) ([][]string, error) {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) > 0 {
+			lines = append(lines, line)
+		}
+	}
+
+	if len(lines) < 1 {
+		return nil, errors.New("sudoku text is empty")
+	}
+
+	if len(lines) == 1 && !strings.ContainsFunc(lines[0], unicode.IsSpace) {
+		return splitCompactRow(lines[0])
+	}
+
+	rows := [][]string{}
+	for lineIndex, line := range lines {
+		row := strings.Fields(line)
+		if len(row) == 1 {
+			row = strings.Split(line, "")
+		}
+
+		if len(row) != len(lines) {
+			return nil, fmt.Errorf("row %d has %d values, but there are %d rows",
+				lineIndex+1, len(row), len(lines))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// splitCompactRow splits a single line with all values into rows of symbols
+func splitCompactRow(line string) ([][]string, error) {
+	symbols := strings.Split(line, "")
+
+	size := 1
+	for size*size < len(symbols) {
+		size++
+	}
+
+	if size*size != len(symbols) {
+		return nil, fmt.Errorf("%d values do not form a square grid", len(symbols))
+	}
+
+	rows := [][]string{}
+	for rowIndex := 0; rowIndex < size; rowIndex++ {
+		rows = append(rows, symbols[rowIndex*size:(rowIndex+1)*size])
+	}
+
+	return rows, nil
+}
+
+// getBoxSize returns box size of classic sudoku with provided amount of rows
+func (parser *TextSudokuParser) getBoxSize(rowsCount int) (int8, error) {
+	var boxSize int8
+	for boxSize = parser.Settings.MinimumBoxSizeInclusive; boxSize <= parser.Settings.MaximumBoxSizeInclusive; boxSize++ {
+		if int(boxSize)*int(boxSize) == rowsCount {
+			return boxSize, nil
+		}
+	}
+
+	return 0, fmt.Errorf("grid with %d rows does not match any supported box size (%d - %d)",
+		rowsCount, parser.Settings.MinimumBoxSizeInclusive, parser.Settings.MaximumBoxSizeInclusive)
+}
+
+// getValue converts the symbol to value, nil is returned for empty cell.
+// Symbols of the alphabet take precedence over blank symbols.
+func getValue(symbol string, alphabet *models.SymbolAlphabet, maximumValue int) (*int, error) {
+	if alphabet != nil {
+		characters := []rune(symbol)
+		value, ok := alphabet.GetValue(characters[0])
+		if len(characters) == 1 && ok {
+			return &value, nil
+		}
+	}
+
+	if slices.Contains(blankSymbols, symbol) {
+		return nil, nil
+	}
+
+	if alphabet != nil {
+		return nil, fmt.Errorf("symbol '%s' is not a part of the alphabet '%s'", symbol, alphabet.Specification)
+	}
+
+	value, err := strconv.Atoi(symbol)
+	if err != nil || value < 1 || value > maximumValue {
+		return nil, fmt.Errorf("'%s' is not a value between 1 and %d", symbol, maximumValue)
+	}
+
+	return &value, nil
+}
@@ -0,0 +1,109 @@
+package textSudokuParser
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Michu8258/kangaroo/models"
+	"github.com/Michu8258/kangaroo/testHelpers"
+)
+
+func TestParseSudoku(t *testing.T) {
+	testCases := []struct {
+		name            string
+		text            string
+		alphabet        string
+		expectedBoxSize int8
+		expectedValues  map[[2]int]int
+	}{
+		{
+			name:            "Compact string",
+			text:            ".....431.3.927.56.41..5....9..527..15....6...17....852.327..1466..348.....4.....3\n",
+			expectedBoxSize: 3,
+			expectedValues:  map[[2]int]int{{0, 5}: 4, {1, 0}: 3, {8, 8}: 3, {0, 0}: 0},
+		},
+		{
+			name:            "Grid without separators",
+			text:            "1_..\n..2.\n.3..\n0004",
+			expectedBoxSize: 2,
+			expectedValues:  map[[2]int]int{{0, 0}: 1, {1, 2}: 2, {2, 1}: 3, {3, 3}: 4, {0, 1}: 0},
+		},
+		{
+			name:            "Grid with separators",
+			text:            "  1 . . .\n\n . . 2 .\n . 3 . .\n . . . 4\n",
+			expectedBoxSize: 2,
+			expectedValues:  map[[2]int]int{{0, 0}: 1, {1, 2}: 2, {2, 1}: 3, {3, 3}: 4},
+		},
+		{
+			name:            "Grid with big values",
+			text:            "16" + strings.Repeat(" .", 15) + "\n" + strings.Repeat(strings.Repeat(". ", 16)+"\n", 15),
+			expectedBoxSize: 4,
+			expectedValues:  map[[2]int]int{{0, 0}: 16, {15, 15}: 0},
+		},
+		{
+			name:            "Alphabet with zero",
+			text:            "0...\n..1.\n.2..\n...3",
+			alphabet:        "0-3",
+			expectedBoxSize: 2,
+			expectedValues:  map[[2]int]int{{0, 0}: 1, {1, 2}: 2, {2, 1}: 3, {3, 3}: 4},
+		},
+	}
+
+	parser := GetNewTextSudokuParser(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		sudokuDto, err := parser.ParseSudoku(testCase.text, testCase.alphabet)
+		if err != nil {
+			t.Errorf("%s: Unexpected error %s", testCase.name, err)
+			continue
+		}
+
+		if sudokuDto.BoxSize != testCase.expectedBoxSize ||
+			sudokuDto.Layout.Width != testCase.expectedBoxSize ||
+			sudokuDto.Layout.Height != testCase.expectedBoxSize {
+			t.Errorf("%s: Invalid size of parsed sudoku", testCase.name)
+			continue
+		}
+
+		sudoku := sudokuDto.ToSudoku()
+		for position, expectedValue := range testCase.expectedValues {
+			_, cell := sudoku.GetCellByPosition(models.SudokuCellPosition{Row: position[0], Column: position[1]})
+			value := 0
+			if cell.Value != nil {
+				value = *cell.Value
+			}
+
+			if value != expectedValue {
+				t.Errorf("%s: Expected value %d in row %d, column %d, got %d",
+					testCase.name, expectedValue, position[0], position[1], value)
+			}
+		}
+	}
+}
+
+func TestParseSudoku_Error(t *testing.T) {
+	testCases := []struct {
+		name     string
+		text     string
+		alphabet string
+	}{
+		{name: "Empty text", text: " \n "},
+		{name: "Not a square", text: "1234567"},
+		{name: "Unsupported size", text: "123456789"},
+		{name: "Different rows lengths", text: "1...\n...\n....\n...."},
+		{name: "Value out of range", text: "5...\n....\n....\n...."},
+		{name: "Not a number", text: "x...\n....\n....\n...."},
+		{name: "Symbol out of alphabet", text: "E...\n....\n....\n....", alphabet: "A-D"},
+		{name: "Invalid alphabet", text: "A...\n....\n....\n....", alphabet: "A-Z"},
+	}
+
+	parser := GetNewTextSudokuParser(testHelpers.GetTestSettings())
+
+	for _, testCase := range testCases {
+		_, err := parser.ParseSudoku(testCase.text, testCase.alphabet)
+		if !errors.Is(err, models.ErrInvalidInput) {
+			t.Errorf("%s: Expected invalid input error, got %v", testCase.name, err)
+		}
+	}
+}
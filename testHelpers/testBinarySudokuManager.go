@@ -25,6 +25,10 @@ func (manager *TestBinarySudokuManager) ReadFromBase64(base64Data string) (*mode
 	return nil, manager.readResultError
 }
 
+func (manager *TestBinarySudokuManager) ReadFromBase64URL(base64Data string) (*models.SudokuDTO, error) {
+	return manager.ReadFromBase64(base64Data)
+}
+
 func (manager *TestBinarySudokuManager) ReadFromBytes(sudokuData []byte) (*models.SudokuDTO, error) {
 	if manager.readResultError == nil {
 		return GetTestSudokuDto(), nil
@@ -41,9 +45,13 @@ func (manager *TestBinarySudokuManager) ToBase64(sudokuDto *models.SudokuDTO) (s
 	return "base64", manager.toBase64Error
 }
 
+func (manager *TestBinarySudokuManager) ToBase64URL(sudokuDto *models.SudokuDTO) (string, error) {
+	return manager.ToBase64(sudokuDto)
+}
+
 func (manager *TestBinarySudokuManager) ToBytes(sudokuDto *models.SudokuDTO) ([]byte, error) {
 	if manager.toBytes == nil {
-		return []byte{}, nil
+		return []byte{0, 2}, nil
 	}
 
 	return []byte{1, 2, 3}, manager.toBytes
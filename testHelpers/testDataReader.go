@@ -4,6 +4,8 @@ import "github.com/Michu8258/kangaroo/models"
 
 type TestDataReader struct {
 	SudokuResult *models.SudokuDTO
+	GameResult   *models.SudokuGameDTO
+	FileData     []byte
 	ErrorResult  error
 }
 
@@ -21,3 +23,31 @@ func (reader *TestDataReader) ReadSudokuFromConsole(request *models.SudokuConfig
 func (reader *TestDataReader) ReadSudokuFromJsonFile(path string) (*models.SudokuDTO, error) {
 	return reader.SudokuResult, reader.ErrorResult
 }
+
+func (reader *TestDataReader) ReadSudokusFromFile(path string, formatName string, alphabet string) ([]*models.SudokuDTO, error) {
+	if reader.SudokuResult == nil {
+		return nil, reader.ErrorResult
+	}
+
+	return []*models.SudokuDTO{reader.SudokuResult}, reader.ErrorResult
+}
+
+func (reader *TestDataReader) ReadSudokuFromFile(path string, alphabet string) (*models.SudokuDTO, error) {
+	return reader.SudokuResult, reader.ErrorResult
+}
+
+func (reader *TestDataReader) ReadSudokuFiles(path string, alphabet string) ([]*models.SudokuFile, error) {
+	if reader.SudokuResult == nil {
+		return nil, reader.ErrorResult
+	}
+
+	return []*models.SudokuFile{{Path: path, Sudokus: []*models.SudokuDTO{reader.SudokuResult}}}, reader.ErrorResult
+}
+
+func (reader *TestDataReader) ReadSudokuGameFromFile(path string) (*models.SudokuGameDTO, error) {
+	return reader.GameResult, reader.ErrorResult
+}
+
+func (reader *TestDataReader) ReadSudokuFileData(path string) ([]byte, error) {
+	return reader.FileData, reader.ErrorResult
+}
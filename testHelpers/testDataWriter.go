@@ -1,10 +1,17 @@
 package testHelpers
 
-import "github.com/Michu8258/kangaroo/models"
+import (
+	"bytes"
+	"io"
+
+	"github.com/Michu8258/kangaroo/models"
+)
 
 type TestDataWriter struct {
 	FileWrittenFlag bool
 	Error           error
+	// SavedData holds data written with SaveDataToFile
+	SavedData []byte
 }
 
 func NewTestDataWriter(fileWritten bool, err error) *TestDataWriter {
@@ -25,3 +32,26 @@ func (writer *TestDataWriter) SaveSudokuDtoToJson(sudokuDto *models.SudokuDTO, p
 func (writer *TestDataWriter) SaveSudokuToTxt(sudoku *models.Sudoku, path string, overwrite bool) (bool, error) {
 	return writer.FileWrittenFlag, writer.Error
 }
+
+func (writer *TestDataWriter) SaveSudokuToFile(sudoku *models.Sudoku, path string, overwrite bool) (bool, error) {
+	return writer.FileWrittenFlag, writer.Error
+}
+
+func (writer *TestDataWriter) SaveSudokuDtoToFile(sudokuDto *models.SudokuDTO, path string,
+	formatName string, overwrite bool) (bool, error) {
+	return writer.FileWrittenFlag, writer.Error
+}
+
+func (writer *TestDataWriter) SaveDataToFile(path string, overwrite bool,
+	write func(writer io.Writer) error) (bool, error) {
+	if writer.Error == nil {
+		buffer := &bytes.Buffer{}
+		if err := write(buffer); err != nil {
+			return false, err
+		}
+
+		writer.SavedData = buffer.Bytes()
+	}
+
+	return writer.FileWrittenFlag, writer.Error
+}
@@ -5,9 +5,11 @@ import "github.com/Michu8258/kangaroo/models"
 type TestPrompterConfig struct {
 	SelectError              error
 	SudokuPromptError        error
+	SudokuPromptFunc         *func(sudokuDto *models.SudokuDTO) error
 	SelectPromptFailEnforcer *func(callIndex int) bool
 	BoxSizePromptFunc        *func(callIndex int) (int8, error)
 	LayoutSizePromptFunc     *func(callIndex int) (int8, error)
+	PlayPromptFunc           *func(game *models.SudokuGameDTO, actions *models.SudokuGameActions) error
 }
 
 type TestPrompter struct {
@@ -41,7 +43,12 @@ func (prompter *TestPrompter) PromptMakeSelectChoice(title string,
 	return options[initialChoiceIndex], nil
 }
 
-func (prompter *TestPrompter) PromptSudokuValues(sudokuDto *models.SudokuDTO) error {
+func (prompter *TestPrompter) PromptSudokuValues(sudokuDto *models.SudokuDTO, allowConflicts bool) error {
+	if prompter.Config.SudokuPromptFunc != nil {
+		f := *prompter.Config.SudokuPromptFunc
+		return f(sudokuDto)
+	}
+
 	return prompter.Config.SudokuPromptError
 }
 
@@ -70,3 +77,13 @@ func (prompter *TestPrompter) PromptGetLayoutSize(initialSize *int8, direction s
 
 	return 0, nil
 }
+
+func (prompter *TestPrompter) PromptPlaySudoku(game *models.SudokuGameDTO,
+	actions *models.SudokuGameActions) error {
+	if prompter.Config.PlayPromptFunc != nil {
+		f := *prompter.Config.PlayPromptFunc
+		return f(game, actions)
+	}
+
+	return nil
+}
@@ -14,5 +14,8 @@ func GetTestSettings() *models.Settings {
 		UseDebugPrints:                   false,
 		SilentConsolePrints:              false,
 		SudokuBinaryEncoderVersion:       1,
+		OutputFormat:                     models.TextOutputFormat,
+		LogLevel:                         "warn",
+		LogFormat:                        models.TextLogFormat,
 	}
 }
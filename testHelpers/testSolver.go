@@ -1,10 +1,19 @@
 package testHelpers
 
-import "github.com/Michu8258/kangaroo/models"
+import (
+	"context"
+
+	"github.com/Michu8258/kangaroo/models"
+)
 
 type TestSolver struct {
-	Result bool
-	Errors []error
+	Result     bool
+	Unsolvable bool
+	Errors     []error
+	// WaitForContext makes the solver work until the context is done
+	WaitForContext bool
+	// Stopped receives a value every time waiting solver is stopped by the context
+	Stopped chan struct{}
 }
 
 func GetNewTestSolver(result bool, errors []error) *TestSolver {
@@ -14,6 +23,23 @@ func GetNewTestSolver(result bool, errors []error) *TestSolver {
 	}
 }
 
-func (solver *TestSolver) Solve(sudoku *models.Sudoku) (result bool, errors []error) {
+func (solver *TestSolver) Solve(ctx context.Context, sudoku *models.Sudoku) (result bool, errors []error) {
+	if solver.WaitForContext {
+		<-ctx.Done()
+		sudoku.Result = models.Failure
+		if solver.Stopped != nil {
+			solver.Stopped <- struct{}{}
+		}
+
+		return false, []error{ctx.Err()}
+	}
+
+	sudoku.Result = models.Failure
+	if solver.Result {
+		sudoku.Result = models.SuccessfullSolution
+	} else if solver.Unsolvable {
+		sudoku.Result = models.UnsolvableSudoku
+	}
+
 	return solver.Result, solver.Errors
 }